@@ -0,0 +1,102 @@
+package pivnet
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+const (
+	// accessTokenRefreshWindow is how long before its expiry a cached access
+	// token is replaced, so that it does not expire while a request is in
+	// flight.
+	accessTokenRefreshWindow = time.Minute
+
+	// defaultAccessTokenLifetime is used for access tokens whose expiry
+	// cannot be read from the token itself.
+	defaultAccessTokenLifetime = 5 * time.Minute
+)
+
+// accessTokenCache holds the most recently exchanged access token. The lock
+// is held while a new token is fetched, so concurrent callers wait for and
+// share a single refresh. It is a channel rather than a mutex so that
+// callers can stop waiting when their context is done.
+type accessTokenCache struct {
+	lock      chan struct{}
+	token     string
+	expiresAt time.Time
+	now       func() time.Time
+}
+
+func newAccessTokenCache() *accessTokenCache {
+	return &accessTokenCache{lock: make(chan struct{}, 1), now: time.Now}
+}
+
+func (c *accessTokenCache) get(fetch func() (string, error)) (string, error) {
+	return c.getContext(context.Background(), fetch)
+}
+
+func (c *accessTokenCache) getContext(ctx context.Context, fetch func() (string, error)) (string, error) {
+	select {
+	case c.lock <- struct{}{}:
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+	defer func() { <-c.lock }()
+
+	now := c.now()
+	if c.token != "" && now.Before(c.expiresAt.Add(-accessTokenRefreshWindow)) {
+		return c.token, nil
+	}
+
+	token, err := fetch()
+	if err != nil {
+		return "", err
+	}
+
+	c.token = token
+	c.expiresAt = accessTokenExpiry(token, now)
+
+	return token, nil
+}
+
+// invalidate discards the cached token if it is still the given one.
+// Comparing against the rejected token means that a burst of concurrent 401s
+// only causes a single refresh.
+func (c *accessTokenCache) invalidate(token string) {
+	c.lock <- struct{}{}
+	defer func() { <-c.lock }()
+
+	if c.token == token {
+		c.token = ""
+		c.expiresAt = time.Time{}
+	}
+}
+
+// accessTokenExpiry reads the exp claim of a JWT access token. The signature
+// is not verified; the expiry is only used to decide when to refresh.
+func accessTokenExpiry(token string, now time.Time) time.Time {
+	fallback := now.Add(defaultAccessTokenLifetime)
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return fallback
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return fallback
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	err = json.Unmarshal(payload, &claims)
+	if err != nil || claims.Exp == 0 {
+		return fallback
+	}
+
+	return time.Unix(claims.Exp, 0)
+}
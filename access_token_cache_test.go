@@ -0,0 +1,161 @@
+package pivnet
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func jwtExpiringAt(expiry time.Time) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, expiry.Unix())))
+	return fmt.Sprintf("%s.%s.some-signature", header, payload)
+}
+
+var _ = Describe("accessTokenCache", func() {
+	var (
+		cache      *accessTokenCache
+		now        time.Time
+		fetchCount int
+		tokens     []string
+		fetch      func() (string, error)
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+		cache = newAccessTokenCache()
+		cache.now = func() time.Time { return now }
+
+		fetchCount = 0
+		tokens = []string{
+			jwtExpiringAt(now.Add(time.Hour)),
+			jwtExpiringAt(now.Add(2 * time.Hour)),
+		}
+		fetch = func() (string, error) {
+			token := tokens[fetchCount]
+			fetchCount++
+			return token, nil
+		}
+	})
+
+	It("reuses the token until shortly before it expires", func() {
+		token, err := cache.get(fetch)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(Equal(tokens[0]))
+
+		now = now.Add(58 * time.Minute)
+		token, err = cache.get(fetch)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(Equal(tokens[0]))
+		Expect(fetchCount).To(Equal(1))
+
+		now = now.Add(90 * time.Second)
+		token, err = cache.get(fetch)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(Equal(tokens[1]))
+		Expect(fetchCount).To(Equal(2))
+	})
+
+	It("shares a single refresh between concurrent callers", func() {
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+
+				token, err := cache.get(fetch)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(token).To(Equal(tokens[0]))
+			}()
+		}
+		wg.Wait()
+
+		Expect(fetchCount).To(Equal(1))
+	})
+
+	It("stops waiting for another caller's refresh when the context is done", func() {
+		token := tokens[0]
+		fetching := make(chan struct{})
+		release := make(chan struct{})
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			cache.get(func() (string, error) {
+				close(fetching)
+				<-release
+				return token, nil
+			})
+		}()
+		<-fetching
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := cache.getContext(ctx, fetch)
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(fetchCount).To(Equal(0))
+
+		close(release)
+		<-done
+	})
+
+	It("does not cache failed fetches", func() {
+		_, err := cache.get(func() (string, error) {
+			return "", errors.New("some error")
+		})
+		Expect(err).To(MatchError("some error"))
+
+		token, err := cache.get(fetch)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(Equal(tokens[0]))
+	})
+
+	Describe("invalidate", func() {
+		It("discards the cached token when it is the rejected one", func() {
+			_, err := cache.get(fetch)
+			Expect(err).NotTo(HaveOccurred())
+
+			cache.invalidate(tokens[0])
+
+			token, err := cache.get(fetch)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(token).To(Equal(tokens[1]))
+		})
+
+		It("keeps the cached token when a different one was rejected", func() {
+			_, err := cache.get(fetch)
+			Expect(err).NotTo(HaveOccurred())
+
+			cache.invalidate("some-older-token")
+
+			token, err := cache.get(fetch)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(token).To(Equal(tokens[0]))
+			Expect(fetchCount).To(Equal(1))
+		})
+	})
+
+	Describe("accessTokenExpiry", func() {
+		It("reads the exp claim of the token", func() {
+			expiry := now.Add(42 * time.Minute)
+			Expect(accessTokenExpiry(jwtExpiringAt(expiry), now)).To(BeTemporally("==", expiry))
+		})
+
+		It("falls back to a default lifetime when the token is not a JWT", func() {
+			Expect(accessTokenExpiry("some-opaque-token", now)).To(BeTemporally("==", now.Add(defaultAccessTokenLifetime)))
+		})
+
+		It("falls back to a default lifetime when the token has no exp claim", func() {
+			payload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"someone"}`))
+			token := fmt.Sprintf("header.%s.signature", payload)
+			Expect(accessTokenExpiry(token, now)).To(BeTemporally("==", now.Add(defaultAccessTokenLifetime)))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -11,6 +12,30 @@ type ArtifactReferencesService struct {
 	client Client
 }
 
+//go:generate counterfeiter . ArtifactReferencesAPI
+type ArtifactReferencesAPI interface {
+	List(productSlug string) ([]ArtifactReference, error)
+	ListContext(ctx context.Context, productSlug string) ([]ArtifactReference, error)
+	ListForDigest(productSlug string, digest string) ([]ArtifactReference, error)
+	ListForDigestContext(ctx context.Context, productSlug string, digest string) ([]ArtifactReference, error)
+	ListForRelease(productSlug string, releaseID int) ([]ArtifactReference, error)
+	ListForReleaseContext(ctx context.Context, productSlug string, releaseID int) ([]ArtifactReference, error)
+	Get(productSlug string, artifactReferenceID int) (ArtifactReference, error)
+	GetContext(ctx context.Context, productSlug string, artifactReferenceID int) (ArtifactReference, error)
+	Update(productSlug string, artifactReference ArtifactReference) (ArtifactReference, error)
+	UpdateContext(ctx context.Context, productSlug string, artifactReference ArtifactReference) (ArtifactReference, error)
+	GetForRelease(productSlug string, releaseID int, artifactReferenceID int) (ArtifactReference, error)
+	GetForReleaseContext(ctx context.Context, productSlug string, releaseID int, artifactReferenceID int) (ArtifactReference, error)
+	Create(config CreateArtifactReferenceConfig) (ArtifactReference, error)
+	CreateContext(ctx context.Context, config CreateArtifactReferenceConfig) (ArtifactReference, error)
+	Delete(productSlug string, id int) (ArtifactReference, error)
+	DeleteContext(ctx context.Context, productSlug string, id int) (ArtifactReference, error)
+	AddToRelease(productSlug string, releaseID int, artifactReferenceID int) error
+	AddToReleaseContext(ctx context.Context, productSlug string, releaseID int, artifactReferenceID int) error
+	RemoveFromRelease(productSlug string, releaseID int, artifactReferenceID int) error
+	RemoveFromReleaseContext(ctx context.Context, productSlug string, releaseID int, artifactReferenceID int) error
+}
+
 type CreateArtifactReferenceConfig struct {
 	ProductSlug        string
 	Description        string
@@ -54,10 +79,15 @@ type createUpdateArtifactReferenceBody struct {
 }
 
 func (p ArtifactReferencesService) List(productSlug string) ([]ArtifactReference, error) {
+	return p.ListContext(context.Background(), productSlug)
+}
+
+func (p ArtifactReferencesService) ListContext(ctx context.Context, productSlug string) ([]ArtifactReference, error) {
 	url := fmt.Sprintf("/products/%s/artifact_references", productSlug)
 
 	var response ArtifactReferencesResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -77,13 +107,18 @@ func (p ArtifactReferencesService) List(productSlug string) ([]ArtifactReference
 }
 
 func (p ArtifactReferencesService) ListForDigest(productSlug string, digest string) ([]ArtifactReference, error) {
+	return p.ListForDigestContext(context.Background(), productSlug, digest)
+}
+
+func (p ArtifactReferencesService) ListForDigestContext(ctx context.Context, productSlug string, digest string) ([]ArtifactReference, error) {
 	url := fmt.Sprintf("/products/%s/artifact_references", productSlug)
 	params := []QueryParameter{
 		{"digest", digest},
 	}
 
 	var response ArtifactReferencesResponse
-	resp, err := p.client.MakeRequestWithParams(
+	resp, err := p.client.MakeRequestWithParamsContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -104,6 +139,10 @@ func (p ArtifactReferencesService) ListForDigest(productSlug string, digest stri
 }
 
 func (p ArtifactReferencesService) ListForRelease(productSlug string, releaseID int) ([]ArtifactReference, error) {
+	return p.ListForReleaseContext(context.Background(), productSlug, releaseID)
+}
+
+func (p ArtifactReferencesService) ListForReleaseContext(ctx context.Context, productSlug string, releaseID int) ([]ArtifactReference, error) {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/artifact_references",
 		productSlug,
@@ -111,7 +150,8 @@ func (p ArtifactReferencesService) ListForRelease(productSlug string, releaseID
 	)
 
 	var response ArtifactReferencesResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -131,6 +171,10 @@ func (p ArtifactReferencesService) ListForRelease(productSlug string, releaseID
 }
 
 func (p ArtifactReferencesService) Get(productSlug string, artifactReferenceID int) (ArtifactReference, error) {
+	return p.GetContext(context.Background(), productSlug, artifactReferenceID)
+}
+
+func (p ArtifactReferencesService) GetContext(ctx context.Context, productSlug string, artifactReferenceID int) (ArtifactReference, error) {
 	url := fmt.Sprintf(
 		"/products/%s/artifact_references/%d",
 		productSlug,
@@ -138,7 +182,8 @@ func (p ArtifactReferencesService) Get(productSlug string, artifactReferenceID i
 	)
 
 	var response ArtifactReferenceResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -158,6 +203,10 @@ func (p ArtifactReferencesService) Get(productSlug string, artifactReferenceID i
 }
 
 func (p ArtifactReferencesService) Update(productSlug string, artifactReference ArtifactReference) (ArtifactReference, error) {
+	return p.UpdateContext(context.Background(), productSlug, artifactReference)
+}
+
+func (p ArtifactReferencesService) UpdateContext(ctx context.Context, productSlug string, artifactReference ArtifactReference) (ArtifactReference, error) {
 	url := fmt.Sprintf("/products/%s/artifact_references/%d", productSlug, artifactReference.ID)
 
 	body := createUpdateArtifactReferenceBody{
@@ -175,7 +224,8 @@ func (p ArtifactReferencesService) Update(productSlug string, artifactReference
 	}
 
 	var response ArtifactReferenceResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusOK,
@@ -195,6 +245,10 @@ func (p ArtifactReferencesService) Update(productSlug string, artifactReference
 }
 
 func (p ArtifactReferencesService) GetForRelease(productSlug string, releaseID int, artifactReferenceID int) (ArtifactReference, error) {
+	return p.GetForReleaseContext(context.Background(), productSlug, releaseID, artifactReferenceID)
+}
+
+func (p ArtifactReferencesService) GetForReleaseContext(ctx context.Context, productSlug string, releaseID int, artifactReferenceID int) (ArtifactReference, error) {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/artifact_references/%d",
 		productSlug,
@@ -203,7 +257,8 @@ func (p ArtifactReferencesService) GetForRelease(productSlug string, releaseID i
 	)
 
 	var response ArtifactReferenceResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -223,6 +278,10 @@ func (p ArtifactReferencesService) GetForRelease(productSlug string, releaseID i
 }
 
 func (p ArtifactReferencesService) Create(config CreateArtifactReferenceConfig) (ArtifactReference, error) {
+	return p.CreateContext(context.Background(), config)
+}
+
+func (p ArtifactReferencesService) CreateContext(ctx context.Context, config CreateArtifactReferenceConfig) (ArtifactReference, error) {
 	url := fmt.Sprintf("/products/%s/artifact_references", config.ProductSlug)
 
 	body := createUpdateArtifactReferenceBody{
@@ -244,7 +303,8 @@ func (p ArtifactReferencesService) Create(config CreateArtifactReferenceConfig)
 	}
 
 	var response ArtifactReferenceResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"POST",
 		url,
 		http.StatusCreated,
@@ -269,6 +329,10 @@ func (p ArtifactReferencesService) Create(config CreateArtifactReferenceConfig)
 }
 
 func (p ArtifactReferencesService) Delete(productSlug string, id int) (ArtifactReference, error) {
+	return p.DeleteContext(context.Background(), productSlug, id)
+}
+
+func (p ArtifactReferencesService) DeleteContext(ctx context.Context, productSlug string, id int) (ArtifactReference, error) {
 	url := fmt.Sprintf(
 		"/products/%s/artifact_references/%d",
 		productSlug,
@@ -276,7 +340,8 @@ func (p ArtifactReferencesService) Delete(productSlug string, id int) (ArtifactR
 	)
 
 	var response ArtifactReferenceResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"DELETE",
 		url,
 		http.StatusOK,
@@ -299,6 +364,15 @@ func (p ArtifactReferencesService) AddToRelease(
 	productSlug string,
 	releaseID int,
 	artifactReferenceID int,
+) error {
+	return p.AddToReleaseContext(context.Background(), productSlug, releaseID, artifactReferenceID)
+}
+
+func (p ArtifactReferencesService) AddToReleaseContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	artifactReferenceID int,
 ) error {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/add_artifact_reference",
@@ -319,7 +393,8 @@ func (p ArtifactReferencesService) AddToRelease(
 		return err
 	}
 
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -337,6 +412,15 @@ func (p ArtifactReferencesService) RemoveFromRelease(
 	productSlug string,
 	releaseID int,
 	artifactReferenceID int,
+) error {
+	return p.RemoveFromReleaseContext(context.Background(), productSlug, releaseID, artifactReferenceID)
+}
+
+func (p ArtifactReferencesService) RemoveFromReleaseContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	artifactReferenceID int,
 ) error {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/remove_artifact_reference",
@@ -357,7 +441,8 @@ func (p ArtifactReferencesService) RemoveFromRelease(
 		return err
 	}
 
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -2,6 +2,7 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -11,6 +12,14 @@ type AuthService struct {
 	client Client
 }
 
+//go:generate counterfeiter . AuthAPI
+type AuthAPI interface {
+	Check() (bool, error)
+	CheckContext(ctx context.Context) (bool, error)
+	FetchUAAToken(refresh_token string) (UAATokenResponse, error)
+	FetchUAATokenContext(ctx context.Context, refresh_token string) (UAATokenResponse, error)
+}
+
 type UAATokenResponse struct {
 	Token string `json:"access_token"`
 }
@@ -21,9 +30,14 @@ type UAATokenResponse struct {
 // false,err if the auth attempt failed for any other reason.
 // It is guaranteed never to return true,err.
 func (e AuthService) Check() (bool, error) {
+	return e.CheckContext(context.Background())
+}
+
+func (e AuthService) CheckContext(ctx context.Context) (bool, error) {
 	url := "/authentication"
 
-	resp, err := e.client.MakeRequest(
+	resp, err := e.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		0,
@@ -47,6 +61,10 @@ func (e AuthService) Check() (bool, error) {
 }
 
 func (e AuthService) FetchUAAToken(refresh_token string) (UAATokenResponse, error) {
+	return e.FetchUAATokenContext(context.Background(), refresh_token)
+}
+
+func (e AuthService) FetchUAATokenContext(ctx context.Context, refresh_token string) (UAATokenResponse, error) {
 	url := "/authentication/access_tokens"
 
 	body := AuthBody{RefreshToken: refresh_token}
@@ -55,7 +73,8 @@ func (e AuthService) FetchUAAToken(refresh_token string) (UAATokenResponse, erro
 		return UAATokenResponse{}, err
 	}
 
-	resp, err := e.client.MakeRequest(
+	resp, err := e.client.MakeRequestContext(
+		ctx,
 		"POST",
 		url,
 		0,
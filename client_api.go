@@ -0,0 +1,109 @@
+package pivnet
+
+// ClientAPI exposes the services of a Client through interfaces, so code
+// depending on go-pivnet can substitute fakes for them in tests.
+//
+//go:generate counterfeiter . ClientAPI
+type ClientAPI interface {
+	AuthAPI() AuthAPI
+	EULAsAPI() EULAsAPI
+	ProductFilesAPI() ProductFilesAPI
+	ArtifactReferencesAPI() ArtifactReferencesAPI
+	FederationTokenAPI() FederationTokenAPI
+	FileGroupsAPI() FileGroupsAPI
+	ReleasesAPI() ReleasesAPI
+	ProductsAPI() ProductsAPI
+	UserGroupsAPI() UserGroupsAPI
+	SubscriptionGroupsAPI() SubscriptionGroupsAPI
+	ReleaseTypesAPI() ReleaseTypesAPI
+	ReleaseDependenciesAPI() ReleaseDependenciesAPI
+	DependencySpecifiersAPI() DependencySpecifiersAPI
+	ReleaseUpgradePathsAPI() ReleaseUpgradePathsAPI
+	UpgradePathSpecifiersAPI() UpgradePathSpecifiersAPI
+	PivnetVersionsAPI() PivnetVersionsAPI
+}
+
+var _ ClientAPI = Client{}
+
+var (
+	_ AuthAPI                  = &AuthService{}
+	_ EULAsAPI                 = &EULAsService{}
+	_ ProductFilesAPI          = &ProductFilesService{}
+	_ ArtifactReferencesAPI    = &ArtifactReferencesService{}
+	_ FederationTokenAPI       = &FederationTokenService{}
+	_ FileGroupsAPI            = &FileGroupsService{}
+	_ ReleasesAPI              = &ReleasesService{}
+	_ ProductsAPI              = &ProductsService{}
+	_ UserGroupsAPI            = &UserGroupsService{}
+	_ SubscriptionGroupsAPI    = &SubscriptionGroupsService{}
+	_ ReleaseTypesAPI          = &ReleaseTypesService{}
+	_ ReleaseDependenciesAPI   = &ReleaseDependenciesService{}
+	_ DependencySpecifiersAPI  = &DependencySpecifiersService{}
+	_ ReleaseUpgradePathsAPI   = &ReleaseUpgradePathsService{}
+	_ UpgradePathSpecifiersAPI = &UpgradePathSpecifiersService{}
+	_ PivnetVersionsAPI        = &PivnetVersionsService{}
+)
+
+func (c Client) AuthAPI() AuthAPI {
+	return c.Auth
+}
+
+func (c Client) EULAsAPI() EULAsAPI {
+	return c.EULA
+}
+
+func (c Client) ProductFilesAPI() ProductFilesAPI {
+	return c.ProductFiles
+}
+
+func (c Client) ArtifactReferencesAPI() ArtifactReferencesAPI {
+	return c.ArtifactReferences
+}
+
+func (c Client) FederationTokenAPI() FederationTokenAPI {
+	return c.FederationToken
+}
+
+func (c Client) FileGroupsAPI() FileGroupsAPI {
+	return c.FileGroups
+}
+
+func (c Client) ReleasesAPI() ReleasesAPI {
+	return c.Releases
+}
+
+func (c Client) ProductsAPI() ProductsAPI {
+	return c.Products
+}
+
+func (c Client) UserGroupsAPI() UserGroupsAPI {
+	return c.UserGroups
+}
+
+func (c Client) SubscriptionGroupsAPI() SubscriptionGroupsAPI {
+	return c.SubscriptionGroups
+}
+
+func (c Client) ReleaseTypesAPI() ReleaseTypesAPI {
+	return c.ReleaseTypes
+}
+
+func (c Client) ReleaseDependenciesAPI() ReleaseDependenciesAPI {
+	return c.ReleaseDependencies
+}
+
+func (c Client) DependencySpecifiersAPI() DependencySpecifiersAPI {
+	return c.DependencySpecifiers
+}
+
+func (c Client) ReleaseUpgradePathsAPI() ReleaseUpgradePathsAPI {
+	return c.ReleaseUpgradePaths
+}
+
+func (c Client) UpgradePathSpecifiersAPI() UpgradePathSpecifiersAPI {
+	return c.UpgradePathSpecifiers
+}
+
+func (c Client) PivnetVersionsAPI() PivnetVersionsAPI {
+	return c.PivnetVersions
+}
@@ -2,6 +2,7 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -11,6 +12,18 @@ type DependencySpecifiersService struct {
 	client Client
 }
 
+//go:generate counterfeiter . DependencySpecifiersAPI
+type DependencySpecifiersAPI interface {
+	List(productSlug string, releaseID int) ([]DependencySpecifier, error)
+	ListContext(ctx context.Context, productSlug string, releaseID int) ([]DependencySpecifier, error)
+	Get(productSlug string, releaseID int, dependencySpecifierID int) (DependencySpecifier, error)
+	GetContext(ctx context.Context, productSlug string, releaseID int, dependencySpecifierID int) (DependencySpecifier, error)
+	Create(productSlug string, releaseID int, dependentProductSlug string, specifier string) (DependencySpecifier, error)
+	CreateContext(ctx context.Context, productSlug string, releaseID int, dependentProductSlug string, specifier string) (DependencySpecifier, error)
+	Delete(productSlug string, releaseID int, dependencySpecifierID int) error
+	DeleteContext(ctx context.Context, productSlug string, releaseID int, dependencySpecifierID int) error
+}
+
 type DependencySpecifiersResponse struct {
 	DependencySpecifiers []DependencySpecifier `json:"dependency_specifiers,omitempty"`
 }
@@ -26,6 +39,10 @@ type DependencySpecifier struct {
 }
 
 func (r DependencySpecifiersService) List(productSlug string, releaseID int) ([]DependencySpecifier, error) {
+	return r.ListContext(context.Background(), productSlug, releaseID)
+}
+
+func (r DependencySpecifiersService) ListContext(ctx context.Context, productSlug string, releaseID int) ([]DependencySpecifier, error) {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/dependency_specifiers",
 		productSlug,
@@ -33,7 +50,8 @@ func (r DependencySpecifiersService) List(productSlug string, releaseID int) ([]
 	)
 
 	var response DependencySpecifiersResponse
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -53,6 +71,10 @@ func (r DependencySpecifiersService) List(productSlug string, releaseID int) ([]
 }
 
 func (r DependencySpecifiersService) Get(productSlug string, releaseID int, dependencySpecifierID int) (DependencySpecifier, error) {
+	return r.GetContext(context.Background(), productSlug, releaseID, dependencySpecifierID)
+}
+
+func (r DependencySpecifiersService) GetContext(ctx context.Context, productSlug string, releaseID int, dependencySpecifierID int) (DependencySpecifier, error) {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/dependency_specifiers/%d",
 		productSlug,
@@ -60,7 +82,8 @@ func (r DependencySpecifiersService) Get(productSlug string, releaseID int, depe
 		dependencySpecifierID,
 	)
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -85,6 +108,16 @@ func (r DependencySpecifiersService) Create(
 	releaseID int,
 	dependentProductSlug string,
 	specifier string,
+) (DependencySpecifier, error) {
+	return r.CreateContext(context.Background(), productSlug, releaseID, dependentProductSlug, specifier)
+}
+
+func (r DependencySpecifiersService) CreateContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	dependentProductSlug string,
+	specifier string,
 ) (DependencySpecifier, error) {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/dependency_specifiers",
@@ -106,7 +139,8 @@ func (r DependencySpecifiersService) Create(
 		return DependencySpecifier{}, err
 	}
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"POST",
 		url,
 		http.StatusCreated,
@@ -130,6 +164,15 @@ func (r DependencySpecifiersService) Delete(
 	productSlug string,
 	releaseID int,
 	dependencySpecifierID int,
+) error {
+	return r.DeleteContext(context.Background(), productSlug, releaseID, dependencySpecifierID)
+}
+
+func (r DependencySpecifiersService) DeleteContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	dependencySpecifierID int,
 ) error {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/dependency_specifiers/%d",
@@ -138,7 +181,8 @@ func (r DependencySpecifiersService) Delete(
 		dependencySpecifierID,
 	)
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"DELETE",
 		url,
 		http.StatusNoContent,
@@ -0,0 +1,102 @@
+package download
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pivotal-cf/go-pivnet/v9/md5sum"
+	"github.com/pivotal-cf/go-pivnet/v9/sha256sum"
+)
+
+// ChecksumMismatchAction says what happens to a downloaded file whose
+// checksum does not match the expected one.
+type ChecksumMismatchAction string
+
+const (
+	// ChecksumMismatchKeep leaves the file at its partial path when the
+	// Client resumes downloads. Otherwise the file is deleted, as the partial
+	// file of any other failed download is.
+	ChecksumMismatchKeep ChecksumMismatchAction = ""
+	// ChecksumMismatchDelete removes the file.
+	ChecksumMismatchDelete ChecksumMismatchAction = "delete"
+	// ChecksumMismatchQuarantine renames the file with QuarantineSuffix.
+	ChecksumMismatchQuarantine ChecksumMismatchAction = "quarantine"
+)
+
+const QuarantineSuffix = ".corrupt"
+
+// ErrChecksumMismatch is returned when a finished download does not match
+// the SHA256 (or, when no SHA256 is known, the MD5) it was expected to have.
+// Path is where the file was left, and is empty if it was deleted.
+type ErrChecksumMismatch struct {
+	Algorithm string `json:"algorithm" yaml:"algorithm"`
+	Expected  string `json:"expected" yaml:"expected"`
+	Actual    string `json:"actual" yaml:"actual"`
+	Path      string `json:"path" yaml:"path"`
+}
+
+func (e ErrChecksumMismatch) Error() string {
+	return fmt.Sprintf("%s checksum mismatch: expected %s, got %s", e.Algorithm, e.Expected, e.Actual)
+}
+
+// verifyChecksum checks the file at filePath against the checksums expected
+// for location.
+func (c Client) verifyChecksum(filePath string, location *FileInfo) error {
+	var (
+		algorithm string
+		expected  string
+		actual    string
+		err       error
+	)
+
+	switch {
+	case location.SHA256 != "":
+		algorithm = "sha256"
+		expected = location.SHA256
+		actual, err = sha256sum.NewFileSummer().SumFile(filePath)
+	case location.MD5 != "":
+		algorithm = "md5"
+		expected = location.MD5
+		actual, err = md5sum.NewFileSummer().SumFile(filePath)
+	default:
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to compute %s checksum: %w", algorithm, err)
+	}
+
+	if strings.EqualFold(actual, expected) {
+		return nil
+	}
+
+	mismatch := ErrChecksumMismatch{
+		Algorithm: algorithm,
+		Expected:  expected,
+		Actual:    actual,
+		Path:      filePath,
+	}
+
+	action := c.ChecksumMismatchAction
+	if action == ChecksumMismatchKeep && !c.Resume {
+		action = ChecksumMismatchDelete
+	}
+
+	switch action {
+	case ChecksumMismatchDelete:
+		err = os.Remove(filePath)
+		if err != nil {
+			return fmt.Errorf("failed to delete corrupt file: %s (%w)", err, mismatch)
+		}
+		mismatch.Path = ""
+	case ChecksumMismatchQuarantine:
+		quarantined := location.Name + QuarantineSuffix
+		err = os.Rename(filePath, quarantined)
+		if err != nil {
+			return fmt.Errorf("failed to quarantine corrupt file: %s (%w)", err, mismatch)
+		}
+		mismatch.Path = quarantined
+	}
+
+	return mismatch
+}
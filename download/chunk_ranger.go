@@ -0,0 +1,61 @@
+package download
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ChunkRanger splits a file into ranges of roughly equal size, aiming for
+// one range per unit of parallelism but never smaller than MinChunkSize or
+// larger than MaxChunkSize. Small files are fetched in a single request and
+// large files in many small ranges, so a failed range costs little to retry.
+// A zero MinChunkSize or MaxChunkSize means no bound.
+type ChunkRanger struct {
+	MinChunkSize int64
+	MaxChunkSize int64
+	Parallelism  int
+}
+
+func NewChunkRanger(minChunkSize int64, maxChunkSize int64, parallelism int) ChunkRanger {
+	return ChunkRanger{
+		MinChunkSize: minChunkSize,
+		MaxChunkSize: maxChunkSize,
+		Parallelism:  parallelism,
+	}
+}
+
+func (r ChunkRanger) BuildRange(contentLength int64) ([]Range, error) {
+	if contentLength <= 0 {
+		return nil, errors.New("content length must be positive")
+	}
+
+	parallelism := int64(r.Parallelism)
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	chunkSize := (contentLength + parallelism - 1) / parallelism
+	if r.MaxChunkSize > 0 && chunkSize > r.MaxChunkSize {
+		chunkSize = r.MaxChunkSize
+	}
+	if r.MinChunkSize > 0 && chunkSize < r.MinChunkSize {
+		chunkSize = r.MinChunkSize
+	}
+
+	var ranges []Range
+	for lower := int64(0); lower < contentLength; lower += chunkSize {
+		upper := lower + chunkSize - 1
+		if upper >= contentLength {
+			upper = contentLength - 1
+		}
+
+		ranges = append(ranges, Range{
+			Lower:      lower,
+			Upper:      upper,
+			HTTPHeader: http.Header{"Range": []string{fmt.Sprintf("bytes=%d-%d", lower, upper)}},
+		})
+	}
+
+	return ranges, nil
+}
@@ -0,0 +1,48 @@
+package download_test
+
+import (
+	"net/http"
+
+	"github.com/pivotal-cf/go-pivnet/v9/download"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ChunkRanger", func() {
+	It("splits the content evenly across the parallelism", func() {
+		r, err := download.NewChunkRanger(0, 0, 3).BuildRange(10)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(r).To(Equal([]download.Range{
+			{Lower: 0, Upper: 3, HTTPHeader: http.Header{"Range": []string{"bytes=0-3"}}},
+			{Lower: 4, Upper: 7, HTTPHeader: http.Header{"Range": []string{"bytes=4-7"}}},
+			{Lower: 8, Upper: 9, HTTPHeader: http.Header{"Range": []string{"bytes=8-9"}}},
+		}))
+	})
+
+	It("fetches a small file in a single range", func() {
+		r, err := download.NewChunkRanger(100, 1000, 10).BuildRange(50)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(r).To(Equal([]download.Range{
+			{Lower: 0, Upper: 49, HTTPHeader: http.Header{"Range": []string{"bytes=0-49"}}},
+		}))
+	})
+
+	It("never builds ranges larger than the max chunk size", func() {
+		r, err := download.NewChunkRanger(1, 25, 2).BuildRange(100)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(r).To(HaveLen(4))
+		for _, byteRange := range r {
+			Expect(byteRange.Upper - byteRange.Lower + 1).To(Equal(int64(25)))
+		}
+		Expect(r[3].Upper).To(Equal(int64(99)))
+	})
+
+	It("returns an error when the content length is not positive", func() {
+		_, err := download.NewChunkRanger(1, 25, 2).BuildRange(0)
+		Expect(err).To(MatchError("content length must be positive"))
+	})
+})
@@ -1,6 +1,8 @@
 package download
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -18,8 +20,11 @@ import (
 	"github.com/pivotal-cf/go-pivnet/v9/logger"
 )
 
-//go:generate counterfeiter -o ./fakes/ranger.go --fake-name Ranger . ranger
-type ranger interface {
+// RangeStrategy splits a file of the given length into the byte ranges that
+// are downloaded separately.
+//
+//go:generate counterfeiter -o ./fakes/ranger.go --fake-name Ranger . RangeStrategy
+type RangeStrategy interface {
 	BuildRange(contentLength int64) ([]Range, error)
 }
 
@@ -39,20 +44,76 @@ type bar interface {
 	Add(totalWritten int) int
 	Kickoff()
 	Finish()
-	NewProxyReader(reader io.Reader) io.Reader
 }
 
 type Client struct {
 	HTTPClient httpClient
-	Ranger     ranger
+	Ranger     RangeStrategy
 	Bar        bar
 	Logger     logger.Logger
 	Timeout    time.Duration
+
+	// Progress receives the progress of downloads. When it is nil progress
+	// is drawn by Bar on the writer passed to Get.
+	Progress ProgressReporter
+
+	// Resume records per-range progress in a sidecar file next to the
+	// download so an interrupted download only fetches the missing bytes
+	// when retried. The partial file is kept between runs, and must not be
+	// truncated.
+	Resume bool
+
+	// ChecksumMismatchAction says what to do with a finished download that
+	// fails checksum verification.
+	ChecksumMismatchAction ChecksumMismatchAction
+
+	// RetryPolicy bounds the retries of failing ranges.
+	RetryPolicy RetryPolicy
+
+	// Throttle limits the bandwidth used by downloads. Nil is unlimited.
+	Throttle *Throttle
+
+	// Limiter, when set, bounds the requests in flight across every download
+	// sharing it, on top of Concurrency.
+	Limiter *Limiter
+
+	// Concurrency is the number of ranges downloaded at once. Zero downloads
+	// every range at once.
+	Concurrency int
+
+	// StreamChunkSize and StreamBufferSize set the size of the ranges fetched
+	// by Stream and how many of them may be held in memory at once.
+	StreamChunkSize  int64
+	StreamBufferSize int
 }
 
+func (c Client) reporter(progressWriter io.Writer) ProgressReporter {
+	if c.Progress != nil {
+		return c.Progress
+	}
+	return barReporter{bar: c.Bar, output: progressWriter}
+}
+
+// errRangesNotSupported is returned for a range request that the file store
+// answered with the whole file.
+var errRangesNotSupported = errors.New("file store does not support range requests")
+
+// PartialSuffix is appended to the download location to name the file that
+// is written to until the download has been verified and renamed into place.
+const PartialSuffix = ".partial"
+
 type FileInfo struct {
 	Name string
 	Mode os.FileMode
+
+	// ProductFileID and SHA256 identify the file being downloaded. When
+	// resuming, saved progress is only reused if they match.
+	ProductFileID int
+	SHA256        string
+
+	// The finished download is verified against SHA256, or MD5 if SHA256 is
+	// empty. No verification is done when both are empty.
+	MD5 string
 }
 
 func NewFileInfo(file *os.File) (*FileInfo, error) {
@@ -74,12 +135,23 @@ func (c Client) Get(
 	downloadLinkFetcher downloadLinkFetcher,
 	progressWriter io.Writer,
 ) error {
+	return c.GetContext(context.Background(), location, downloadLinkFetcher, progressWriter)
+}
+
+// GetContext is like Get but stops every in-flight range request and returns
+// the context's error once ctx is cancelled.
+func (c Client) GetContext(
+	ctx context.Context,
+	location *FileInfo,
+	downloadLinkFetcher downloadLinkFetcher,
+	progressWriter io.Writer,
+) (err error) {
 	contentURL, err := downloadLinkFetcher.NewDownloadLink()
 	if err != nil {
-		return fmt.Errorf("could not create new download link in get: %s", err)
+		return fmt.Errorf("could not create new download link in get: %w", err)
 	}
 
-	req, err := http.NewRequest("HEAD", contentURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "HEAD", contentURL, nil)
 	if err != nil {
 		return fmt.Errorf("failed to construct HEAD request: %s", err)
 	}
@@ -88,97 +160,342 @@ func (c Client) Get(
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to make HEAD request: %s", err)
+		return fmt.Errorf("failed to make HEAD request: %w", err)
 	}
 
 	c.Logger.Debug(fmt.Sprintf("HEAD response content size: %d", resp.ContentLength))
 
 	contentURL = resp.Request.URL.String()
+	contentLength := resp.ContentLength
+	version := newRemoteVersion(resp.Header)
+
+	// Ranges are tried unless the file store says they are not supported, as
+	// many omit Accept-Ranges. A 200 in response to a range request also
+	// falls back to a single stream.
+	singleStream := contentLength == -1 || resp.Header.Get("Accept-Ranges") == "none"
+	if singleStream {
+		c.Logger.Debug("file store does not support range requests, downloading in a single stream")
+	}
+
+	var tracker *resumeTracker
+	if c.Resume && !singleStream {
+		tracker, err = loadResumeTracker(location, contentLength, version.String())
+		if err != nil {
+			return fmt.Errorf("failed to load resume state: %w", err)
+		}
+	}
+
+	var ranges []Range
+	if tracker.resumed() {
+		ranges = tracker.ranges()
+	} else if !singleStream {
+		ranges, err = c.Ranger.BuildRange(contentLength)
+		if err != nil {
+			return fmt.Errorf("failed to construct range: %s", err)
+		}
+
+		err = tracker.start(ranges)
+		if err != nil {
+			return fmt.Errorf("failed to save resume state: %w", err)
+		}
+	}
 
-	if resp.ContentLength == -1 {
-		return fmt.Errorf("failed to find file on remote filestore")
+	completed := tracker.completed()
+	if completed > 0 {
+		c.Logger.Debug(fmt.Sprintf("resuming download with %d of %d bytes already written", completed, contentLength))
 	}
 
-	ranges, err := c.Ranger.BuildRange(resp.ContentLength)
+	if contentLength > 0 {
+		diskStats, err := disk.Usage(path.Dir(location.Name))
+		if err != nil {
+			return fmt.Errorf("failed to get disk free space: %s", err)
+		}
+
+		if diskStats.Free < uint64(contentLength-completed) {
+			return fmt.Errorf("file is too big to fit on this drive: %d bytes required, %d bytes free", uint64(contentLength-completed), diskStats.Free)
+		}
+	}
+
+	partialPath := location.Name + PartialSuffix
+
+	allocate := contentLength
+	if allocate < 0 {
+		allocate = 0
+	}
+
+	partial, err := c.preparePartialFile(location, partialPath, allocate, tracker.resumed())
 	if err != nil {
-		return fmt.Errorf("failed to construct range: %s", err)
+		return err
+	}
+	defer partial.Close()
+
+	reporter := &countingReporter{ProgressReporter: c.reporter(progressWriter)}
+	reporter.Start(allocate)
+	if completed > 0 {
+		reporter.Add(0, contentLength-1, completed)
+	}
+
+	defer func() { reporter.Finish(err) }()
+
+	budget := &retryBudget{policy: c.RetryPolicy}
+
+	if !singleStream {
+		err = c.downloadRanges(ctx, contentURL, version, partialPath, ranges, downloadLinkFetcher, tracker, reporter, budget)
+		if errors.Is(err, errRangesNotSupported) {
+			c.Logger.Debug("file store ignored a range request, downloading in a single stream")
+
+			if removeErr := tracker.remove(); removeErr != nil {
+				return fmt.Errorf("failed to remove resume state: %w", removeErr)
+			}
+			tracker = nil
+
+			reporter.Add(0, contentLength-1, -reporter.written())
+			singleStream = true
+		}
+	}
+
+	if singleStream {
+		progress := rangeProgress{
+			reporter: reporter,
+			lower:    0,
+			upper:    contentLength - 1,
+		}
+
+		err = c.downloadSingleStream(ctx, contentURL, version, partialPath, downloadLinkFetcher, progress, budget)
 	}
 
-	diskStats, err := disk.Usage(path.Dir(location.Name))
 	if err != nil {
-		return fmt.Errorf("failed to get disk free space: %s", err)
+		var changed ErrRemoteFileChanged
+		if tracker != nil && !errors.As(err, &changed) {
+			if flushErr := tracker.flush(); flushErr != nil {
+				c.Logger.Debug(fmt.Sprintf("failed to save resume state: %s", flushErr))
+			}
+		} else {
+			if removeErr := tracker.remove(); removeErr != nil {
+				c.Logger.Debug(fmt.Sprintf("failed to remove resume state: %s", removeErr))
+			}
+			partial.Close()
+			os.Remove(partialPath)
+		}
+		return fmt.Errorf("problem while waiting for chunks to download: %w", err)
 	}
 
-	if diskStats.Free < uint64(resp.ContentLength) {
-		return fmt.Errorf("file is too big to fit on this drive: %d bytes required, %d bytes free", uint64(resp.ContentLength), diskStats.Free)
+	if err := partial.Sync(); err != nil {
+		return fmt.Errorf("failed to sync %s: %s", partialPath, err)
 	}
+	partial.Close()
 
-	c.Bar.SetOutput(progressWriter)
-	c.Bar.SetTotal(resp.ContentLength)
-	c.Bar.Kickoff()
+	if err := tracker.remove(); err != nil {
+		return fmt.Errorf("failed to remove resume state: %w", err)
+	}
 
-	defer c.Bar.Finish()
+	if err := c.verifyChecksum(partialPath, location); err != nil {
+		return err
+	}
 
-	var g errgroup.Group
-	for _, r := range ranges {
-		byteRange := r
+	if err := os.Rename(partialPath, location.Name); err != nil {
+		return fmt.Errorf("failed to move download into place: %s", err)
+	}
 
-		fileWriter, err := os.OpenFile(location.Name, os.O_RDWR, location.Mode)
+	return nil
+}
+
+// preparePartialFile opens the file a download is written to before it is
+// renamed into place, and sizes it to the content length. A partial file is
+// only kept from an earlier run when its progress is being resumed.
+func (c Client) preparePartialFile(location *FileInfo, partialPath string, contentLength int64, resumed bool) (*os.File, error) {
+	mode := location.Mode.Perm()
+	if mode == 0 {
+		mode = 0644
+	}
+
+	partial, err := os.OpenFile(partialPath, os.O_RDWR|os.O_CREATE, mode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s: %s", partialPath, err)
+	}
+
+	if !resumed {
+		err = partial.Truncate(0)
 		if err != nil {
-			return fmt.Errorf("failed to open file %s for writing: %s", location.Name, err)
+			partial.Close()
+			return nil, fmt.Errorf("failed to truncate %s: %s", partialPath, err)
 		}
+	}
 
-		g.Go(func() error {
-			err := c.retryableRequest(contentURL, byteRange.HTTPHeader, fileWriter, byteRange.Lower, downloadLinkFetcher, c.Timeout)
-			if err != nil {
-				return fmt.Errorf("failed during retryable request: %s", err)
+	err = partial.Truncate(contentLength)
+	if err != nil {
+		partial.Close()
+		return nil, fmt.Errorf("failed to allocate %s: %s", partialPath, err)
+	}
+
+	return partial, nil
+}
+
+// downloadRanges fetches ranges into the partial file, at most Concurrency
+// of them at once.
+func (c Client) downloadRanges(ctx context.Context, contentURL string, version remoteVersion, partialPath string, ranges []Range, downloadLinkFetcher downloadLinkFetcher, tracker *resumeTracker, reporter ProgressReporter, budget *retryBudget) error {
+	var pending []int
+	for i, r := range ranges {
+		if r.Lower <= r.Upper {
+			pending = append(pending, i)
+		}
+	}
+
+	workers := c.Concurrency
+	if workers <= 0 || workers > len(pending) {
+		workers = len(pending)
+	}
+
+	queue := make(chan int)
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		defer close(queue)
+		for _, i := range pending {
+			select {
+			case queue <- i:
+			case <-gctx.Done():
+				return gctx.Err()
 			}
+		}
+		return nil
+	})
 
+	for w := 0; w < workers; w++ {
+		g.Go(func() error {
+			for i := range queue {
+				progress := rangeProgress{
+					tracker:  tracker,
+					index:    i,
+					reporter: reporter,
+					lower:    ranges[i].Lower,
+					upper:    ranges[i].Upper,
+				}
+
+				err := c.downloadRange(gctx, contentURL, version, partialPath, ranges[i], downloadLinkFetcher, progress, budget)
+				if err != nil {
+					return err
+				}
+			}
 			return nil
 		})
 	}
 
-	if err := g.Wait(); err != nil {
-		return fmt.Errorf("problem while waiting for chunks to download: %s", err)
+	return g.Wait()
+}
+
+// downloadSingleStream fetches the whole file in one request, for file
+// stores that do not support range requests. A failed attempt starts over
+// from the beginning of the file.
+func (c Client) downloadSingleStream(ctx context.Context, contentURL string, version remoteVersion, partialPath string, downloadLinkFetcher downloadLinkFetcher, progress rangeProgress, budget *retryBudget) error {
+	if err := c.Limiter.acquire(ctx); err != nil {
+		return fmt.Errorf("failed during single stream request: %w", err)
+	}
+	defer c.Limiter.release()
+
+	fileWriter, err := os.OpenFile(partialPath, os.O_RDWR, 0)
+	if err != nil {
+		return fmt.Errorf("failed to open file %s for writing: %s", partialPath, err)
+	}
+
+	wholeFile := NewRange(0, progress.upper, http.Header{})
+
+	err = c.retryableRequest(ctx, contentURL, version, wholeFile, true, fileWriter, downloadLinkFetcher, c.Timeout, progress, budget)
+	if err != nil {
+		return fmt.Errorf("failed during single stream request: %w", err)
+	}
+
+	return nil
+}
+
+func (c Client) downloadRange(ctx context.Context, contentURL string, version remoteVersion, partialPath string, byteRange Range, downloadLinkFetcher downloadLinkFetcher, progress rangeProgress, budget *retryBudget) error {
+	if err := c.Limiter.acquire(ctx); err != nil {
+		return fmt.Errorf("failed during retryable request: %w", err)
+	}
+	defer c.Limiter.release()
+
+	fileWriter, err := os.OpenFile(partialPath, os.O_RDWR, 0)
+	if err != nil {
+		return fmt.Errorf("failed to open file %s for writing: %s", partialPath, err)
+	}
+
+	err = c.retryableRequest(ctx, contentURL, version, byteRange, false, fileWriter, downloadLinkFetcher, c.Timeout, progress, budget)
+	if err != nil {
+		if ctx.Err() != nil && errors.Is(err, ctx.Err()) {
+			return fmt.Errorf("failed during retryable request: %w", err)
+		}
+		return fmt.Errorf("failed during retryable request: %w", ErrRangeFailed{Lower: byteRange.Lower, Upper: byteRange.Upper, Err: err})
 	}
 
 	return nil
 }
 
-func (c Client) retryableRequest(contentURL string, rangeHeader http.Header, fileWriter *os.File, startingByte int64, downloadLinkFetcher downloadLinkFetcher, timeout time.Duration) error {
+func (c Client) retryableRequest(ctx context.Context, contentURL string, version remoteVersion, byteRange Range, wholeFile bool, fileWriter writeSeekCloser, downloadLinkFetcher downloadLinkFetcher, timeout time.Duration, progress rangeProgress, budget *retryBudget) error {
 	currentURL := contentURL
 	defer fileWriter.Close()
 
+	rangeHeader := byteRange.HTTPHeader
+	startingByte := byteRange.Lower
+	recorded := progress.tracker.recorded(progress.index)
+
 	var err error
+	attempt := 0
 Retry:
+	attempt++
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
 	_, err = fileWriter.Seek(startingByte, 0)
 	if err != nil {
 		return fmt.Errorf("failed to seek to correct byte of output file: %s", err)
 	}
 
-	req, err := http.NewRequest("GET", currentURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", currentURL, nil)
 	if err != nil {
 		return fmt.Errorf("could not get new request: %s", err)
 	}
 
 	rangeHeader.Add("Referer", "https://go-pivnet.network.tanzu.vmware.com")
+	if ifRange := version.ifRange(); ifRange != "" && !wholeFile {
+		// a changed file is sent whole instead of the range, and caught below
+		rangeHeader.Set("If-Range", ifRange)
+	}
 	req.Header = rangeHeader
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
 		if netErr, ok := err.(net.Error); ok {
 			if netErr.Temporary() {
+				if retryErr := budget.retry(ctx, attempt, err); retryErr != nil {
+					return retryErr
+				}
+				progress.retry(attempt, err)
 				goto Retry
 			}
 		}
 
-		return fmt.Errorf("download request failed: %s", err)
+		return fmt.Errorf("download request failed: %w", err)
 	}
 
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusForbidden {
 		c.Logger.Debug("received unsuccessful status code: %d", logger.Data{"statusCode": resp.StatusCode})
+		err = budget.refreshLink()
+		if err != nil {
+			return err
+		}
+		cause := fmt.Errorf("during GET unexpected status code was returned: %d", resp.StatusCode)
+		if retryErr := budget.retry(ctx, attempt, cause); retryErr != nil {
+			return retryErr
+		}
+		progress.retry(attempt, cause)
 		currentURL, err = downloadLinkFetcher.NewDownloadLink()
 		if err != nil {
 			return fmt.Errorf("could not get new download link: %s", err)
@@ -188,29 +505,49 @@ Retry:
 		goto Retry
 	}
 
-	if resp.StatusCode != http.StatusPartialContent {
-		return fmt.Errorf("during GET unexpected status code was returned: %d", resp.StatusCode)
+	if err := version.check(resp.Header); err != nil {
+		return err
 	}
 
-	var proxyReader io.Reader
-	proxyReader = c.Bar.NewProxyReader(resp.Body)
+	expectedStatus := http.StatusPartialContent
+	if wholeFile {
+		expectedStatus = http.StatusOK
+	} else if resp.StatusCode == http.StatusOK {
+		return errRangesNotSupported
+	}
+
+	if resp.StatusCode != expectedStatus {
+		return fmt.Errorf("during GET unexpected status code was returned: %d", resp.StatusCode)
+	}
 
 	var timeoutReader io.Reader
-	timeoutReader = gbytes.TimeoutReader(proxyReader, timeout)
+	timeoutReader = gbytes.TimeoutReader(resp.Body, timeout)
 
-	bytesWritten, err := io.Copy(fileWriter, timeoutReader)
+	bytesWritten, err := io.Copy(rangeWriter{w: fileWriter, progress: progress}, c.Throttle.reader(ctx, timeoutReader))
 	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
 		if err == io.ErrUnexpectedEOF || err == gbytes.ErrTimeout {
 			c.Logger.Debug(fmt.Sprintf("retrying %v", err))
-			c.Bar.Add(int(-1 * bytesWritten))
+			progress.rewind(bytesWritten, recorded)
+			if retryErr := budget.retry(ctx, attempt, err); retryErr != nil {
+				return retryErr
+			}
+			progress.retry(attempt, err)
 			goto Retry
 		}
-		oe, _ := err.(*net.OpError)
-		if strings.Contains(oe.Err.Error(), syscall.ECONNRESET.Error()) {
-			c.Bar.Add(int(-1 * bytesWritten))
+		var oe *net.OpError
+		if errors.As(err, &oe) && strings.Contains(oe.Err.Error(), syscall.ECONNRESET.Error()) {
+			progress.rewind(bytesWritten, recorded)
+			if retryErr := budget.retry(ctx, attempt, err); retryErr != nil {
+				return retryErr
+			}
+			progress.retry(attempt, err)
 			goto Retry
 		}
-		return fmt.Errorf("failed to write file during io.Copy: %s", err)
+		return fmt.Errorf("failed to write file during io.Copy: %w", err)
 	}
 
 	return nil
@@ -1,6 +1,9 @@
 package download_test
 
 import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io"
@@ -10,6 +13,8 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -48,6 +53,19 @@ func (ne NetError) Timeout() bool {
 	return true
 }
 
+type NotifyingReader struct {
+	io.Reader
+	onEOF func()
+}
+
+func (r NotifyingReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if err == io.EOF {
+		r.onEOF()
+	}
+	return n, err
+}
+
 type ReaderThatDoesntRead struct{}
 
 func (r ReaderThatDoesntRead) Read(p []byte) (int, error) {
@@ -56,6 +74,19 @@ func (r ReaderThatDoesntRead) Read(p []byte) (int, error) {
 	}
 }
 
+// ReaderThatWaitsForCancel blocks until its request is cancelled, then calls
+// cancelled and fails with the context's error.
+type ReaderThatWaitsForCancel struct {
+	ctx       context.Context
+	cancelled func()
+}
+
+func (r ReaderThatWaitsForCancel) Read(p []byte) (int, error) {
+	<-r.ctx.Done()
+	r.cancelled()
+	return 0, r.ctx.Err()
+}
+
 var _ = Describe("Downloader", func() {
 	var (
 		httpClient          *fakes.HTTPClient
@@ -69,8 +100,6 @@ var _ = Describe("Downloader", func() {
 		ranger = &fakes.Ranger{}
 		bar = &fakes.Bar{}
 
-		bar.NewProxyReaderStub = func(reader io.Reader) io.Reader { return reader }
-
 		downloadLinkFetcher = &fakes.DownloadLinkFetcher{}
 		downloadLinkFetcher.NewDownloadLinkStub = func() (string, error) {
 			return "https://example.com/some-file", nil
@@ -142,10 +171,11 @@ var _ = Describe("Downloader", func() {
 			err = downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
 			Expect(err).NotTo(HaveOccurred())
 
-			content, err := ioutil.ReadAll(tmpFile)
+			content, err := ioutil.ReadFile(tmpFile.Name())
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(string(content)).To(Equal("fake product content"))
+			Expect(tmpFile.Name() + download.PartialSuffix).NotTo(BeAnExistingFile())
 
 			Expect(ranger.BuildRangeCallCount()).To(Equal(1))
 			Expect(ranger.BuildRangeArgsForCall(0)).To(Equal(int64(10)))
@@ -188,6 +218,758 @@ var _ = Describe("Downloader", func() {
 		})
 	})
 
+	Describe("GetContext", func() {
+		Context("when the context is cancelled while chunks are downloading", func() {
+			It("stops all in-flight chunks and returns the context error", func() {
+				ranger.BuildRangeReturns([]download.Range{
+					download.NewRange(0, 9, http.Header{"Range": []string{"bytes=0-9"}}),
+					download.NewRange(10, 19, http.Header{"Range": []string{"bytes=10-19"}}),
+				}, nil)
+
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+
+				var (
+					m                   sync.Mutex
+					rangeRequests       int
+					requestsAfterCancel int
+					cancelledRequests   int
+				)
+
+				httpClient.DoStub = func(req *http.Request) (*http.Response, error) {
+					if req.Method == "HEAD" {
+						return &http.Response{
+							StatusCode:    http.StatusOK,
+							ContentLength: 20,
+							Request: &http.Request{
+								URL: &url.URL{
+									Scheme: "https",
+									Host:   "example.com",
+									Path:   "some-file",
+								},
+							},
+						}, nil
+					}
+
+					// cancel once both ranges are in flight
+					m.Lock()
+					if ctx.Err() != nil {
+						requestsAfterCancel++
+					}
+					rangeRequests++
+					if rangeRequests == 2 {
+						cancel()
+					}
+					m.Unlock()
+
+					return &http.Response{
+						StatusCode: http.StatusPartialContent,
+						Body: ioutil.NopCloser(ReaderThatWaitsForCancel{
+							ctx: req.Context(),
+							cancelled: func() {
+								m.Lock()
+								cancelledRequests++
+								m.Unlock()
+							},
+						}),
+					}, nil
+				}
+
+				downloader := download.Client{
+					Logger:      &loggerfakes.FakeLogger{},
+					HTTPClient:  httpClient,
+					Ranger:      ranger,
+					Bar:         bar,
+					Timeout:     time.Second,
+					Concurrency: 2,
+				}
+
+				tmpFile, err := ioutil.TempFile("", "")
+				Expect(err).NotTo(HaveOccurred())
+				defer os.Remove(tmpFile.Name())
+
+				tmpLocation, err := download.NewFileInfo(tmpFile)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = downloader.GetContext(ctx, tmpLocation, downloadLinkFetcher, GinkgoWriter)
+				Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+				Expect(httpClient.DoCallCount()).To(BeNumerically("<=", 3))
+
+				m.Lock()
+				defer m.Unlock()
+				Expect(rangeRequests).To(Equal(2))
+				Expect(cancelledRequests).To(Equal(2))
+				Expect(requestsAfterCancel).To(Equal(0))
+			})
+		})
+
+		Context("when the concurrency is limited", func() {
+			It("downloads no more than that many ranges at once", func() {
+				const content = "the quick brown fox jumps over the lazy dog"
+
+				downloader := download.Client{
+					Logger:      &loggerfakes.FakeLogger{},
+					HTTPClient:  httpClient,
+					Ranger:      download.NewChunkRanger(4, 4, 1),
+					Bar:         bar,
+					Timeout:     time.Second,
+					Concurrency: 2,
+				}
+
+				var (
+					m           sync.Mutex
+					inFlight    int
+					maxInFlight int
+				)
+				rangePattern := regexp.MustCompile(`bytes=(\d+)-(\d+)`)
+
+				httpClient.DoStub = func(req *http.Request) (*http.Response, error) {
+					if req.Method == "HEAD" {
+						return &http.Response{
+							StatusCode:    http.StatusOK,
+							ContentLength: int64(len(content)),
+							Request: &http.Request{
+								URL: &url.URL{Scheme: "https", Host: "example.com", Path: "some-file"},
+							},
+						}, nil
+					}
+
+					m.Lock()
+					inFlight++
+					if inFlight > maxInFlight {
+						maxInFlight = inFlight
+					}
+					m.Unlock()
+
+					time.Sleep(time.Millisecond)
+
+					m.Lock()
+					inFlight--
+					m.Unlock()
+
+					matches := rangePattern.FindStringSubmatch(req.Header.Get("Range"))
+					lower, _ := strconv.Atoi(matches[1])
+					upper, _ := strconv.Atoi(matches[2])
+
+					return &http.Response{
+						StatusCode: http.StatusPartialContent,
+						Body:       ioutil.NopCloser(strings.NewReader(content[lower : upper+1])),
+					}, nil
+				}
+
+				tmpFile, err := ioutil.TempFile("", "")
+				Expect(err).NotTo(HaveOccurred())
+				defer os.Remove(tmpFile.Name())
+
+				tmpLocation, err := download.NewFileInfo(tmpFile)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = downloader.GetContext(context.Background(), tmpLocation, downloadLinkFetcher, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				written, err := ioutil.ReadFile(tmpFile.Name())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(written)).To(Equal(content))
+
+				Expect(httpClient.DoCallCount()).To(Equal(1 + (len(content)+3)/4))
+				Expect(maxInFlight).To(BeNumerically("<=", 2))
+			})
+		})
+	})
+
+	Describe("single stream fallback", func() {
+		const content = "fake product content"
+
+		var (
+			headResponse *http.Response
+			getResponses []*http.Response
+			rangeHeaders []string
+			downloader   download.Client
+			tmpLocation  *download.FileInfo
+		)
+
+		BeforeEach(func() {
+			ranger.BuildRangeReturns([]download.Range{
+				download.NewRange(0, 9, http.Header{"Range": []string{"bytes=0-9"}}),
+				download.NewRange(10, 19, http.Header{"Range": []string{"bytes=10-19"}}),
+			}, nil)
+
+			headResponse = &http.Response{
+				StatusCode:    http.StatusOK,
+				ContentLength: int64(len(content)),
+				Header:        http.Header{},
+				Request: &http.Request{
+					URL: &url.URL{Scheme: "https", Host: "example.com", Path: "some-file"},
+				},
+			}
+			getResponses = nil
+			rangeHeaders = nil
+
+			var m sync.Mutex
+			httpClient.DoStub = func(req *http.Request) (*http.Response, error) {
+				if req.Method == "HEAD" {
+					return headResponse, nil
+				}
+
+				m.Lock()
+				defer m.Unlock()
+				rangeHeaders = append(rangeHeaders, req.Header.Get("Range"))
+
+				if len(getResponses) > 0 {
+					resp := getResponses[0]
+					getResponses = getResponses[1:]
+					return resp, nil
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(strings.NewReader(content)),
+				}, nil
+			}
+
+			downloader = download.Client{
+				Logger:     &loggerfakes.FakeLogger{},
+				HTTPClient: httpClient,
+				Ranger:     ranger,
+				Bar:        bar,
+				Timeout:    time.Second,
+			}
+
+			tmpFile, err := ioutil.TempFile("", "")
+			Expect(err).NotTo(HaveOccurred())
+			defer tmpFile.Close()
+
+			tmpLocation, err = download.NewFileInfo(tmpFile)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.Remove(tmpLocation.Name)
+			os.Remove(tmpLocation.Name + download.PartialSuffix)
+		})
+
+		expectContent := func() {
+			written, err := ioutil.ReadFile(tmpLocation.Name)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(written)).To(Equal(content))
+		}
+
+		Context("when the HEAD response has no content length", func() {
+			BeforeEach(func() {
+				headResponse.ContentLength = -1
+			})
+
+			It("downloads the file in a single request", func() {
+				err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				expectContent()
+				Expect(rangeHeaders).To(Equal([]string{""}))
+				Expect(ranger.BuildRangeCallCount()).To(Equal(0))
+				Expect(bar.AddArgsForCall(0)).To(Equal(len(content)))
+			})
+		})
+
+		Context("when the file store does not accept ranges", func() {
+			BeforeEach(func() {
+				headResponse.Header.Set("Accept-Ranges", "none")
+			})
+
+			It("downloads the file in a single request", func() {
+				err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				expectContent()
+				Expect(rangeHeaders).To(Equal([]string{""}))
+			})
+
+			It("starts over when the stream is interrupted", func() {
+				getResponses = []*http.Response{
+					{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(io.MultiReader(strings.NewReader("fake"), EOFReader{})),
+					},
+				}
+
+				err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				expectContent()
+				Expect(rangeHeaders).To(Equal([]string{"", ""}))
+			})
+
+			It("returns an error for an unexpected status code", func() {
+				getResponses = []*http.Response{
+					{
+						StatusCode: http.StatusInternalServerError,
+						Body:       ioutil.NopCloser(strings.NewReader("")),
+					},
+				}
+
+				err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+				Expect(err).To(MatchError(ContainSubstring("during GET unexpected status code was returned: 500")))
+				Expect(tmpLocation.Name + download.PartialSuffix).NotTo(BeAnExistingFile())
+			})
+		})
+
+		Context("when a range request is answered with the whole file", func() {
+			It("falls back to a single request and takes back the progress of the ranges", func() {
+				downloader.Concurrency = 1
+				getResponses = []*http.Response{
+					{
+						StatusCode: http.StatusPartialContent,
+						Body:       ioutil.NopCloser(strings.NewReader(content[:10])),
+					},
+				}
+
+				var (
+					m     sync.Mutex
+					added int64
+				)
+				downloader.Progress = download.ProgressFunc(func(event download.ProgressEvent) {
+					m.Lock()
+					defer m.Unlock()
+					added += event.Bytes
+				})
+
+				err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				expectContent()
+				Expect(rangeHeaders).To(Equal([]string{"bytes=0-9", "bytes=10-19", ""}))
+				Expect(added).To(Equal(int64(len(content))))
+			})
+		})
+	})
+
+	Describe("remote file versions", func() {
+		var (
+			headHeader  http.Header
+			changeAfter int
+			requests    []*http.Request
+			downloader  download.Client
+			tmpLocation *download.FileInfo
+		)
+
+		BeforeEach(func() {
+			ranger.BuildRangeReturns([]download.Range{
+				download.NewRange(0, 9, http.Header{"Range": []string{"bytes=0-9"}}),
+				download.NewRange(10, 19, http.Header{"Range": []string{"bytes=10-19"}}),
+			}, nil)
+
+			headHeader = http.Header{"Etag": []string{`"v1"`}}
+			changeAfter = -1
+			requests = nil
+
+			var m sync.Mutex
+			httpClient.DoStub = func(req *http.Request) (*http.Response, error) {
+				if req.Method == "HEAD" {
+					return &http.Response{
+						StatusCode:    http.StatusOK,
+						ContentLength: 20,
+						Header:        headHeader,
+						Request: &http.Request{
+							URL: &url.URL{Scheme: "https", Host: "example.com", Path: "some-file"},
+						},
+					}, nil
+				}
+
+				m.Lock()
+				defer m.Unlock()
+				requests = append(requests, req)
+
+				if changeAfter >= 0 && len(requests) > changeAfter {
+					// the file was re-uploaded, so If-Range no longer matches
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Header:     http.Header{"Etag": []string{`"v2"`}},
+						Body:       ioutil.NopCloser(strings.NewReader("new product content!")),
+					}, nil
+				}
+
+				body := "fake produ"
+				if req.Header.Get("Range") == "bytes=10-19" {
+					body = "ct content"
+				}
+				return &http.Response{
+					StatusCode: http.StatusPartialContent,
+					Header:     headHeader,
+					Body:       ioutil.NopCloser(strings.NewReader(body)),
+				}, nil
+			}
+
+			downloader = download.Client{
+				Logger:      &loggerfakes.FakeLogger{},
+				HTTPClient:  httpClient,
+				Ranger:      ranger,
+				Bar:         bar,
+				Timeout:     time.Second,
+				Concurrency: 1,
+			}
+
+			tmpFile, err := ioutil.TempFile("", "")
+			Expect(err).NotTo(HaveOccurred())
+			defer tmpFile.Close()
+
+			tmpLocation, err = download.NewFileInfo(tmpFile)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.Remove(tmpLocation.Name)
+			os.Remove(tmpLocation.Name + download.PartialSuffix)
+			os.Remove(tmpLocation.Name + download.ResumeStateSuffix)
+		})
+
+		It("sends the ETag from the HEAD request in If-Range", func() {
+			err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(requests).To(HaveLen(2))
+			for _, req := range requests {
+				Expect(req.Header.Get("If-Range")).To(Equal(`"v1"`))
+			}
+		})
+
+		It("sends Last-Modified in If-Range when the ETag is weak", func() {
+			headHeader = http.Header{
+				"Etag":          []string{`W/"v1"`},
+				"Last-Modified": []string{"Wed, 21 Oct 2015 07:28:00 GMT"},
+			}
+
+			err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(requests[0].Header.Get("If-Range")).To(Equal("Wed, 21 Oct 2015 07:28:00 GMT"))
+		})
+
+		Context("when the file changes during the download", func() {
+			BeforeEach(func() {
+				changeAfter = 1
+			})
+
+			It("returns an ErrRemoteFileChanged and discards the partial file", func() {
+				err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+
+				var changed download.ErrRemoteFileChanged
+				Expect(errors.As(err, &changed)).To(BeTrue())
+				Expect(changed.Expected).To(Equal(`"v1"`))
+				Expect(changed.Actual).To(Equal(`"v2"`))
+				Expect(err).To(MatchError(ContainSubstring(`remote file changed during download: expected version "v1", got "v2"`)))
+
+				Expect(requests).To(HaveLen(2))
+				Expect(tmpLocation.Name + download.PartialSuffix).NotTo(BeAnExistingFile())
+			})
+
+			It("discards the resume state so the next download starts over", func() {
+				downloader.Resume = true
+
+				err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+				Expect(err).To(HaveOccurred())
+
+				Expect(tmpLocation.Name + download.PartialSuffix).NotTo(BeAnExistingFile())
+				Expect(tmpLocation.Name + download.ResumeStateSuffix).NotTo(BeAnExistingFile())
+			})
+		})
+	})
+
+	Describe("Resume", func() {
+		var (
+			downloader   download.Client
+			tmpLocation  *download.FileInfo
+			failSecond   bool
+			firstDone    chan struct{}
+			rangeHeaders []string
+			etag         string
+			m            *sync.Mutex
+		)
+
+		BeforeEach(func() {
+			ranger.BuildRangeReturns([]download.Range{
+				download.NewRange(0, 9, http.Header{"Range": []string{"bytes=0-9"}}),
+				download.NewRange(10, 19, http.Header{"Range": []string{"bytes=10-19"}}),
+			}, nil)
+
+			failSecond = true
+			firstDone = make(chan struct{})
+			closeFirstDone := &sync.Once{}
+			rangeHeaders = nil
+			etag = `"v1"`
+			m = &sync.Mutex{}
+
+			httpClient.DoStub = func(req *http.Request) (*http.Response, error) {
+				if req.Method == "HEAD" {
+					return &http.Response{
+						StatusCode:    http.StatusOK,
+						ContentLength: 20,
+						Header:        http.Header{"Etag": []string{etag}},
+						Request: &http.Request{
+							URL: &url.URL{Scheme: "https", Host: "example.com", Path: "some-file"},
+						},
+					}, nil
+				}
+
+				m.Lock()
+				rangeHeaders = append(rangeHeaders, req.Header.Get("Range"))
+				m.Unlock()
+
+				switch req.Header.Get("Range") {
+				case "bytes=0-9":
+					return &http.Response{
+						StatusCode: http.StatusPartialContent,
+						Body: ioutil.NopCloser(NotifyingReader{
+							Reader: strings.NewReader("fake produ"),
+							onEOF:  func() { closeFirstDone.Do(func() { close(firstDone) }) },
+						}),
+					}, nil
+				default:
+					if failSecond {
+						<-firstDone
+						return &http.Response{
+							StatusCode: http.StatusInternalServerError,
+							Body:       ioutil.NopCloser(strings.NewReader("")),
+						}, nil
+					}
+					return &http.Response{
+						StatusCode: http.StatusPartialContent,
+						Body:       ioutil.NopCloser(strings.NewReader("ct content")),
+					}, nil
+				}
+			}
+
+			downloader = download.Client{
+				Logger:     &loggerfakes.FakeLogger{},
+				HTTPClient: httpClient,
+				Ranger:     ranger,
+				Bar:        bar,
+				Timeout:    time.Second,
+				Resume:     true,
+			}
+
+			tmpFile, err := ioutil.TempFile("", "")
+			Expect(err).NotTo(HaveOccurred())
+			defer tmpFile.Close()
+
+			tmpLocation, err = download.NewFileInfo(tmpFile)
+			Expect(err).NotTo(HaveOccurred())
+			tmpLocation.ProductFileID = 1234
+			tmpLocation.SHA256 = fmt.Sprintf("%x", sha256.Sum256([]byte("fake product content")))
+		})
+
+		AfterEach(func() {
+			os.Remove(tmpLocation.Name)
+			os.Remove(tmpLocation.Name + download.PartialSuffix)
+			os.Remove(tmpLocation.Name + download.ResumeStateSuffix)
+		})
+
+		It("only fetches the ranges missing from an interrupted download", func() {
+			err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+			Expect(err).To(HaveOccurred())
+			Expect(tmpLocation.Name + download.ResumeStateSuffix).To(BeAnExistingFile())
+			Expect(tmpLocation.Name + download.ResumeStateSuffix + ".tmp").NotTo(BeAnExistingFile())
+			Expect(tmpLocation.Name + download.PartialSuffix).To(BeAnExistingFile())
+
+			failSecond = false
+			rangeHeaders = nil
+
+			err = downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ranger.BuildRangeCallCount()).To(Equal(1))
+			Expect(rangeHeaders).To(Equal([]string{"bytes=10-19"}))
+			Expect(bar.AddArgsForCall(0)).To(Equal(10))
+
+			content, err := ioutil.ReadFile(tmpLocation.Name)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("fake product content"))
+
+			Expect(tmpLocation.Name + download.ResumeStateSuffix).NotTo(BeAnExistingFile())
+		})
+
+		It("starts over when the saved state is for a different file", func() {
+			err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+			Expect(err).To(HaveOccurred())
+
+			failSecond = false
+			rangeHeaders = nil
+			tmpLocation.ProductFileID = 5678
+
+			err = downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ranger.BuildRangeCallCount()).To(Equal(2))
+			Expect(rangeHeaders).To(ConsistOf("bytes=0-9", "bytes=10-19"))
+		})
+
+		It("starts over when the remote file has changed", func() {
+			err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+			Expect(err).To(HaveOccurred())
+
+			failSecond = false
+			rangeHeaders = nil
+			etag = `"v2"`
+
+			err = downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ranger.BuildRangeCallCount()).To(Equal(2))
+			Expect(rangeHeaders).To(ConsistOf("bytes=0-9", "bytes=10-19"))
+		})
+
+		It("starts over when the file was truncated", func() {
+			err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+			Expect(err).To(HaveOccurred())
+
+			Expect(os.Truncate(tmpLocation.Name+download.PartialSuffix, 0)).To(Succeed())
+
+			failSecond = false
+			rangeHeaders = nil
+
+			err = downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ranger.BuildRangeCallCount()).To(Equal(2))
+			Expect(rangeHeaders).To(ConsistOf("bytes=0-9", "bytes=10-19"))
+		})
+	})
+
+	Describe("checksum verification", func() {
+		var (
+			downloader  download.Client
+			tmpLocation *download.FileInfo
+		)
+
+		BeforeEach(func() {
+			ranger.BuildRangeReturns([]download.Range{
+				download.NewRange(0, 19, http.Header{"Range": []string{"bytes=0-19"}}),
+			}, nil)
+
+			httpClient.DoStub = func(req *http.Request) (*http.Response, error) {
+				if req.Method == "HEAD" {
+					return &http.Response{
+						StatusCode:    http.StatusOK,
+						ContentLength: 20,
+						Request: &http.Request{
+							URL: &url.URL{Scheme: "https", Host: "example.com", Path: "some-file"},
+						},
+					}, nil
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusPartialContent,
+					Body:       ioutil.NopCloser(strings.NewReader("fake product content")),
+				}, nil
+			}
+
+			downloader = download.Client{
+				Logger:     &loggerfakes.FakeLogger{},
+				HTTPClient: httpClient,
+				Ranger:     ranger,
+				Bar:        bar,
+				Timeout:    time.Second,
+			}
+
+			tmpFile, err := ioutil.TempFile("", "")
+			Expect(err).NotTo(HaveOccurred())
+			defer tmpFile.Close()
+
+			tmpLocation, err = download.NewFileInfo(tmpFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.Remove(tmpLocation.Name)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			os.Remove(tmpLocation.Name)
+			os.Remove(tmpLocation.Name + download.PartialSuffix)
+			os.Remove(tmpLocation.Name + download.QuarantineSuffix)
+			os.Remove(tmpLocation.Name + download.ResumeStateSuffix)
+		})
+
+		It("succeeds when the SHA256 matches", func() {
+			tmpLocation.SHA256 = fmt.Sprintf("%X", sha256.Sum256([]byte("fake product content")))
+
+			err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("falls back to MD5 when there is no SHA256", func() {
+			tmpLocation.MD5 = "bad-md5"
+
+			err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+
+			var mismatch download.ErrChecksumMismatch
+			Expect(errors.As(err, &mismatch)).To(BeTrue())
+			Expect(mismatch).To(Equal(download.ErrChecksumMismatch{
+				Algorithm: "md5",
+				Expected:  "bad-md5",
+				Actual:    fmt.Sprintf("%x", md5.Sum([]byte("fake product content"))),
+			}))
+			Expect(tmpLocation.Name).NotTo(BeAnExistingFile())
+		})
+
+		Context("when the SHA256 does not match", func() {
+			BeforeEach(func() {
+				tmpLocation.SHA256 = "bad-sha256"
+				tmpLocation.MD5 = fmt.Sprintf("%x", md5.Sum([]byte("fake product content")))
+			})
+
+			It("returns an ErrChecksumMismatch and keeps the partial file when resuming", func() {
+				downloader.Resume = true
+
+				err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+
+				var mismatch download.ErrChecksumMismatch
+				Expect(errors.As(err, &mismatch)).To(BeTrue())
+				Expect(mismatch.Algorithm).To(Equal("sha256"))
+				Expect(mismatch.Expected).To(Equal("bad-sha256"))
+				Expect(mismatch.Actual).To(Equal(fmt.Sprintf("%x", sha256.Sum256([]byte("fake product content")))))
+				Expect(mismatch.Path).To(Equal(tmpLocation.Name + download.PartialSuffix))
+				Expect(mismatch.Path).To(BeAnExistingFile())
+				Expect(tmpLocation.Name).NotTo(BeAnExistingFile())
+				Expect(tmpLocation.Name + download.ResumeStateSuffix).NotTo(BeAnExistingFile())
+			})
+
+			It("returns an ErrChecksumMismatch and deletes the partial file when not resuming", func() {
+				err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+
+				var mismatch download.ErrChecksumMismatch
+				Expect(errors.As(err, &mismatch)).To(BeTrue())
+				Expect(mismatch.Algorithm).To(Equal("sha256"))
+				Expect(mismatch.Path).To(BeEmpty())
+				Expect(tmpLocation.Name).NotTo(BeAnExistingFile())
+				Expect(tmpLocation.Name + download.PartialSuffix).NotTo(BeAnExistingFile())
+			})
+
+			It("deletes the file when asked to", func() {
+				downloader.ChecksumMismatchAction = download.ChecksumMismatchDelete
+
+				err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+
+				var mismatch download.ErrChecksumMismatch
+				Expect(errors.As(err, &mismatch)).To(BeTrue())
+				Expect(mismatch.Path).To(BeEmpty())
+				Expect(tmpLocation.Name).NotTo(BeAnExistingFile())
+				Expect(tmpLocation.Name + download.PartialSuffix).NotTo(BeAnExistingFile())
+			})
+
+			It("quarantines the file when asked to", func() {
+				downloader.ChecksumMismatchAction = download.ChecksumMismatchQuarantine
+
+				err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+
+				var mismatch download.ErrChecksumMismatch
+				Expect(errors.As(err, &mismatch)).To(BeTrue())
+				Expect(mismatch.Path).To(Equal(tmpLocation.Name + download.QuarantineSuffix))
+				Expect(tmpLocation.Name).NotTo(BeAnExistingFile())
+				Expect(tmpLocation.Name + download.PartialSuffix).NotTo(BeAnExistingFile())
+
+				content, err := ioutil.ReadFile(mismatch.Path)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(content)).To(Equal("fake product content"))
+			})
+		})
+	})
+
 	Context("when a retryable error occurs", func() {
 		var (
 			responses      []*http.Response
@@ -254,14 +1036,15 @@ var _ = Describe("Downloader", func() {
 			})
 
 			It("successfully retries the download", func() {
-				stats, err := tmpFile.Stat()
+				stats, err := os.Stat(tmpFile.Name())
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(stats.Size()).To(BeNumerically(">", 0))
 
-				Expect(bar.AddArgsForCall(0)).To(Equal(-4))
+				Expect(bar.AddArgsForCall(0)).To(Equal(4))
+				Expect(bar.AddArgsForCall(1)).To(Equal(-4))
 
-				content, err := ioutil.ReadAll(tmpFile)
+				content, err := ioutil.ReadFile(tmpFile.Name())
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(string(content)).To(Equal("something"))
@@ -283,7 +1066,7 @@ var _ = Describe("Downloader", func() {
 			})
 
 			It("successfully retries the download", func() {
-				stats, err := tmpFile.Stat()
+				stats, err := os.Stat(tmpFile.Name())
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(stats.Size()).To(BeNumerically(">", 0))
@@ -308,13 +1091,14 @@ var _ = Describe("Downloader", func() {
 			})
 
 			It("successfully retries the download", func() {
-				stats, err := tmpFile.Stat()
+				stats, err := os.Stat(tmpFile.Name())
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(stats.Size()).To(BeNumerically(">", 0))
-				Expect(bar.AddArgsForCall(0)).To(Equal(-4))
+				Expect(bar.AddArgsForCall(0)).To(Equal(4))
+				Expect(bar.AddArgsForCall(1)).To(Equal(-4))
 
-				content, err := ioutil.ReadAll(tmpFile)
+				content, err := ioutil.ReadFile(tmpFile.Name())
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(string(content)).To(Equal("something"))
@@ -339,13 +1123,14 @@ var _ = Describe("Downloader", func() {
 			})
 
 			It("retries", func() {
-				stats, err := tmpFile.Stat()
+				stats, err := os.Stat(tmpFile.Name())
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(stats.Size()).To(BeNumerically(">", 0))
-				Expect(bar.AddArgsForCall(0)).To(Equal(-4))
+				Expect(bar.AddArgsForCall(0)).To(Equal(4))
+				Expect(bar.AddArgsForCall(1)).To(Equal(-4))
 
-				content, err := ioutil.ReadAll(tmpFile)
+				content, err := ioutil.ReadFile(tmpFile.Name())
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(string(content)).To(Equal("something"))
@@ -353,54 +1138,126 @@ var _ = Describe("Downloader", func() {
 		})
 	})
 
-	Context("when an error occurs", func() {
-		Context("when the disk is out of memory", func() {
-			It("returns an error", func() {
-				tooBig := int64(math.MaxInt64)
-				responses := []*http.Response{
-					{
+	Context("when retries are bounded by a retry policy", func() {
+		var (
+			downloader  download.Client
+			tmpLocation *download.FileInfo
+			getStub     func(req *http.Request) (*http.Response, error)
+		)
+
+		BeforeEach(func() {
+			ranger.BuildRangeReturns([]download.Range{
+				download.NewRange(0, 9, http.Header{"Range": []string{"bytes=0-9"}}),
+				download.NewRange(10, 19, http.Header{"Range": []string{"bytes=10-19"}}),
+			}, nil)
+
+			httpClient.DoStub = func(req *http.Request) (*http.Response, error) {
+				if req.Method == "HEAD" {
+					return &http.Response{
+						StatusCode:    http.StatusOK,
+						ContentLength: 20,
 						Request: &http.Request{
-							URL: &url.URL{
-								Scheme: "https",
-								Host:   "example.com",
-								Path:   "some-file",
-							},
+							URL: &url.URL{Scheme: "https", Host: "example.com", Path: "some-file"},
 						},
-						ContentLength: tooBig,
-					},
+					}, nil
 				}
-				errors := []error{nil, nil}
 
-				httpClient.DoStub = func(req *http.Request) (*http.Response, error) {
-					count := httpClient.DoCallCount() - 1
-					return responses[count], errors[count]
-				}
+				return getStub(req)
+			}
 
-				downloader := download.Client{
-					Logger:     &loggerfakes.FakeLogger{},
-					HTTPClient: httpClient,
-					Ranger:     ranger,
-					Bar:        bar,
-					Timeout:    5 * time.Millisecond,
+			downloader = download.Client{
+				Logger:     &loggerfakes.FakeLogger{},
+				HTTPClient: httpClient,
+				Ranger:     ranger,
+				Bar:        bar,
+				Timeout:    time.Second,
+				RetryPolicy: download.RetryPolicy{
+					MaxAttemptsPerChunk: 3,
+					BaseBackoff:         time.Millisecond,
+					MaxBackoff:          2 * time.Millisecond,
+				},
+			}
+
+			tmpFile, err := ioutil.TempFile("", "")
+			Expect(err).NotTo(HaveOccurred())
+			defer tmpFile.Close()
+
+			tmpLocation, err = download.NewFileInfo(tmpFile)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.Remove(tmpLocation.Name)
+		})
+
+		It("gives up on a range after the maximum number of attempts", func() {
+			var m sync.Mutex
+			attempts := map[string]int{}
+			getStub = func(req *http.Request) (*http.Response, error) {
+				m.Lock()
+				attempts[req.Header.Get("Range")]++
+				m.Unlock()
+
+				if req.Header.Get("Range") == "bytes=10-19" {
+					return &http.Response{
+						StatusCode: http.StatusPartialContent,
+						Body:       ioutil.NopCloser(io.MultiReader(strings.NewReader("some"), EOFReader{})),
+					}, nil
 				}
+				return &http.Response{
+					StatusCode: http.StatusPartialContent,
+					Body:       ioutil.NopCloser(strings.NewReader("fake produ")),
+				}, nil
+			}
 
-				file, err := ioutil.TempFile("", "")
-				Expect(err).NotTo(HaveOccurred())
+			err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
 
-				location, err := download.NewFileInfo(file)
-				Expect(err).NotTo(HaveOccurred())
+			var rangeErr download.ErrRangeFailed
+			Expect(errors.As(err, &rangeErr)).To(BeTrue())
+			Expect(rangeErr.Lower).To(Equal(int64(10)))
+			Expect(rangeErr.Upper).To(Equal(int64(19)))
+			Expect(errors.Is(err, io.ErrUnexpectedEOF)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("range bytes=10-19: gave up after 3 attempts"))
+			Expect(attempts["bytes=10-19"]).To(Equal(3))
+		})
 
-				err = downloader.Get(location, downloadLinkFetcher, GinkgoWriter)
-				Expect(err).To(MatchError(ContainSubstring("file is too big to fit on this drive:")))
-				Expect(err).To(MatchError(ContainSubstring("bytes required")))
-				Expect(err).To(MatchError(ContainSubstring("bytes free")))
-			})
+		It("shares the retry budget between all ranges of a download", func() {
+			downloader.RetryPolicy.MaxAttemptsPerChunk = 0
+			downloader.RetryPolicy.MaxRetries = 4
+
+			getStub = func(req *http.Request) (*http.Response, error) {
+				return nil, NetError{errors.New("whoops")}
+			}
+
+			err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+			Expect(err).To(MatchError(ContainSubstring("gave up after 4 retries of the download")))
+			Expect(httpClient.DoCallCount()).To(BeNumerically("<=", 1+4+2))
 		})
 
-		Context("when content length is -1", func() {
-			It("returns an error", func() {
-				invalidLength := int64(-1)
+		It("stops fetching new download links after the maximum number of refreshes", func() {
+			downloader.RetryPolicy.MaxAttemptsPerChunk = 0
+			downloader.RetryPolicy.MaxLinkRefreshes = 2
+			ranger.BuildRangeReturns([]download.Range{
+				download.NewRange(0, 19, http.Header{"Range": []string{"bytes=0-19"}}),
+			}, nil)
 
+			getStub = func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusForbidden,
+					Body:       ioutil.NopCloser(strings.NewReader("")),
+				}, nil
+			}
+
+			err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+			Expect(err).To(MatchError(ContainSubstring("download link was refreshed 2 times and is still forbidden")))
+			Expect(downloadLinkFetcher.NewDownloadLinkCallCount()).To(Equal(1 + 2))
+		})
+	})
+
+	Context("when an error occurs", func() {
+		Context("when the disk is out of memory", func() {
+			It("returns an error", func() {
+				tooBig := int64(math.MaxInt64)
 				responses := []*http.Response{
 					{
 						Request: &http.Request{
@@ -410,7 +1267,7 @@ var _ = Describe("Downloader", func() {
 								Path:   "some-file",
 							},
 						},
-						ContentLength: invalidLength,
+						ContentLength: tooBig,
 					},
 				}
 				errors := []error{nil, nil}
@@ -435,7 +1292,9 @@ var _ = Describe("Downloader", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				err = downloader.Get(location, downloadLinkFetcher, GinkgoWriter)
-				Expect(err).To(MatchError(ContainSubstring("failed to find file")))
+				Expect(err).To(MatchError(ContainSubstring("file is too big to fit on this drive:")))
+				Expect(err).To(MatchError(ContainSubstring("bytes required")))
+				Expect(err).To(MatchError(ContainSubstring("bytes free")))
 			})
 		})
 
@@ -538,7 +1397,7 @@ var _ = Describe("Downloader", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				err = downloader.Get(location, downloadLinkFetcher, GinkgoWriter)
-				Expect(err).To(MatchError("problem while waiting for chunks to download: failed during retryable request: download request failed: failed GET"))
+				Expect(err).To(MatchError("problem while waiting for chunks to download: failed during retryable request: range bytes=0-0: download request failed: failed GET"))
 			})
 		})
 
@@ -583,7 +1442,8 @@ var _ = Describe("Downloader", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				err = downloader.Get(location, downloadLinkFetcher, GinkgoWriter)
-				Expect(err).To(MatchError("problem while waiting for chunks to download: failed during retryable request: during GET unexpected status code was returned: 500"))
+				Expect(err).To(MatchError("problem while waiting for chunks to download: failed during retryable request: range bytes=0-0: during GET unexpected status code was returned: 500"))
+				Expect(location.Name + download.PartialSuffix).NotTo(BeAnExistingFile())
 			})
 		})
 	})
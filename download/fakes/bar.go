@@ -26,17 +26,6 @@ type Bar struct {
 	kickoffMutex       sync.RWMutex
 	kickoffArgsForCall []struct {
 	}
-	NewProxyReaderStub        func(io.Reader) io.Reader
-	newProxyReaderMutex       sync.RWMutex
-	newProxyReaderArgsForCall []struct {
-		arg1 io.Reader
-	}
-	newProxyReaderReturns struct {
-		result1 io.Reader
-	}
-	newProxyReaderReturnsOnCall map[int]struct {
-		result1 io.Reader
-	}
 	SetOutputStub        func(io.Writer)
 	setOutputMutex       sync.RWMutex
 	setOutputArgsForCall []struct {
@@ -57,15 +46,16 @@ func (fake *Bar) Add(arg1 int) int {
 	fake.addArgsForCall = append(fake.addArgsForCall, struct {
 		arg1 int
 	}{arg1})
+	stub := fake.AddStub
+	fakeReturns := fake.addReturns
 	fake.recordInvocation("Add", []interface{}{arg1})
 	fake.addMutex.Unlock()
-	if fake.AddStub != nil {
-		return fake.AddStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.addReturns
 	return fakeReturns.result1
 }
 
@@ -115,9 +105,10 @@ func (fake *Bar) Finish() {
 	fake.finishMutex.Lock()
 	fake.finishArgsForCall = append(fake.finishArgsForCall, struct {
 	}{})
+	stub := fake.FinishStub
 	fake.recordInvocation("Finish", []interface{}{})
 	fake.finishMutex.Unlock()
-	if fake.FinishStub != nil {
+	if stub != nil {
 		fake.FinishStub()
 	}
 }
@@ -138,9 +129,10 @@ func (fake *Bar) Kickoff() {
 	fake.kickoffMutex.Lock()
 	fake.kickoffArgsForCall = append(fake.kickoffArgsForCall, struct {
 	}{})
+	stub := fake.KickoffStub
 	fake.recordInvocation("Kickoff", []interface{}{})
 	fake.kickoffMutex.Unlock()
-	if fake.KickoffStub != nil {
+	if stub != nil {
 		fake.KickoffStub()
 	}
 }
@@ -157,74 +149,15 @@ func (fake *Bar) KickoffCalls(stub func()) {
 	fake.KickoffStub = stub
 }
 
-func (fake *Bar) NewProxyReader(arg1 io.Reader) io.Reader {
-	fake.newProxyReaderMutex.Lock()
-	ret, specificReturn := fake.newProxyReaderReturnsOnCall[len(fake.newProxyReaderArgsForCall)]
-	fake.newProxyReaderArgsForCall = append(fake.newProxyReaderArgsForCall, struct {
-		arg1 io.Reader
-	}{arg1})
-	fake.recordInvocation("NewProxyReader", []interface{}{arg1})
-	fake.newProxyReaderMutex.Unlock()
-	if fake.NewProxyReaderStub != nil {
-		return fake.NewProxyReaderStub(arg1)
-	}
-	if specificReturn {
-		return ret.result1
-	}
-	fakeReturns := fake.newProxyReaderReturns
-	return fakeReturns.result1
-}
-
-func (fake *Bar) NewProxyReaderCallCount() int {
-	fake.newProxyReaderMutex.RLock()
-	defer fake.newProxyReaderMutex.RUnlock()
-	return len(fake.newProxyReaderArgsForCall)
-}
-
-func (fake *Bar) NewProxyReaderCalls(stub func(io.Reader) io.Reader) {
-	fake.newProxyReaderMutex.Lock()
-	defer fake.newProxyReaderMutex.Unlock()
-	fake.NewProxyReaderStub = stub
-}
-
-func (fake *Bar) NewProxyReaderArgsForCall(i int) io.Reader {
-	fake.newProxyReaderMutex.RLock()
-	defer fake.newProxyReaderMutex.RUnlock()
-	argsForCall := fake.newProxyReaderArgsForCall[i]
-	return argsForCall.arg1
-}
-
-func (fake *Bar) NewProxyReaderReturns(result1 io.Reader) {
-	fake.newProxyReaderMutex.Lock()
-	defer fake.newProxyReaderMutex.Unlock()
-	fake.NewProxyReaderStub = nil
-	fake.newProxyReaderReturns = struct {
-		result1 io.Reader
-	}{result1}
-}
-
-func (fake *Bar) NewProxyReaderReturnsOnCall(i int, result1 io.Reader) {
-	fake.newProxyReaderMutex.Lock()
-	defer fake.newProxyReaderMutex.Unlock()
-	fake.NewProxyReaderStub = nil
-	if fake.newProxyReaderReturnsOnCall == nil {
-		fake.newProxyReaderReturnsOnCall = make(map[int]struct {
-			result1 io.Reader
-		})
-	}
-	fake.newProxyReaderReturnsOnCall[i] = struct {
-		result1 io.Reader
-	}{result1}
-}
-
 func (fake *Bar) SetOutput(arg1 io.Writer) {
 	fake.setOutputMutex.Lock()
 	fake.setOutputArgsForCall = append(fake.setOutputArgsForCall, struct {
 		arg1 io.Writer
 	}{arg1})
+	stub := fake.SetOutputStub
 	fake.recordInvocation("SetOutput", []interface{}{arg1})
 	fake.setOutputMutex.Unlock()
-	if fake.SetOutputStub != nil {
+	if stub != nil {
 		fake.SetOutputStub(arg1)
 	}
 }
@@ -253,9 +186,10 @@ func (fake *Bar) SetTotal(arg1 int64) {
 	fake.setTotalArgsForCall = append(fake.setTotalArgsForCall, struct {
 		arg1 int64
 	}{arg1})
+	stub := fake.SetTotalStub
 	fake.recordInvocation("SetTotal", []interface{}{arg1})
 	fake.setTotalMutex.Unlock()
-	if fake.SetTotalStub != nil {
+	if stub != nil {
 		fake.SetTotalStub(arg1)
 	}
 }
@@ -288,8 +222,6 @@ func (fake *Bar) Invocations() map[string][][]interface{} {
 	defer fake.finishMutex.RUnlock()
 	fake.kickoffMutex.RLock()
 	defer fake.kickoffMutex.RUnlock()
-	fake.newProxyReaderMutex.RLock()
-	defer fake.newProxyReaderMutex.RUnlock()
 	fake.setOutputMutex.RLock()
 	defer fake.setOutputMutex.RUnlock()
 	fake.setTotalMutex.RLock()
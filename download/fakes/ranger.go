@@ -31,15 +31,16 @@ func (fake *Ranger) BuildRange(arg1 int64) ([]download.Range, error) {
 	fake.buildRangeArgsForCall = append(fake.buildRangeArgsForCall, struct {
 		arg1 int64
 	}{arg1})
+	stub := fake.BuildRangeStub
+	fakeReturns := fake.buildRangeReturns
 	fake.recordInvocation("BuildRange", []interface{}{arg1})
 	fake.buildRangeMutex.Unlock()
-	if fake.BuildRangeStub != nil {
-		return fake.BuildRangeStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.buildRangeReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -111,3 +112,5 @@ func (fake *Ranger) recordInvocation(key string, args []interface{}) {
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
 }
+
+var _ download.RangeStrategy = new(Ranger)
@@ -0,0 +1,38 @@
+package download
+
+import "context"
+
+// Limiter bounds the number of requests in flight across every download of
+// the Clients sharing it, so several files can be fetched at once without
+// multiplying the connections used.
+type Limiter struct {
+	slots chan struct{}
+}
+
+func NewLimiter(maxRequests int) *Limiter {
+	if maxRequests < 1 {
+		maxRequests = 1
+	}
+	return &Limiter{slots: make(chan struct{}, maxRequests)}
+}
+
+// acquire blocks until a request may be made. A nil Limiter never blocks.
+func (l *Limiter) acquire(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *Limiter) release() {
+	if l == nil {
+		return
+	}
+	<-l.slots
+}
@@ -0,0 +1,181 @@
+package download
+
+import (
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet/v9/logger"
+)
+
+// ProgressReporter receives the progress of a download. Add is called with
+// the bytes written to the range lower-upper as they arrive, and with a
+// negative count when a failed attempt is discarded before a retry. Start is
+// called with zero when the content length is not known. Add and Retry may be
+// called concurrently for different ranges.
+type ProgressReporter interface {
+	Start(contentLength int64)
+	Add(lower, upper, n int64)
+	Retry(lower, upper int64, attempt int, cause error)
+	Finish(err error)
+}
+
+// barReporter reports progress on a terminal progress bar.
+type barReporter struct {
+	bar    bar
+	output io.Writer
+}
+
+// NewBarReporter returns a ProgressReporter that draws a progress bar on
+// output.
+func NewBarReporter(output io.Writer) ProgressReporter {
+	return barReporter{bar: NewBar(), output: output}
+}
+
+func (r barReporter) Start(contentLength int64) {
+	r.bar.SetOutput(r.output)
+	r.bar.SetTotal(contentLength)
+	r.bar.Kickoff()
+}
+
+func (r barReporter) Add(lower, upper, n int64) {
+	r.bar.Add(int(n))
+}
+
+func (r barReporter) Retry(lower, upper int64, attempt int, cause error) {}
+
+func (r barReporter) Finish(err error) {
+	r.bar.Finish()
+}
+
+type ProgressEventType string
+
+const (
+	ProgressStarted  ProgressEventType = "started"
+	ProgressAdded    ProgressEventType = "added"
+	ProgressRetry    ProgressEventType = "retry"
+	ProgressFinished ProgressEventType = "finished"
+)
+
+// ProgressEvent describes one call made to a ProgressReporter. Only the
+// fields relevant to its Type are set.
+type ProgressEvent struct {
+	Type          ProgressEventType
+	ContentLength int64
+	Lower         int64
+	Upper         int64
+	Bytes         int64
+	Attempt       int
+	Err           error
+}
+
+// ProgressFunc is a ProgressReporter that passes every event to a callback.
+// The callback must be safe to call from several goroutines at once.
+type ProgressFunc func(event ProgressEvent)
+
+func (f ProgressFunc) Start(contentLength int64) {
+	f(ProgressEvent{Type: ProgressStarted, ContentLength: contentLength})
+}
+
+func (f ProgressFunc) Add(lower, upper, n int64) {
+	f(ProgressEvent{Type: ProgressAdded, Lower: lower, Upper: upper, Bytes: n})
+}
+
+func (f ProgressFunc) Retry(lower, upper int64, attempt int, cause error) {
+	f(ProgressEvent{Type: ProgressRetry, Lower: lower, Upper: upper, Attempt: attempt, Err: cause})
+}
+
+func (f ProgressFunc) Finish(err error) {
+	f(ProgressEvent{Type: ProgressFinished, Err: err})
+}
+
+// logReporter reports progress as structured log lines.
+type logReporter struct {
+	logger   logger.Logger
+	interval time.Duration
+
+	mutex         sync.Mutex
+	contentLength int64
+	written       int64
+	lastLogged    time.Time
+}
+
+// NewLogReporter returns a ProgressReporter that logs the start, retries and
+// end of a download, and the bytes written at most once per interval.
+func NewLogReporter(lgr logger.Logger, interval time.Duration) ProgressReporter {
+	return &logReporter{logger: lgr, interval: interval}
+}
+
+func (r *logReporter) Start(contentLength int64) {
+	r.mutex.Lock()
+	r.contentLength = contentLength
+	r.written = 0
+	r.lastLogged = time.Now()
+	r.mutex.Unlock()
+
+	r.logger.Info("Download started", logger.Data{"content_length": contentLength})
+}
+
+func (r *logReporter) Add(lower, upper, n int64) {
+	r.mutex.Lock()
+	r.written += n
+	if time.Since(r.lastLogged) < r.interval {
+		r.mutex.Unlock()
+		return
+	}
+	r.lastLogged = time.Now()
+	data := r.progressData()
+	r.mutex.Unlock()
+
+	r.logger.Info("Download progress", data)
+}
+
+func (r *logReporter) Retry(lower, upper int64, attempt int, cause error) {
+	r.logger.Info("Download retry", logger.Data{
+		"lower":   lower,
+		"upper":   upper,
+		"attempt": attempt,
+		"error":   cause.Error(),
+	})
+}
+
+func (r *logReporter) Finish(err error) {
+	r.mutex.Lock()
+	data := r.progressData()
+	r.mutex.Unlock()
+
+	if err != nil {
+		data["error"] = err.Error()
+	}
+
+	r.logger.Info("Download finished", data)
+}
+
+// progressData must be called with the mutex held.
+func (r *logReporter) progressData() logger.Data {
+	data := logger.Data{
+		"written":        r.written,
+		"content_length": r.contentLength,
+	}
+	if r.contentLength > 0 {
+		data["percent"] = r.written * 100 / r.contentLength
+	}
+	return data
+}
+
+// countingReporter keeps a running total of the bytes reported, so they can
+// be taken back when a download has to start over.
+type countingReporter struct {
+	ProgressReporter
+	total int64
+}
+
+func (r *countingReporter) Add(lower, upper, n int64) {
+	atomic.AddInt64(&r.total, n)
+	r.ProgressReporter.Add(lower, upper, n)
+}
+
+func (r *countingReporter) written() int64 {
+	return atomic.LoadInt64(&r.total)
+}
@@ -0,0 +1,178 @@
+package download_test
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet/v9/download"
+	"github.com/pivotal-cf/go-pivnet/v9/download/fakes"
+	"github.com/pivotal-cf/go-pivnet/v9/logger"
+	"github.com/pivotal-cf/go-pivnet/v9/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ProgressReporter", func() {
+	var (
+		httpClient          *fakes.HTTPClient
+		ranger              *fakes.Ranger
+		bar                 *fakes.Bar
+		downloadLinkFetcher *fakes.DownloadLinkFetcher
+		downloader          download.Client
+		tmpLocation         *download.FileInfo
+	)
+
+	BeforeEach(func() {
+		httpClient = &fakes.HTTPClient{}
+		ranger = &fakes.Ranger{}
+		bar = &fakes.Bar{}
+
+		downloadLinkFetcher = &fakes.DownloadLinkFetcher{}
+		downloadLinkFetcher.NewDownloadLinkReturns("https://example.com/some-file", nil)
+
+		ranger.BuildRangeReturns([]download.Range{
+			download.NewRange(0, 8, http.Header{"Range": []string{"bytes=0-8"}}),
+		}, nil)
+
+		responses := []*http.Response{
+			{
+				StatusCode:    http.StatusOK,
+				ContentLength: 9,
+				Request: &http.Request{
+					URL: &url.URL{Scheme: "https", Host: "example.com", Path: "some-file"},
+				},
+			},
+			{
+				StatusCode: http.StatusPartialContent,
+				Body:       ioutil.NopCloser(io.MultiReader(strings.NewReader("some"), EOFReader{})),
+			},
+			{
+				StatusCode: http.StatusPartialContent,
+				Body:       ioutil.NopCloser(strings.NewReader("something")),
+			},
+		}
+		httpClient.DoStub = func(req *http.Request) (*http.Response, error) {
+			return responses[httpClient.DoCallCount()-1], nil
+		}
+
+		downloader = download.Client{
+			Logger:     &loggerfakes.FakeLogger{},
+			HTTPClient: httpClient,
+			Ranger:     ranger,
+			Bar:        bar,
+			Timeout:    time.Second,
+		}
+
+		tmpFile, err := ioutil.TempFile("", "")
+		Expect(err).NotTo(HaveOccurred())
+		defer tmpFile.Close()
+
+		tmpLocation, err = download.NewFileInfo(tmpFile)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.Remove(tmpLocation.Name)
+		os.Remove(tmpLocation.Name + download.PartialSuffix)
+	})
+
+	Describe("ProgressFunc", func() {
+		It("receives every event of the download instead of the bar", func() {
+			var (
+				m      sync.Mutex
+				events []download.ProgressEvent
+			)
+			downloader.Progress = download.ProgressFunc(func(event download.ProgressEvent) {
+				m.Lock()
+				defer m.Unlock()
+				events = append(events, event)
+			})
+
+			err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(events).To(Equal([]download.ProgressEvent{
+				{Type: download.ProgressStarted, ContentLength: 9},
+				{Type: download.ProgressAdded, Lower: 0, Upper: 8, Bytes: 4},
+				{Type: download.ProgressAdded, Lower: 0, Upper: 8, Bytes: -4},
+				{Type: download.ProgressRetry, Lower: 0, Upper: 8, Attempt: 1, Err: io.ErrUnexpectedEOF},
+				{Type: download.ProgressAdded, Lower: 0, Upper: 8, Bytes: 9},
+				{Type: download.ProgressFinished},
+			}))
+
+			Expect(bar.KickoffCallCount()).To(Equal(0))
+			Expect(bar.AddCallCount()).To(Equal(0))
+		})
+
+		It("reports the error the download finished with", func() {
+			tmpLocation.SHA256 = "bad-sha256"
+
+			var finished []error
+			downloader.Progress = download.ProgressFunc(func(event download.ProgressEvent) {
+				if event.Type == download.ProgressFinished {
+					finished = append(finished, event.Err)
+				}
+			})
+
+			err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+			Expect(err).To(HaveOccurred())
+			Expect(finished).To(Equal([]error{err}))
+		})
+	})
+
+	Describe("NewLogReporter", func() {
+		It("logs the start, retries and end of the download", func() {
+			fakeLogger := &loggerfakes.FakeLogger{}
+			downloader.Progress = download.NewLogReporter(fakeLogger, time.Hour)
+
+			err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeLogger.InfoCallCount()).To(Equal(3))
+
+			action, data := fakeLogger.InfoArgsForCall(0)
+			Expect(action).To(Equal("Download started"))
+			Expect(data).To(Equal([]logger.Data{{"content_length": int64(9)}}))
+
+			action, data = fakeLogger.InfoArgsForCall(1)
+			Expect(action).To(Equal("Download retry"))
+			Expect(data).To(Equal([]logger.Data{{
+				"lower":   int64(0),
+				"upper":   int64(8),
+				"attempt": 1,
+				"error":   io.ErrUnexpectedEOF.Error(),
+			}}))
+
+			action, data = fakeLogger.InfoArgsForCall(2)
+			Expect(action).To(Equal("Download finished"))
+			Expect(data).To(Equal([]logger.Data{{
+				"written":        int64(9),
+				"content_length": int64(9),
+				"percent":        int64(100),
+			}}))
+		})
+
+		It("logs progress at most once per interval", func() {
+			fakeLogger := &loggerfakes.FakeLogger{}
+			reporter := download.NewLogReporter(fakeLogger, 0)
+
+			reporter.Start(10)
+			reporter.Add(0, 9, 5)
+
+			action, data := fakeLogger.InfoArgsForCall(1)
+			Expect(action).To(Equal("Download progress"))
+			Expect(data).To(Equal([]logger.Data{{
+				"written":        int64(5),
+				"content_length": int64(10),
+				"percent":        int64(50),
+			}}))
+		})
+	})
+})
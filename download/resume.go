@@ -0,0 +1,317 @@
+package download
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// ResumeStateSuffix is appended to the download location to name the sidecar
+// file recording per-range progress when Client.Resume is set.
+const ResumeStateSuffix = ".pivnet-resume"
+
+const resumeSaveInterval = time.Second
+
+type resumeState struct {
+	ProductFileID int           `json:"product_file_id"`
+	SHA256        string        `json:"sha256"`
+	ContentLength int64         `json:"content_length"`
+	Version       string        `json:"version,omitempty"`
+	Ranges        []resumeRange `json:"ranges"`
+}
+
+type resumeRange struct {
+	Lower   int64 `json:"lower"`
+	Upper   int64 `json:"upper"`
+	Written int64 `json:"written"`
+}
+
+func (r resumeRange) remaining() int64 {
+	return r.Upper - r.Lower + 1 - r.Written
+}
+
+// resumeTracker persists how much of each range has been written. A nil
+// tracker records nothing, so callers need not check whether resume is on.
+type resumeTracker struct {
+	mutex       sync.Mutex
+	path        string
+	partialPath string
+	state       resumeState
+	lastSaved   time.Time
+}
+
+// loadResumeTracker returns a tracker for location, carrying over the
+// progress of a previous run when its state file matches the product file,
+// checksum, content length and remote version and the partial file still
+// holds the recorded bytes.
+func loadResumeTracker(location *FileInfo, contentLength int64, version string) (*resumeTracker, error) {
+	t := &resumeTracker{
+		path:        location.Name + ResumeStateSuffix,
+		partialPath: location.Name + PartialSuffix,
+		state: resumeState{
+			ProductFileID: location.ProductFileID,
+			SHA256:        location.SHA256,
+			ContentLength: contentLength,
+			Version:       version,
+		},
+	}
+
+	b, err := ioutil.ReadFile(t.path)
+	if os.IsNotExist(err) {
+		return t, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var previous resumeState
+	if err := json.Unmarshal(b, &previous); err != nil {
+		return t, nil
+	}
+
+	if previous.ProductFileID != location.ProductFileID ||
+		previous.SHA256 != location.SHA256 ||
+		previous.ContentLength != contentLength ||
+		previous.Version != version {
+		return t, nil
+	}
+
+	stat, err := os.Stat(t.partialPath)
+	if os.IsNotExist(err) {
+		return t, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range previous.Ranges {
+		if r.Written < 0 || r.remaining() < 0 {
+			return t, nil
+		}
+		if r.Written > 0 && stat.Size() < r.Lower+r.Written {
+			return t, nil
+		}
+	}
+
+	t.state.Ranges = previous.Ranges
+	return t, nil
+}
+
+func (t *resumeTracker) resumed() bool {
+	return t != nil && len(t.state.Ranges) > 0
+}
+
+func (t *resumeTracker) start(ranges []Range) error {
+	if t == nil {
+		return nil
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.state.Ranges = make([]resumeRange, len(ranges))
+	for i, r := range ranges {
+		t.state.Ranges[i] = resumeRange{Lower: r.Lower, Upper: r.Upper}
+	}
+
+	return t.save()
+}
+
+// ranges returns the parts of the recorded ranges that are still missing.
+// Indexes of the returned ranges match the recorded ones.
+func (t *resumeTracker) ranges() []Range {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	ranges := make([]Range, len(t.state.Ranges))
+	for i, r := range t.state.Ranges {
+		lower := r.Lower + r.Written
+		ranges[i] = Range{
+			Lower:      lower,
+			Upper:      r.Upper,
+			HTTPHeader: http.Header{"Range": []string{fmt.Sprintf("bytes=%d-%d", lower, r.Upper)}},
+		}
+	}
+
+	return ranges
+}
+
+func (t *resumeTracker) completed() int64 {
+	if t == nil {
+		return 0
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	var n int64
+	for _, r := range t.state.Ranges {
+		n += r.Written
+	}
+	return n
+}
+
+func (t *resumeTracker) written(index int, n int64) error {
+	if t == nil {
+		return nil
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.state.Ranges[index].Written += n
+
+	if time.Since(t.lastSaved) < resumeSaveInterval {
+		return nil
+	}
+	return t.save()
+}
+
+func (t *resumeTracker) reset(index int, written int64) {
+	if t == nil {
+		return
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.state.Ranges[index].Written = written
+}
+
+func (t *resumeTracker) recorded(index int) int64 {
+	if t == nil {
+		return 0
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.state.Ranges[index].Written
+}
+
+func (t *resumeTracker) flush() error {
+	if t == nil {
+		return nil
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.save()
+}
+
+func (t *resumeTracker) remove() error {
+	if t == nil {
+		return nil
+	}
+
+	err := os.Remove(t.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// save must be called with the mutex held. The partial file is synced first
+// so the state never records bytes that a crash could lose, and the state is
+// written to a temporary file and renamed so a crash never leaves it half
+// written.
+func (t *resumeTracker) save() error {
+	b, err := json.Marshal(t.state)
+	if err != nil {
+		return err
+	}
+
+	if err := syncFile(t.partialPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	tmp := t.path + ".tmp"
+	if err := writeFileSync(tmp, b); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, t.path); err != nil {
+		return err
+	}
+
+	t.lastSaved = time.Now()
+	return nil
+}
+
+func syncFile(path string) error {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return f.Sync()
+}
+
+func writeFileSync(path string, b []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// rangeProgress records the bytes of one range as they reach the file.
+type rangeProgress struct {
+	tracker  *resumeTracker
+	index    int
+	reporter ProgressReporter
+	lower    int64
+	upper    int64
+}
+
+func (p rangeProgress) add(n int64) error {
+	if p.reporter != nil {
+		p.reporter.Add(p.lower, p.upper, n)
+	}
+	return p.tracker.written(p.index, n)
+}
+
+// rewind discards n bytes of a failed attempt, so that the range's recorded
+// progress is back to what it was before the attempt.
+func (p rangeProgress) rewind(n int64, recorded int64) {
+	if p.reporter != nil {
+		p.reporter.Add(p.lower, p.upper, -n)
+	}
+	p.tracker.reset(p.index, recorded)
+}
+
+func (p rangeProgress) retry(attempt int, cause error) {
+	if p.reporter != nil {
+		p.reporter.Retry(p.lower, p.upper, attempt, cause)
+	}
+}
+
+type rangeWriter struct {
+	w        io.Writer
+	progress rangeProgress
+}
+
+func (p rangeWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	if n > 0 {
+		if trackErr := p.progress.add(int64(n)); trackErr != nil && err == nil {
+			err = fmt.Errorf("failed to save resume state: %w", trackErr)
+		}
+	}
+	return n, err
+}
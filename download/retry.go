@@ -0,0 +1,146 @@
+package download
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// RetryPolicy bounds how often chunks are retried after temporary network
+// errors, stalled or truncated reads, reset connections and 403s from an
+// expired download link. A zero field means no limit, and the zero value
+// retries immediately and forever.
+type RetryPolicy struct {
+	// MaxAttemptsPerChunk is the number of attempts made for a single range,
+	// including the first one.
+	MaxAttemptsPerChunk int
+
+	// MaxRetries is the number of retries shared by all ranges of one
+	// download.
+	MaxRetries int
+
+	// MaxLinkRefreshes is the number of times one download may fetch a new
+	// download link after a 403.
+	MaxLinkRefreshes int
+
+	// BaseBackoff is the delay before the first retry of a range. It doubles
+	// with every subsequent attempt.
+	BaseBackoff time.Duration
+
+	// MaxBackoff caps a single delay. Zero means no cap.
+	MaxBackoff time.Duration
+
+	// Jitter is the fraction (0 to 1) of each delay that is randomised, so
+	// that ranges do not retry in lockstep.
+	Jitter float64
+}
+
+// DefaultRetryPolicy is a reasonable policy for long downloads over
+// unreliable connections.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttemptsPerChunk: 10,
+	MaxRetries:          50,
+	MaxLinkRefreshes:    10,
+	BaseBackoff:         time.Second,
+	MaxBackoff:          time.Minute,
+	Jitter:              0.2,
+}
+
+var (
+	jitterRandMutex sync.Mutex
+	jitterRand      = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseBackoff
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if p.MaxBackoff > 0 && delay >= p.MaxBackoff {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+
+	if p.Jitter > 0 && delay > 0 {
+		jitterRandMutex.Lock()
+		f := jitterRand.Float64()
+		jitterRandMutex.Unlock()
+
+		delay -= time.Duration(f * p.Jitter * float64(delay))
+	}
+
+	return delay
+}
+
+// ErrRangeFailed is returned when a range of the file could not be
+// downloaded. Err says why.
+type ErrRangeFailed struct {
+	Lower int64
+	Upper int64
+	Err   error
+}
+
+func (e ErrRangeFailed) Error() string {
+	return fmt.Sprintf("range bytes=%d-%d: %s", e.Lower, e.Upper, e.Err)
+}
+
+func (e ErrRangeFailed) Unwrap() error {
+	return e.Err
+}
+
+// retryBudget tracks the retries and link refreshes of one download across
+// all of its ranges.
+type retryBudget struct {
+	policy RetryPolicy
+
+	mutex         sync.Mutex
+	retries       int
+	linkRefreshes int
+}
+
+// retry is called after attempt attempts of a range have failed with cause.
+// It waits for the backoff and returns nil if the range may be tried again.
+func (b *retryBudget) retry(ctx context.Context, attempt int, cause error) error {
+	if b.policy.MaxAttemptsPerChunk > 0 && attempt >= b.policy.MaxAttemptsPerChunk {
+		return fmt.Errorf("gave up after %d attempts: %w", attempt, cause)
+	}
+
+	b.mutex.Lock()
+	if b.policy.MaxRetries > 0 && b.retries >= b.policy.MaxRetries {
+		b.mutex.Unlock()
+		return fmt.Errorf("gave up after %d retries of the download: %w", b.retries, cause)
+	}
+	b.retries++
+	b.mutex.Unlock()
+
+	delay := b.policy.backoff(attempt)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (b *retryBudget) refreshLink() error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.policy.MaxLinkRefreshes > 0 && b.linkRefreshes >= b.policy.MaxLinkRefreshes {
+		return fmt.Errorf("download link was refreshed %d times and is still forbidden", b.linkRefreshes)
+	}
+	b.linkRefreshes++
+
+	return nil
+}
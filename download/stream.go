@@ -0,0 +1,277 @@
+package download
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	defaultStreamChunkSize  = 8 * 1024 * 1024
+	defaultStreamBufferSize = 10
+)
+
+type writeSeekCloser interface {
+	io.Writer
+	io.Seeker
+	io.Closer
+}
+
+// memoryChunk holds one range in memory. Offsets passed to Seek are offsets
+// into the whole file, as they are for the *os.File used by Get.
+type memoryChunk struct {
+	lower int64
+	buf   []byte
+}
+
+func (m *memoryChunk) Write(p []byte) (int, error) {
+	m.buf = append(m.buf, p...)
+	return len(p), nil
+}
+
+func (m *memoryChunk) Seek(offset int64, whence int) (int64, error) {
+	if whence != io.SeekStart || offset < m.lower || offset-m.lower > int64(len(m.buf)) {
+		return 0, errors.New("invalid seek in memory chunk")
+	}
+	m.buf = m.buf[:offset-m.lower]
+	return offset, nil
+}
+
+func (m *memoryChunk) Close() error {
+	return nil
+}
+
+type streamChunk struct {
+	byteRange Range
+	data      *memoryChunk
+	done      chan struct{}
+}
+
+func (c Client) Stream(
+	downloadLinkFetcher downloadLinkFetcher,
+	writer io.Writer,
+	progressWriter io.Writer,
+) error {
+	return c.StreamContext(context.Background(), downloadLinkFetcher, writer, progressWriter)
+}
+
+// StreamContext downloads the file into writer instead of onto disk. Ranges
+// of StreamChunkSize bytes are fetched concurrently and written in order; at
+// most StreamBufferSize of them are held in memory at once. File stores that
+// do not support range requests are streamed in a single request instead.
+func (c Client) StreamContext(
+	ctx context.Context,
+	downloadLinkFetcher downloadLinkFetcher,
+	writer io.Writer,
+	progressWriter io.Writer,
+) (err error) {
+	contentURL, err := downloadLinkFetcher.NewDownloadLink()
+	if err != nil {
+		return fmt.Errorf("could not create new download link in stream: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "HEAD", contentURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to construct HEAD request: %s", err)
+	}
+
+	req.Header.Add("Referer", "https://go-pivnet.network.tanzu.vmware.com")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make HEAD request: %w", err)
+	}
+
+	c.Logger.Debug(fmt.Sprintf("HEAD response content size: %d", resp.ContentLength))
+
+	contentURL = resp.Request.URL.String()
+	version := newRemoteVersion(resp.Header)
+
+	reporter := &countingReporter{ProgressReporter: c.reporter(progressWriter)}
+	budget := &retryBudget{policy: c.RetryPolicy}
+	out := &orderedWriter{w: writer}
+
+	// as in GetContext, ranges are tried unless the file store says they are
+	// not supported
+	if resp.ContentLength == -1 || resp.Header.Get("Accept-Ranges") == "none" {
+		c.Logger.Debug("file store does not support range requests, streaming in a single request")
+
+		size := resp.ContentLength
+		if size < 0 {
+			size = 0
+		}
+		reporter.Start(size)
+		defer func() { reporter.Finish(err) }()
+
+		return c.streamSingle(ctx, contentURL, version, resp.ContentLength, out, downloadLinkFetcher, reporter, budget)
+	}
+
+	chunkSize := c.StreamChunkSize
+	if chunkSize <= 0 {
+		chunkSize = defaultStreamChunkSize
+	}
+
+	bufferSize := c.StreamBufferSize
+	if bufferSize <= 0 {
+		bufferSize = defaultStreamBufferSize
+	}
+
+	var chunks []*streamChunk
+	for lower := int64(0); lower < resp.ContentLength; lower += chunkSize {
+		upper := lower + chunkSize - 1
+		if upper >= resp.ContentLength {
+			upper = resp.ContentLength - 1
+		}
+
+		chunks = append(chunks, &streamChunk{
+			byteRange: NewRange(lower, upper, http.Header{"Range": []string{fmt.Sprintf("bytes=%d-%d", lower, upper)}}),
+			data:      &memoryChunk{lower: lower},
+			done:      make(chan struct{}),
+		})
+	}
+
+	reporter.Start(resp.ContentLength)
+
+	defer func() { reporter.Finish(err) }()
+
+	slots := make(chan struct{}, bufferSize)
+
+	g, gctx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		for _, chunk := range chunks {
+			select {
+			case slots <- struct{}{}:
+			case <-gctx.Done():
+				return gctx.Err()
+			}
+
+			chunk := chunk
+			g.Go(func() error {
+				if err := c.Limiter.acquire(gctx); err != nil {
+					return fmt.Errorf("failed during retryable request: %w", err)
+				}
+				defer c.Limiter.release()
+
+				progress := rangeProgress{
+					reporter: reporter,
+					lower:    chunk.byteRange.Lower,
+					upper:    chunk.byteRange.Upper,
+				}
+
+				err := c.retryableRequest(gctx, contentURL, version, chunk.byteRange, false, chunk.data, downloadLinkFetcher, c.Timeout, progress, budget)
+				if err != nil {
+					if errors.Is(err, errRangesNotSupported) {
+						return err
+					}
+					if gctx.Err() != nil && errors.Is(err, gctx.Err()) {
+						return fmt.Errorf("failed during retryable request: %w", err)
+					}
+					return fmt.Errorf("failed during retryable request: %w", ErrRangeFailed{Lower: chunk.byteRange.Lower, Upper: chunk.byteRange.Upper, Err: err})
+				}
+
+				close(chunk.done)
+				return nil
+			})
+		}
+
+		return nil
+	})
+
+	g.Go(func() error {
+		for _, chunk := range chunks {
+			select {
+			case <-chunk.done:
+			case <-gctx.Done():
+				return gctx.Err()
+			}
+
+			_, err := out.Write(chunk.data.buf)
+			if err != nil {
+				return fmt.Errorf("failed to write to stream: %w", err)
+			}
+
+			chunk.data = nil
+			<-slots
+		}
+
+		return nil
+	})
+
+	err = g.Wait()
+	if errors.Is(err, errRangesNotSupported) {
+		c.Logger.Debug("file store ignored a range request, streaming in a single request")
+
+		reporter.Add(0, resp.ContentLength-1, -reporter.written())
+		return c.streamSingle(ctx, contentURL, version, resp.ContentLength, out, downloadLinkFetcher, reporter, budget)
+	}
+	if err != nil {
+		return fmt.Errorf("problem while waiting for chunks to download: %w", err)
+	}
+
+	return nil
+}
+
+// streamSingle fetches the whole file in one request and writes it to out,
+// for file stores that do not support range requests.
+func (c Client) streamSingle(ctx context.Context, contentURL string, version remoteVersion, contentLength int64, out *orderedWriter, downloadLinkFetcher downloadLinkFetcher, reporter ProgressReporter, budget *retryBudget) error {
+	if err := c.Limiter.acquire(ctx); err != nil {
+		return fmt.Errorf("failed during single stream request: %w", err)
+	}
+	defer c.Limiter.release()
+
+	progress := rangeProgress{
+		reporter: reporter,
+		lower:    0,
+		upper:    contentLength - 1,
+	}
+
+	err := c.retryableRequest(ctx, contentURL, version, NewRange(0, contentLength-1, http.Header{}), true, out, downloadLinkFetcher, c.Timeout, progress, budget)
+	if err != nil {
+		return fmt.Errorf("failed during single stream request: %w", err)
+	}
+
+	return nil
+}
+
+// orderedWriter passes the file on to a writer that cannot be rewound. A
+// request that starts over from the beginning of the file skips the bytes
+// that were already written.
+type orderedWriter struct {
+	w       io.Writer
+	written int64
+	skip    int64
+}
+
+func (o *orderedWriter) Write(p []byte) (int, error) {
+	n := len(p)
+
+	if o.skip >= int64(n) {
+		o.skip -= int64(n)
+		return n, nil
+	}
+	p = p[o.skip:]
+	skipped := int(o.skip)
+	o.skip = 0
+
+	written, err := o.w.Write(p)
+	o.written += int64(written)
+
+	return skipped + written, err
+}
+
+func (o *orderedWriter) Seek(offset int64, whence int) (int64, error) {
+	if whence != io.SeekStart || offset < 0 || offset > o.written {
+		return 0, errors.New("invalid seek in stream")
+	}
+	o.skip = o.written - offset
+	return offset, nil
+}
+
+func (o *orderedWriter) Close() error {
+	return nil
+}
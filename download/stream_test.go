@@ -0,0 +1,233 @@
+package download_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing/iotest"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet/v9/download"
+	"github.com/pivotal-cf/go-pivnet/v9/download/fakes"
+	"github.com/pivotal-cf/go-pivnet/v9/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("disk on fire")
+}
+
+var _ = Describe("Stream", func() {
+	const content = "the quick brown fox jumps over the lazy dog"
+
+	var (
+		httpClient          *fakes.HTTPClient
+		bar                 *fakes.Bar
+		downloadLinkFetcher *fakes.DownloadLinkFetcher
+		downloader          download.Client
+
+		m           sync.Mutex
+		inFlight    int
+		maxInFlight int
+		failRange   string
+
+		headLength   int64
+		acceptRanges string
+		ignoreRanges bool
+		truncateOnce bool
+	)
+
+	BeforeEach(func() {
+		httpClient = &fakes.HTTPClient{}
+		bar = &fakes.Bar{}
+
+		downloadLinkFetcher = &fakes.DownloadLinkFetcher{}
+		downloadLinkFetcher.NewDownloadLinkReturns("https://example.com/some-file", nil)
+
+		inFlight = 0
+		maxInFlight = 0
+		failRange = ""
+
+		headLength = int64(len(content))
+		acceptRanges = ""
+		ignoreRanges = false
+		truncateOnce = false
+
+		rangePattern := regexp.MustCompile(`bytes=(\d+)-(\d+)`)
+
+		httpClient.DoStub = func(req *http.Request) (*http.Response, error) {
+			if req.Method == "HEAD" {
+				return &http.Response{
+					StatusCode:    http.StatusOK,
+					ContentLength: headLength,
+					Header:        http.Header{"Accept-Ranges": []string{acceptRanges}},
+					Request: &http.Request{
+						URL: &url.URL{Scheme: "https", Host: "example.com", Path: "some-file"},
+					},
+				}, nil
+			}
+
+			if ignoreRanges || req.Header.Get("Range") == "" {
+				m.Lock()
+				truncate := truncateOnce
+				truncateOnce = false
+				m.Unlock()
+
+				var body io.Reader = strings.NewReader(content)
+				if truncate {
+					body = io.MultiReader(strings.NewReader(content[:10]), iotest.ErrReader(io.ErrUnexpectedEOF))
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(body),
+				}, nil
+			}
+
+			if req.Header.Get("Range") == failRange {
+				return &http.Response{
+					StatusCode: http.StatusInternalServerError,
+					Body:       ioutil.NopCloser(strings.NewReader("")),
+				}, nil
+			}
+
+			m.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			m.Unlock()
+
+			matches := rangePattern.FindStringSubmatch(req.Header.Get("Range"))
+			lower, _ := strconv.Atoi(matches[1])
+			upper, _ := strconv.Atoi(matches[2])
+
+			// later ranges finish first, so chunks arrive out of order
+			time.Sleep(time.Duration(len(content)-lower) * 100 * time.Microsecond)
+
+			m.Lock()
+			inFlight--
+			m.Unlock()
+
+			return &http.Response{
+				StatusCode: http.StatusPartialContent,
+				Body:       ioutil.NopCloser(strings.NewReader(content[lower : upper+1])),
+			}, nil
+		}
+
+		downloader = download.Client{
+			Logger:           &loggerfakes.FakeLogger{},
+			HTTPClient:       httpClient,
+			Bar:              bar,
+			Timeout:          time.Second,
+			StreamChunkSize:  5,
+			StreamBufferSize: 3,
+		}
+	})
+
+	It("writes the ranges to the writer in order", func() {
+		var buf bytes.Buffer
+
+		err := downloader.Stream(downloadLinkFetcher, &buf, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(buf.String()).To(Equal(content))
+		Expect(bar.SetTotalArgsForCall(0)).To(Equal(int64(len(content))))
+		Expect(bar.FinishCallCount()).To(Equal(1))
+	})
+
+	It("holds no more than StreamBufferSize chunks at once", func() {
+		var buf bytes.Buffer
+
+		err := downloader.Stream(downloadLinkFetcher, &buf, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(maxInFlight).To(BeNumerically("<=", 3))
+		Expect(httpClient.DoCallCount()).To(Equal(1 + (len(content)+4)/5))
+	})
+
+	It("holds no more than the Limiter allows in flight", func() {
+		downloader.Limiter = download.NewLimiter(1)
+
+		var buf bytes.Buffer
+		err := downloader.Stream(downloadLinkFetcher, &buf, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(buf.String()).To(Equal(content))
+		Expect(maxInFlight).To(Equal(1))
+	})
+
+	It("reports the range that failed", func() {
+		failRange = "bytes=10-14"
+
+		err := downloader.Stream(downloadLinkFetcher, &bytes.Buffer{}, GinkgoWriter)
+
+		var rangeErr download.ErrRangeFailed
+		Expect(errors.As(err, &rangeErr)).To(BeTrue())
+		Expect(rangeErr.Lower).To(Equal(int64(10)))
+		Expect(rangeErr.Upper).To(Equal(int64(14)))
+	})
+
+	It("returns an error when the writer fails", func() {
+		err := downloader.Stream(downloadLinkFetcher, failingWriter{}, GinkgoWriter)
+		Expect(err).To(MatchError("problem while waiting for chunks to download: failed to write to stream: disk on fire"))
+	})
+
+	Context("when the file store does not support range requests", func() {
+		It("streams the file in a single request when the content length is unknown", func() {
+			headLength = -1
+
+			var buf bytes.Buffer
+			err := downloader.Stream(downloadLinkFetcher, &buf, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(buf.String()).To(Equal(content))
+			Expect(httpClient.DoCallCount()).To(Equal(2))
+		})
+
+		It("streams the file in a single request when it says so", func() {
+			acceptRanges = "none"
+
+			var buf bytes.Buffer
+			err := downloader.Stream(downloadLinkFetcher, &buf, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(buf.String()).To(Equal(content))
+			Expect(httpClient.DoCallCount()).To(Equal(2))
+			Expect(httpClient.DoArgsForCall(1).Header.Get("Range")).To(BeEmpty())
+		})
+
+		It("falls back to a single request when a range request gets the whole file", func() {
+			ignoreRanges = true
+
+			var buf bytes.Buffer
+			err := downloader.Stream(downloadLinkFetcher, &buf, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(buf.String()).To(Equal(content))
+		})
+
+		It("does not write the same bytes twice when the request is retried", func() {
+			acceptRanges = "none"
+			truncateOnce = true
+
+			var buf bytes.Buffer
+			err := downloader.Stream(downloadLinkFetcher, &buf, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(buf.String()).To(Equal(content))
+			Expect(httpClient.DoCallCount()).To(Equal(3))
+		})
+	})
+})
@@ -0,0 +1,178 @@
+package download
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// Throttle limits the bandwidth used by downloads. The aggregate limit is
+// shared by every range being fetched through it, and the connection limit
+// applies to each range request on its own. Limits are in bytes per second,
+// zero means unlimited, and both may be changed while a download is running.
+// A Throttle may be shared between several Clients.
+type Throttle struct {
+	aggregate *bucket
+
+	mutex           sync.Mutex
+	connectionLimit int64
+}
+
+func NewThrottle(bytesPerSecond int64, connectionBytesPerSecond int64) *Throttle {
+	return &Throttle{
+		aggregate:       newBucket(bytesPerSecond),
+		connectionLimit: connectionBytesPerSecond,
+	}
+}
+
+// SetLimit changes the aggregate limit.
+func (t *Throttle) SetLimit(bytesPerSecond int64) {
+	t.aggregate.setRate(bytesPerSecond)
+}
+
+// SetConnectionLimit changes the per-connection limit, including for range
+// requests already in flight.
+func (t *Throttle) SetConnectionLimit(bytesPerSecond int64) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.connectionLimit = bytesPerSecond
+}
+
+func (t *Throttle) Limit() int64 {
+	return t.aggregate.currentRate()
+}
+
+func (t *Throttle) ConnectionLimit() int64 {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.connectionLimit
+}
+
+// reader wraps the body of one range request. A nil Throttle returns r
+// unchanged.
+func (t *Throttle) reader(ctx context.Context, r io.Reader) io.Reader {
+	if t == nil {
+		return r
+	}
+
+	return &throttledReader{
+		ctx:        ctx,
+		r:          r,
+		throttle:   t,
+		connection: newBucket(t.ConnectionLimit()),
+	}
+}
+
+type throttledReader struct {
+	ctx        context.Context
+	r          io.Reader
+	throttle   *Throttle
+	connection *bucket
+}
+
+func (r *throttledReader) Read(p []byte) (int, error) {
+	r.connection.setRate(r.throttle.ConnectionLimit())
+
+	// keep each read within a second's worth of bytes so the rate stays
+	// smooth rather than arriving in bursts of a whole buffer
+	if limit := r.readLimit(); limit > 0 && int64(len(p)) > limit {
+		p = p[:limit]
+	}
+
+	n, err := r.r.Read(p)
+	if n > 0 {
+		waitErr := r.connection.wait(r.ctx, int64(n))
+		if waitErr == nil {
+			waitErr = r.throttle.aggregate.wait(r.ctx, int64(n))
+		}
+		if waitErr != nil {
+			return n, waitErr
+		}
+	}
+
+	return n, err
+}
+
+func (r *throttledReader) readLimit() int64 {
+	limit := r.connection.currentRate()
+	if aggregate := r.throttle.aggregate.currentRate(); aggregate > 0 && (limit == 0 || aggregate < limit) {
+		limit = aggregate
+	}
+	return limit
+}
+
+// bucket is a token bucket holding up to one second's worth of bytes.
+type bucket struct {
+	mutex  sync.Mutex
+	rate   int64
+	tokens float64
+	last   time.Time
+}
+
+func newBucket(rate int64) *bucket {
+	return &bucket{rate: rate, tokens: float64(rate), last: time.Now()}
+}
+
+func (b *bucket) setRate(rate int64) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if rate == b.rate {
+		return
+	}
+	b.refill()
+	b.rate = rate
+	if b.tokens > float64(rate) {
+		b.tokens = float64(rate)
+	}
+}
+
+func (b *bucket) currentRate() int64 {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.rate
+}
+
+// wait takes n tokens from the bucket, blocking until they have been paid
+// for or ctx is done.
+func (b *bucket) wait(ctx context.Context, n int64) error {
+	b.mutex.Lock()
+	if b.rate <= 0 {
+		b.mutex.Unlock()
+		return nil
+	}
+	b.refill()
+	b.tokens -= float64(n)
+	var delay time.Duration
+	if b.tokens < 0 {
+		delay = time.Duration(-b.tokens / float64(b.rate) * float64(time.Second))
+	}
+	b.mutex.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// refill must be called with the mutex held.
+func (b *bucket) refill() {
+	now := time.Now()
+	if b.rate > 0 {
+		b.tokens += now.Sub(b.last).Seconds() * float64(b.rate)
+		if b.tokens > float64(b.rate) {
+			b.tokens = float64(b.rate)
+		}
+	}
+	b.last = now
+}
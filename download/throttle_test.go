@@ -0,0 +1,141 @@
+package download_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet/v9/download"
+	"github.com/pivotal-cf/go-pivnet/v9/download/fakes"
+	"github.com/pivotal-cf/go-pivnet/v9/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Throttle", func() {
+	var (
+		content             string
+		httpClient          *fakes.HTTPClient
+		downloadLinkFetcher *fakes.DownloadLinkFetcher
+		downloader          download.Client
+		tmpLocation         *download.FileInfo
+	)
+
+	BeforeEach(func() {
+		content = strings.Repeat("0123456789", 30)
+
+		httpClient = &fakes.HTTPClient{}
+		downloadLinkFetcher = &fakes.DownloadLinkFetcher{}
+		downloadLinkFetcher.NewDownloadLinkReturns("https://example.com/some-file", nil)
+
+		rangePattern := regexp.MustCompile(`bytes=(\d+)-(\d+)`)
+
+		httpClient.DoStub = func(req *http.Request) (*http.Response, error) {
+			if req.Method == "HEAD" {
+				return &http.Response{
+					StatusCode:    http.StatusOK,
+					ContentLength: int64(len(content)),
+					Request: &http.Request{
+						URL: &url.URL{Scheme: "https", Host: "example.com", Path: "some-file"},
+					},
+				}, nil
+			}
+
+			matches := rangePattern.FindStringSubmatch(req.Header.Get("Range"))
+			lower, _ := strconv.Atoi(matches[1])
+			upper, _ := strconv.Atoi(matches[2])
+
+			return &http.Response{
+				StatusCode: http.StatusPartialContent,
+				Body:       ioutil.NopCloser(strings.NewReader(content[lower : upper+1])),
+			}, nil
+		}
+
+		downloader = download.Client{
+			Logger:     &loggerfakes.FakeLogger{},
+			HTTPClient: httpClient,
+			Ranger:     download.NewRanger(2),
+			Bar:        &fakes.Bar{},
+			Timeout:    5 * time.Second,
+		}
+
+		tmpFile, err := ioutil.TempFile("", "")
+		Expect(err).NotTo(HaveOccurred())
+		defer tmpFile.Close()
+
+		tmpLocation, err = download.NewFileInfo(tmpFile)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.Remove(tmpLocation.Name)
+		os.Remove(tmpLocation.Name + download.PartialSuffix)
+	})
+
+	expectContent := func() {
+		written, err := ioutil.ReadFile(tmpLocation.Name)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(written)).To(Equal(content))
+	}
+
+	It("limits the bandwidth shared by all ranges", func() {
+		downloader.Throttle = download.NewThrottle(200, 0)
+
+		start := time.Now()
+		err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		// a second's worth of bytes is allowed up front, the other 100 take half a second
+		Expect(time.Since(start)).To(BeNumerically(">=", 400*time.Millisecond))
+		expectContent()
+	})
+
+	It("limits the bandwidth of each connection", func() {
+		downloader.Throttle = download.NewThrottle(0, 100)
+
+		start := time.Now()
+		err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		elapsed := time.Since(start)
+		Expect(elapsed).To(BeNumerically(">=", 400*time.Millisecond))
+		Expect(elapsed).To(BeNumerically("<", 900*time.Millisecond))
+		expectContent()
+	})
+
+	It("applies limits changed during the download", func() {
+		throttle := download.NewThrottle(10, 0)
+		downloader.Throttle = throttle
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			throttle.SetLimit(0)
+		}()
+
+		start := time.Now()
+		err := downloader.Get(tmpLocation, downloadLinkFetcher, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+		Expect(throttle.Limit()).To(Equal(int64(0)))
+		expectContent()
+	})
+
+	It("stops waiting when the context is cancelled", func() {
+		downloader.Throttle = download.NewThrottle(1, 0)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		err := downloader.GetContext(ctx, tmpLocation, downloadLinkFetcher, GinkgoWriter)
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+	})
+})
@@ -0,0 +1,70 @@
+package download
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrRemoteFileChanged is returned when the file behind the download link
+// changes while it is being downloaded, for example because the product
+// file was re-uploaded. The partial download is discarded, so downloading
+// again starts from scratch.
+type ErrRemoteFileChanged struct {
+	Expected string `json:"expected" yaml:"expected"`
+	Actual   string `json:"actual" yaml:"actual"`
+}
+
+func (e ErrRemoteFileChanged) Error() string {
+	return fmt.Sprintf("remote file changed during download: expected version %s, got %s", e.Expected, e.Actual)
+}
+
+// remoteVersion identifies the version of the remote file measured by the
+// HEAD request of a download.
+type remoteVersion struct {
+	etag         string
+	lastModified string
+}
+
+func newRemoteVersion(header http.Header) remoteVersion {
+	return remoteVersion{
+		etag:         header.Get("ETag"),
+		lastModified: header.Get("Last-Modified"),
+	}
+}
+
+// String is recorded in resume state, so it must stay stable between runs.
+func (v remoteVersion) String() string {
+	if v.etag != "" {
+		return v.etag
+	}
+	return v.lastModified
+}
+
+// ifRange returns the value of the If-Range header for ranged requests, or
+// an empty string when the version cannot be used for one. Weak ETags are
+// not allowed in If-Range.
+func (v remoteVersion) ifRange() string {
+	if v.etag != "" && !strings.HasPrefix(v.etag, "W/") {
+		return v.etag
+	}
+	return v.lastModified
+}
+
+// check returns ErrRemoteFileChanged when a response is for a different
+// version of the file. Responses that do not say which version they are
+// for are assumed to match.
+func (v remoteVersion) check(header http.Header) error {
+	if etag := header.Get("ETag"); v.etag != "" && etag != "" {
+		if etag != v.etag {
+			return ErrRemoteFileChanged{Expected: v.etag, Actual: etag}
+		}
+		return nil
+	}
+
+	if lastModified := header.Get("Last-Modified"); v.lastModified != "" && lastModified != "" && lastModified != v.lastModified {
+		return ErrRemoteFileChanged{Expected: v.lastModified, Actual: lastModified}
+	}
+
+	return nil
+}
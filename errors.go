@@ -108,4 +108,31 @@ func newErrProxyAuthenticationRequired(message string) ErrProxyAuthenticationReq
 		ResponseCode: http.StatusProxyAuthRequired,
 		Message:      message,
 	}
-}
\ No newline at end of file
+}
+
+// ErrTokenExchange is returned when a UAA refresh token cannot be exchanged
+// for an access token, either because Pivnet rejected the exchange or because
+// it could not be reached.
+type ErrTokenExchange struct {
+	ResponseCode        int    `json:"response_code" yaml:"response_code"`
+	Message             string `json:"message" yaml:"message"`
+	InvalidRefreshToken bool   `json:"invalid_refresh_token" yaml:"invalid_refresh_token"`
+	Unreachable         bool   `json:"unreachable" yaml:"unreachable"`
+	Err                 error  `json:"-" yaml:"-"`
+}
+
+func (e ErrTokenExchange) Error() string {
+	if e.Unreachable {
+		return fmt.Sprintf("API token request failed: %s", e.Err)
+	}
+
+	if e.Message == "" {
+		return fmt.Sprintf("failed to fetch API token - received status %d", e.ResponseCode)
+	}
+
+	return fmt.Sprintf("failed to fetch API token - received status %d: %s", e.ResponseCode, e.Message)
+}
+
+func (e ErrTokenExchange) Unwrap() error {
+	return e.Err
+}
@@ -1,6 +1,7 @@
 package pivnet
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -11,6 +12,16 @@ type EULAsService struct {
 	client Client
 }
 
+//go:generate counterfeiter . EULAsAPI
+type EULAsAPI interface {
+	List() ([]EULA, error)
+	ListContext(ctx context.Context) ([]EULA, error)
+	Get(eulaSlug string) (EULA, error)
+	GetContext(ctx context.Context, eulaSlug string) (EULA, error)
+	Accept(productSlug string, releaseID int) error
+	AcceptContext(ctx context.Context, productSlug string, releaseID int) error
+}
+
 type EULA struct {
 	Slug        string  `json:"slug,omitempty" yaml:"slug,omitempty"`
 	ID          int     `json:"id,omitempty" yaml:"id,omitempty"`
@@ -31,10 +42,15 @@ type EULAAcceptanceResponse struct {
 }
 
 func (e EULAsService) List() ([]EULA, error) {
+	return e.ListContext(context.Background())
+}
+
+func (e EULAsService) ListContext(ctx context.Context) ([]EULA, error) {
 	url := "/eulas"
 
 	var response EULAsResponse
-	resp, err := e.client.MakeRequest(
+	resp, err := e.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -54,10 +70,15 @@ func (e EULAsService) List() ([]EULA, error) {
 }
 
 func (e EULAsService) Get(eulaSlug string) (EULA, error) {
+	return e.GetContext(context.Background(), eulaSlug)
+}
+
+func (e EULAsService) GetContext(ctx context.Context, eulaSlug string) (EULA, error) {
 	url := fmt.Sprintf("/eulas/%s", eulaSlug)
 
 	var response EULA
-	resp, err := e.client.MakeRequest(
+	resp, err := e.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -77,13 +98,18 @@ func (e EULAsService) Get(eulaSlug string) (EULA, error) {
 }
 
 func (e EULAsService) Accept(productSlug string, releaseID int) error {
+	return e.AcceptContext(context.Background(), productSlug, releaseID)
+}
+
+func (e EULAsService) AcceptContext(ctx context.Context, productSlug string, releaseID int) error {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/pivnet_resource_eula_acceptance",
 		productSlug,
 		releaseID,
 	)
 
-	resp, err := e.client.MakeRequest(
+	resp, err := e.client.MakeRequestContext(
+		ctx,
 		"POST",
 		url,
 		http.StatusOK,
@@ -2,6 +2,7 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -11,6 +12,12 @@ type FederationTokenService struct {
 	client Client
 }
 
+//go:generate counterfeiter . FederationTokenAPI
+type FederationTokenAPI interface {
+	GenerateFederationToken(productSlug string) (FederationToken, error)
+	GenerateFederationTokenContext(ctx context.Context, productSlug string) (FederationToken, error)
+}
+
 type FederationToken struct {
 	AccessKeyID     string `json:"access_key_id,omitempty" yaml:"access_key_id,omitempty"`
 	SecretAccessKey string `json:"secret_access_key,omitempty" yaml:"secret_access_key,omitempty"`
@@ -24,6 +31,10 @@ type createFederationTokenBody struct {
 }
 
 func (f FederationTokenService) GenerateFederationToken(productSlug string) (FederationToken, error) {
+	return f.GenerateFederationTokenContext(context.Background(), productSlug)
+}
+
+func (f FederationTokenService) GenerateFederationTokenContext(ctx context.Context, productSlug string) (FederationToken, error) {
 	url := fmt.Sprintf("/federation_token")
 
 	body := createFederationTokenBody{
@@ -37,7 +48,8 @@ func (f FederationTokenService) GenerateFederationToken(productSlug string) (Fed
 		return FederationToken{}, fmt.Errorf("could not marshall json: %v", err)
 	}
 
-	resp, err := f.client.MakeRequest(
+	resp, err := f.client.MakeRequestContext(
+		ctx,
 		"POST",
 		url,
 		http.StatusOK,
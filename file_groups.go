@@ -2,6 +2,7 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -11,6 +12,26 @@ type FileGroupsService struct {
 	client Client
 }
 
+//go:generate counterfeiter . FileGroupsAPI
+type FileGroupsAPI interface {
+	List(productSlug string) ([]FileGroup, error)
+	ListContext(ctx context.Context, productSlug string) ([]FileGroup, error)
+	Get(productSlug string, fileGroupID int) (FileGroup, error)
+	GetContext(ctx context.Context, productSlug string, fileGroupID int) (FileGroup, error)
+	Create(config CreateFileGroupConfig) (FileGroup, error)
+	CreateContext(ctx context.Context, config CreateFileGroupConfig) (FileGroup, error)
+	Update(productSlug string, fileGroup FileGroup) (FileGroup, error)
+	UpdateContext(ctx context.Context, productSlug string, fileGroup FileGroup) (FileGroup, error)
+	Delete(productSlug string, id int) (FileGroup, error)
+	DeleteContext(ctx context.Context, productSlug string, id int) (FileGroup, error)
+	ListForRelease(productSlug string, releaseID int) ([]FileGroup, error)
+	ListForReleaseContext(ctx context.Context, productSlug string, releaseID int) ([]FileGroup, error)
+	AddToRelease(productSlug string, releaseID int, fileGroupID int) error
+	AddToReleaseContext(ctx context.Context, productSlug string, releaseID int, fileGroupID int) error
+	RemoveFromRelease(productSlug string, releaseID int, fileGroupID int) error
+	RemoveFromReleaseContext(ctx context.Context, productSlug string, releaseID int, fileGroupID int) error
+}
+
 type createFileGroupBody struct {
 	FileGroup createFileGroup `json:"file_group"`
 }
@@ -49,10 +70,15 @@ type FileGroupsResponse struct {
 }
 
 func (e FileGroupsService) List(productSlug string) ([]FileGroup, error) {
+	return e.ListContext(context.Background(), productSlug)
+}
+
+func (e FileGroupsService) ListContext(ctx context.Context, productSlug string) ([]FileGroup, error) {
 	url := fmt.Sprintf("/products/%s/file_groups", productSlug)
 
 	var response FileGroupsResponse
-	resp, err := e.client.MakeRequest(
+	resp, err := e.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -72,13 +98,18 @@ func (e FileGroupsService) List(productSlug string) ([]FileGroup, error) {
 }
 
 func (p FileGroupsService) Get(productSlug string, fileGroupID int) (FileGroup, error) {
+	return p.GetContext(context.Background(), productSlug, fileGroupID)
+}
+
+func (p FileGroupsService) GetContext(ctx context.Context, productSlug string, fileGroupID int) (FileGroup, error) {
 	url := fmt.Sprintf("/products/%s/file_groups/%d",
 		productSlug,
 		fileGroupID,
 	)
 
 	var response FileGroup
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -98,6 +129,10 @@ func (p FileGroupsService) Get(productSlug string, fileGroupID int) (FileGroup,
 }
 
 func (p FileGroupsService) Create(config CreateFileGroupConfig) (FileGroup, error) {
+	return p.CreateContext(context.Background(), config)
+}
+
+func (p FileGroupsService) CreateContext(ctx context.Context, config CreateFileGroupConfig) (FileGroup, error) {
 	url := fmt.Sprintf(
 		"/products/%s/file_groups",
 		config.ProductSlug,
@@ -119,7 +154,8 @@ func (p FileGroupsService) Create(config CreateFileGroupConfig) (FileGroup, erro
 	body := bytes.NewReader(b)
 
 	var response FileGroup
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"POST",
 		url,
 		http.StatusCreated,
@@ -139,6 +175,10 @@ func (p FileGroupsService) Create(config CreateFileGroupConfig) (FileGroup, erro
 }
 
 func (p FileGroupsService) Update(productSlug string, fileGroup FileGroup) (FileGroup, error) {
+	return p.UpdateContext(context.Background(), productSlug, fileGroup)
+}
+
+func (p FileGroupsService) UpdateContext(ctx context.Context, productSlug string, fileGroup FileGroup) (FileGroup, error) {
 	url := fmt.Sprintf(
 		"/products/%s/file_groups/%d",
 		productSlug,
@@ -161,7 +201,8 @@ func (p FileGroupsService) Update(productSlug string, fileGroup FileGroup) (File
 	body := bytes.NewReader(b)
 
 	var response FileGroup
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusOK,
@@ -181,6 +222,10 @@ func (p FileGroupsService) Update(productSlug string, fileGroup FileGroup) (File
 }
 
 func (p FileGroupsService) Delete(productSlug string, id int) (FileGroup, error) {
+	return p.DeleteContext(context.Background(), productSlug, id)
+}
+
+func (p FileGroupsService) DeleteContext(ctx context.Context, productSlug string, id int) (FileGroup, error) {
 	url := fmt.Sprintf(
 		"/products/%s/file_groups/%d",
 		productSlug,
@@ -188,7 +233,8 @@ func (p FileGroupsService) Delete(productSlug string, id int) (FileGroup, error)
 	)
 
 	var response FileGroup
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"DELETE",
 		url,
 		http.StatusOK,
@@ -208,13 +254,18 @@ func (p FileGroupsService) Delete(productSlug string, id int) (FileGroup, error)
 }
 
 func (p FileGroupsService) ListForRelease(productSlug string, releaseID int) ([]FileGroup, error) {
+	return p.ListForReleaseContext(context.Background(), productSlug, releaseID)
+}
+
+func (p FileGroupsService) ListForReleaseContext(ctx context.Context, productSlug string, releaseID int) ([]FileGroup, error) {
 	url := fmt.Sprintf("/products/%s/releases/%d/file_groups",
 		productSlug,
 		releaseID,
 	)
 
 	var response FileGroupsResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -237,6 +288,15 @@ func (r FileGroupsService) AddToRelease(
 	productSlug string,
 	releaseID int,
 	fileGroupID int,
+) error {
+	return r.AddToReleaseContext(context.Background(), productSlug, releaseID, fileGroupID)
+}
+
+func (r FileGroupsService) AddToReleaseContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	fileGroupID int,
 ) error {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/add_file_group",
@@ -257,7 +317,8 @@ func (r FileGroupsService) AddToRelease(
 		return err
 	}
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -275,6 +336,15 @@ func (r FileGroupsService) RemoveFromRelease(
 	productSlug string,
 	releaseID int,
 	fileGroupID int,
+) error {
+	return r.RemoveFromReleaseContext(context.Background(), productSlug, releaseID, fileGroupID)
+}
+
+func (r FileGroupsService) RemoveFromReleaseContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	fileGroupID int,
 ) error {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/remove_file_group",
@@ -295,7 +365,8 @@ func (r FileGroupsService) RemoveFromRelease(
 		return err
 	}
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -0,0 +1,1662 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package gopivnetfakes
+
+import (
+	"context"
+	"sync"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v9"
+)
+
+type FakeArtifactReferencesAPI struct {
+	AddToReleaseStub        func(string, int, int) error
+	addToReleaseMutex       sync.RWMutex
+	addToReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	addToReleaseReturns struct {
+		result1 error
+	}
+	addToReleaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddToReleaseContextStub        func(context.Context, string, int, int) error
+	addToReleaseContextMutex       sync.RWMutex
+	addToReleaseContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	addToReleaseContextReturns struct {
+		result1 error
+	}
+	addToReleaseContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateStub        func(pivnet.CreateArtifactReferenceConfig) (pivnet.ArtifactReference, error)
+	createMutex       sync.RWMutex
+	createArgsForCall []struct {
+		arg1 pivnet.CreateArtifactReferenceConfig
+	}
+	createReturns struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}
+	createReturnsOnCall map[int]struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}
+	CreateContextStub        func(context.Context, pivnet.CreateArtifactReferenceConfig) (pivnet.ArtifactReference, error)
+	createContextMutex       sync.RWMutex
+	createContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 pivnet.CreateArtifactReferenceConfig
+	}
+	createContextReturns struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}
+	createContextReturnsOnCall map[int]struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}
+	DeleteStub        func(string, int) (pivnet.ArtifactReference, error)
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	deleteReturns struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}
+	DeleteContextStub        func(context.Context, string, int) (pivnet.ArtifactReference, error)
+	deleteContextMutex       sync.RWMutex
+	deleteContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	deleteContextReturns struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}
+	deleteContextReturnsOnCall map[int]struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}
+	GetStub        func(string, int) (pivnet.ArtifactReference, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	getReturns struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}
+	GetContextStub        func(context.Context, string, int) (pivnet.ArtifactReference, error)
+	getContextMutex       sync.RWMutex
+	getContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	getContextReturns struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}
+	getContextReturnsOnCall map[int]struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}
+	GetForReleaseStub        func(string, int, int) (pivnet.ArtifactReference, error)
+	getForReleaseMutex       sync.RWMutex
+	getForReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	getForReleaseReturns struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}
+	getForReleaseReturnsOnCall map[int]struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}
+	GetForReleaseContextStub        func(context.Context, string, int, int) (pivnet.ArtifactReference, error)
+	getForReleaseContextMutex       sync.RWMutex
+	getForReleaseContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	getForReleaseContextReturns struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}
+	getForReleaseContextReturnsOnCall map[int]struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}
+	ListStub        func(string) ([]pivnet.ArtifactReference, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		arg1 string
+	}
+	listReturns struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}
+	ListContextStub        func(context.Context, string) ([]pivnet.ArtifactReference, error)
+	listContextMutex       sync.RWMutex
+	listContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	listContextReturns struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}
+	listContextReturnsOnCall map[int]struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}
+	ListForDigestStub        func(string, string) ([]pivnet.ArtifactReference, error)
+	listForDigestMutex       sync.RWMutex
+	listForDigestArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	listForDigestReturns struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}
+	listForDigestReturnsOnCall map[int]struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}
+	ListForDigestContextStub        func(context.Context, string, string) ([]pivnet.ArtifactReference, error)
+	listForDigestContextMutex       sync.RWMutex
+	listForDigestContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	listForDigestContextReturns struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}
+	listForDigestContextReturnsOnCall map[int]struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}
+	ListForReleaseStub        func(string, int) ([]pivnet.ArtifactReference, error)
+	listForReleaseMutex       sync.RWMutex
+	listForReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	listForReleaseReturns struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}
+	listForReleaseReturnsOnCall map[int]struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}
+	ListForReleaseContextStub        func(context.Context, string, int) ([]pivnet.ArtifactReference, error)
+	listForReleaseContextMutex       sync.RWMutex
+	listForReleaseContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	listForReleaseContextReturns struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}
+	listForReleaseContextReturnsOnCall map[int]struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}
+	RemoveFromReleaseStub        func(string, int, int) error
+	removeFromReleaseMutex       sync.RWMutex
+	removeFromReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	removeFromReleaseReturns struct {
+		result1 error
+	}
+	removeFromReleaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveFromReleaseContextStub        func(context.Context, string, int, int) error
+	removeFromReleaseContextMutex       sync.RWMutex
+	removeFromReleaseContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	removeFromReleaseContextReturns struct {
+		result1 error
+	}
+	removeFromReleaseContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateStub        func(string, pivnet.ArtifactReference) (pivnet.ArtifactReference, error)
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 string
+		arg2 pivnet.ArtifactReference
+	}
+	updateReturns struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}
+	UpdateContextStub        func(context.Context, string, pivnet.ArtifactReference) (pivnet.ArtifactReference, error)
+	updateContextMutex       sync.RWMutex
+	updateContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 pivnet.ArtifactReference
+	}
+	updateContextReturns struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}
+	updateContextReturnsOnCall map[int]struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeArtifactReferencesAPI) AddToRelease(arg1 string, arg2 int, arg3 int) error {
+	fake.addToReleaseMutex.Lock()
+	ret, specificReturn := fake.addToReleaseReturnsOnCall[len(fake.addToReleaseArgsForCall)]
+	fake.addToReleaseArgsForCall = append(fake.addToReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.AddToReleaseStub
+	fakeReturns := fake.addToReleaseReturns
+	fake.recordInvocation("AddToRelease", []interface{}{arg1, arg2, arg3})
+	fake.addToReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeArtifactReferencesAPI) AddToReleaseCallCount() int {
+	fake.addToReleaseMutex.RLock()
+	defer fake.addToReleaseMutex.RUnlock()
+	return len(fake.addToReleaseArgsForCall)
+}
+
+func (fake *FakeArtifactReferencesAPI) AddToReleaseCalls(stub func(string, int, int) error) {
+	fake.addToReleaseMutex.Lock()
+	defer fake.addToReleaseMutex.Unlock()
+	fake.AddToReleaseStub = stub
+}
+
+func (fake *FakeArtifactReferencesAPI) AddToReleaseArgsForCall(i int) (string, int, int) {
+	fake.addToReleaseMutex.RLock()
+	defer fake.addToReleaseMutex.RUnlock()
+	argsForCall := fake.addToReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeArtifactReferencesAPI) AddToReleaseReturns(result1 error) {
+	fake.addToReleaseMutex.Lock()
+	defer fake.addToReleaseMutex.Unlock()
+	fake.AddToReleaseStub = nil
+	fake.addToReleaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeArtifactReferencesAPI) AddToReleaseReturnsOnCall(i int, result1 error) {
+	fake.addToReleaseMutex.Lock()
+	defer fake.addToReleaseMutex.Unlock()
+	fake.AddToReleaseStub = nil
+	if fake.addToReleaseReturnsOnCall == nil {
+		fake.addToReleaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addToReleaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeArtifactReferencesAPI) AddToReleaseContext(arg1 context.Context, arg2 string, arg3 int, arg4 int) error {
+	fake.addToReleaseContextMutex.Lock()
+	ret, specificReturn := fake.addToReleaseContextReturnsOnCall[len(fake.addToReleaseContextArgsForCall)]
+	fake.addToReleaseContextArgsForCall = append(fake.addToReleaseContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AddToReleaseContextStub
+	fakeReturns := fake.addToReleaseContextReturns
+	fake.recordInvocation("AddToReleaseContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.addToReleaseContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeArtifactReferencesAPI) AddToReleaseContextCallCount() int {
+	fake.addToReleaseContextMutex.RLock()
+	defer fake.addToReleaseContextMutex.RUnlock()
+	return len(fake.addToReleaseContextArgsForCall)
+}
+
+func (fake *FakeArtifactReferencesAPI) AddToReleaseContextCalls(stub func(context.Context, string, int, int) error) {
+	fake.addToReleaseContextMutex.Lock()
+	defer fake.addToReleaseContextMutex.Unlock()
+	fake.AddToReleaseContextStub = stub
+}
+
+func (fake *FakeArtifactReferencesAPI) AddToReleaseContextArgsForCall(i int) (context.Context, string, int, int) {
+	fake.addToReleaseContextMutex.RLock()
+	defer fake.addToReleaseContextMutex.RUnlock()
+	argsForCall := fake.addToReleaseContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeArtifactReferencesAPI) AddToReleaseContextReturns(result1 error) {
+	fake.addToReleaseContextMutex.Lock()
+	defer fake.addToReleaseContextMutex.Unlock()
+	fake.AddToReleaseContextStub = nil
+	fake.addToReleaseContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeArtifactReferencesAPI) AddToReleaseContextReturnsOnCall(i int, result1 error) {
+	fake.addToReleaseContextMutex.Lock()
+	defer fake.addToReleaseContextMutex.Unlock()
+	fake.AddToReleaseContextStub = nil
+	if fake.addToReleaseContextReturnsOnCall == nil {
+		fake.addToReleaseContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addToReleaseContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeArtifactReferencesAPI) Create(arg1 pivnet.CreateArtifactReferenceConfig) (pivnet.ArtifactReference, error) {
+	fake.createMutex.Lock()
+	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
+	fake.createArgsForCall = append(fake.createArgsForCall, struct {
+		arg1 pivnet.CreateArtifactReferenceConfig
+	}{arg1})
+	stub := fake.CreateStub
+	fakeReturns := fake.createReturns
+	fake.recordInvocation("Create", []interface{}{arg1})
+	fake.createMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeArtifactReferencesAPI) CreateCallCount() int {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return len(fake.createArgsForCall)
+}
+
+func (fake *FakeArtifactReferencesAPI) CreateCalls(stub func(pivnet.CreateArtifactReferenceConfig) (pivnet.ArtifactReference, error)) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = stub
+}
+
+func (fake *FakeArtifactReferencesAPI) CreateArgsForCall(i int) pivnet.CreateArtifactReferenceConfig {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	argsForCall := fake.createArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeArtifactReferencesAPI) CreateReturns(result1 pivnet.ArtifactReference, result2 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	fake.createReturns = struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) CreateReturnsOnCall(i int, result1 pivnet.ArtifactReference, result2 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ArtifactReference
+			result2 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) CreateContext(arg1 context.Context, arg2 pivnet.CreateArtifactReferenceConfig) (pivnet.ArtifactReference, error) {
+	fake.createContextMutex.Lock()
+	ret, specificReturn := fake.createContextReturnsOnCall[len(fake.createContextArgsForCall)]
+	fake.createContextArgsForCall = append(fake.createContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 pivnet.CreateArtifactReferenceConfig
+	}{arg1, arg2})
+	stub := fake.CreateContextStub
+	fakeReturns := fake.createContextReturns
+	fake.recordInvocation("CreateContext", []interface{}{arg1, arg2})
+	fake.createContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeArtifactReferencesAPI) CreateContextCallCount() int {
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	return len(fake.createContextArgsForCall)
+}
+
+func (fake *FakeArtifactReferencesAPI) CreateContextCalls(stub func(context.Context, pivnet.CreateArtifactReferenceConfig) (pivnet.ArtifactReference, error)) {
+	fake.createContextMutex.Lock()
+	defer fake.createContextMutex.Unlock()
+	fake.CreateContextStub = stub
+}
+
+func (fake *FakeArtifactReferencesAPI) CreateContextArgsForCall(i int) (context.Context, pivnet.CreateArtifactReferenceConfig) {
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	argsForCall := fake.createContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeArtifactReferencesAPI) CreateContextReturns(result1 pivnet.ArtifactReference, result2 error) {
+	fake.createContextMutex.Lock()
+	defer fake.createContextMutex.Unlock()
+	fake.CreateContextStub = nil
+	fake.createContextReturns = struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) CreateContextReturnsOnCall(i int, result1 pivnet.ArtifactReference, result2 error) {
+	fake.createContextMutex.Lock()
+	defer fake.createContextMutex.Unlock()
+	fake.CreateContextStub = nil
+	if fake.createContextReturnsOnCall == nil {
+		fake.createContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ArtifactReference
+			result2 error
+		})
+	}
+	fake.createContextReturnsOnCall[i] = struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) Delete(arg1 string, arg2 int) (pivnet.ArtifactReference, error) {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.DeleteStub
+	fakeReturns := fake.deleteReturns
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
+	fake.deleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeArtifactReferencesAPI) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeArtifactReferencesAPI) DeleteCalls(stub func(string, int) (pivnet.ArtifactReference, error)) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = stub
+}
+
+func (fake *FakeArtifactReferencesAPI) DeleteArgsForCall(i int) (string, int) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	argsForCall := fake.deleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeArtifactReferencesAPI) DeleteReturns(result1 pivnet.ArtifactReference, result2 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) DeleteReturnsOnCall(i int, result1 pivnet.ArtifactReference, result2 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ArtifactReference
+			result2 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) DeleteContext(arg1 context.Context, arg2 string, arg3 int) (pivnet.ArtifactReference, error) {
+	fake.deleteContextMutex.Lock()
+	ret, specificReturn := fake.deleteContextReturnsOnCall[len(fake.deleteContextArgsForCall)]
+	fake.deleteContextArgsForCall = append(fake.deleteContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteContextStub
+	fakeReturns := fake.deleteContextReturns
+	fake.recordInvocation("DeleteContext", []interface{}{arg1, arg2, arg3})
+	fake.deleteContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeArtifactReferencesAPI) DeleteContextCallCount() int {
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	return len(fake.deleteContextArgsForCall)
+}
+
+func (fake *FakeArtifactReferencesAPI) DeleteContextCalls(stub func(context.Context, string, int) (pivnet.ArtifactReference, error)) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = stub
+}
+
+func (fake *FakeArtifactReferencesAPI) DeleteContextArgsForCall(i int) (context.Context, string, int) {
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	argsForCall := fake.deleteContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeArtifactReferencesAPI) DeleteContextReturns(result1 pivnet.ArtifactReference, result2 error) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = nil
+	fake.deleteContextReturns = struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) DeleteContextReturnsOnCall(i int, result1 pivnet.ArtifactReference, result2 error) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = nil
+	if fake.deleteContextReturnsOnCall == nil {
+		fake.deleteContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ArtifactReference
+			result2 error
+		})
+	}
+	fake.deleteContextReturnsOnCall[i] = struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) Get(arg1 string, arg2 int) (pivnet.ArtifactReference, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1, arg2})
+	fake.getMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeArtifactReferencesAPI) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeArtifactReferencesAPI) GetCalls(stub func(string, int) (pivnet.ArtifactReference, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeArtifactReferencesAPI) GetArgsForCall(i int) (string, int) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeArtifactReferencesAPI) GetReturns(result1 pivnet.ArtifactReference, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) GetReturnsOnCall(i int, result1 pivnet.ArtifactReference, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ArtifactReference
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) GetContext(arg1 context.Context, arg2 string, arg3 int) (pivnet.ArtifactReference, error) {
+	fake.getContextMutex.Lock()
+	ret, specificReturn := fake.getContextReturnsOnCall[len(fake.getContextArgsForCall)]
+	fake.getContextArgsForCall = append(fake.getContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GetContextStub
+	fakeReturns := fake.getContextReturns
+	fake.recordInvocation("GetContext", []interface{}{arg1, arg2, arg3})
+	fake.getContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeArtifactReferencesAPI) GetContextCallCount() int {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	return len(fake.getContextArgsForCall)
+}
+
+func (fake *FakeArtifactReferencesAPI) GetContextCalls(stub func(context.Context, string, int) (pivnet.ArtifactReference, error)) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = stub
+}
+
+func (fake *FakeArtifactReferencesAPI) GetContextArgsForCall(i int) (context.Context, string, int) {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	argsForCall := fake.getContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeArtifactReferencesAPI) GetContextReturns(result1 pivnet.ArtifactReference, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	fake.getContextReturns = struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) GetContextReturnsOnCall(i int, result1 pivnet.ArtifactReference, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	if fake.getContextReturnsOnCall == nil {
+		fake.getContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ArtifactReference
+			result2 error
+		})
+	}
+	fake.getContextReturnsOnCall[i] = struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) GetForRelease(arg1 string, arg2 int, arg3 int) (pivnet.ArtifactReference, error) {
+	fake.getForReleaseMutex.Lock()
+	ret, specificReturn := fake.getForReleaseReturnsOnCall[len(fake.getForReleaseArgsForCall)]
+	fake.getForReleaseArgsForCall = append(fake.getForReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GetForReleaseStub
+	fakeReturns := fake.getForReleaseReturns
+	fake.recordInvocation("GetForRelease", []interface{}{arg1, arg2, arg3})
+	fake.getForReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeArtifactReferencesAPI) GetForReleaseCallCount() int {
+	fake.getForReleaseMutex.RLock()
+	defer fake.getForReleaseMutex.RUnlock()
+	return len(fake.getForReleaseArgsForCall)
+}
+
+func (fake *FakeArtifactReferencesAPI) GetForReleaseCalls(stub func(string, int, int) (pivnet.ArtifactReference, error)) {
+	fake.getForReleaseMutex.Lock()
+	defer fake.getForReleaseMutex.Unlock()
+	fake.GetForReleaseStub = stub
+}
+
+func (fake *FakeArtifactReferencesAPI) GetForReleaseArgsForCall(i int) (string, int, int) {
+	fake.getForReleaseMutex.RLock()
+	defer fake.getForReleaseMutex.RUnlock()
+	argsForCall := fake.getForReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeArtifactReferencesAPI) GetForReleaseReturns(result1 pivnet.ArtifactReference, result2 error) {
+	fake.getForReleaseMutex.Lock()
+	defer fake.getForReleaseMutex.Unlock()
+	fake.GetForReleaseStub = nil
+	fake.getForReleaseReturns = struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) GetForReleaseReturnsOnCall(i int, result1 pivnet.ArtifactReference, result2 error) {
+	fake.getForReleaseMutex.Lock()
+	defer fake.getForReleaseMutex.Unlock()
+	fake.GetForReleaseStub = nil
+	if fake.getForReleaseReturnsOnCall == nil {
+		fake.getForReleaseReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ArtifactReference
+			result2 error
+		})
+	}
+	fake.getForReleaseReturnsOnCall[i] = struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) GetForReleaseContext(arg1 context.Context, arg2 string, arg3 int, arg4 int) (pivnet.ArtifactReference, error) {
+	fake.getForReleaseContextMutex.Lock()
+	ret, specificReturn := fake.getForReleaseContextReturnsOnCall[len(fake.getForReleaseContextArgsForCall)]
+	fake.getForReleaseContextArgsForCall = append(fake.getForReleaseContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetForReleaseContextStub
+	fakeReturns := fake.getForReleaseContextReturns
+	fake.recordInvocation("GetForReleaseContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getForReleaseContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeArtifactReferencesAPI) GetForReleaseContextCallCount() int {
+	fake.getForReleaseContextMutex.RLock()
+	defer fake.getForReleaseContextMutex.RUnlock()
+	return len(fake.getForReleaseContextArgsForCall)
+}
+
+func (fake *FakeArtifactReferencesAPI) GetForReleaseContextCalls(stub func(context.Context, string, int, int) (pivnet.ArtifactReference, error)) {
+	fake.getForReleaseContextMutex.Lock()
+	defer fake.getForReleaseContextMutex.Unlock()
+	fake.GetForReleaseContextStub = stub
+}
+
+func (fake *FakeArtifactReferencesAPI) GetForReleaseContextArgsForCall(i int) (context.Context, string, int, int) {
+	fake.getForReleaseContextMutex.RLock()
+	defer fake.getForReleaseContextMutex.RUnlock()
+	argsForCall := fake.getForReleaseContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeArtifactReferencesAPI) GetForReleaseContextReturns(result1 pivnet.ArtifactReference, result2 error) {
+	fake.getForReleaseContextMutex.Lock()
+	defer fake.getForReleaseContextMutex.Unlock()
+	fake.GetForReleaseContextStub = nil
+	fake.getForReleaseContextReturns = struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) GetForReleaseContextReturnsOnCall(i int, result1 pivnet.ArtifactReference, result2 error) {
+	fake.getForReleaseContextMutex.Lock()
+	defer fake.getForReleaseContextMutex.Unlock()
+	fake.GetForReleaseContextStub = nil
+	if fake.getForReleaseContextReturnsOnCall == nil {
+		fake.getForReleaseContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ArtifactReference
+			result2 error
+		})
+	}
+	fake.getForReleaseContextReturnsOnCall[i] = struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) List(arg1 string) ([]pivnet.ArtifactReference, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{arg1})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeArtifactReferencesAPI) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeArtifactReferencesAPI) ListCalls(stub func(string) ([]pivnet.ArtifactReference, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeArtifactReferencesAPI) ListArgsForCall(i int) string {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	argsForCall := fake.listArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeArtifactReferencesAPI) ListReturns(result1 []pivnet.ArtifactReference, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) ListReturnsOnCall(i int, result1 []pivnet.ArtifactReference, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ArtifactReference
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) ListContext(arg1 context.Context, arg2 string) ([]pivnet.ArtifactReference, error) {
+	fake.listContextMutex.Lock()
+	ret, specificReturn := fake.listContextReturnsOnCall[len(fake.listContextArgsForCall)]
+	fake.listContextArgsForCall = append(fake.listContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ListContextStub
+	fakeReturns := fake.listContextReturns
+	fake.recordInvocation("ListContext", []interface{}{arg1, arg2})
+	fake.listContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeArtifactReferencesAPI) ListContextCallCount() int {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	return len(fake.listContextArgsForCall)
+}
+
+func (fake *FakeArtifactReferencesAPI) ListContextCalls(stub func(context.Context, string) ([]pivnet.ArtifactReference, error)) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = stub
+}
+
+func (fake *FakeArtifactReferencesAPI) ListContextArgsForCall(i int) (context.Context, string) {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	argsForCall := fake.listContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeArtifactReferencesAPI) ListContextReturns(result1 []pivnet.ArtifactReference, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	fake.listContextReturns = struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) ListContextReturnsOnCall(i int, result1 []pivnet.ArtifactReference, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	if fake.listContextReturnsOnCall == nil {
+		fake.listContextReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ArtifactReference
+			result2 error
+		})
+	}
+	fake.listContextReturnsOnCall[i] = struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForDigest(arg1 string, arg2 string) ([]pivnet.ArtifactReference, error) {
+	fake.listForDigestMutex.Lock()
+	ret, specificReturn := fake.listForDigestReturnsOnCall[len(fake.listForDigestArgsForCall)]
+	fake.listForDigestArgsForCall = append(fake.listForDigestArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ListForDigestStub
+	fakeReturns := fake.listForDigestReturns
+	fake.recordInvocation("ListForDigest", []interface{}{arg1, arg2})
+	fake.listForDigestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForDigestCallCount() int {
+	fake.listForDigestMutex.RLock()
+	defer fake.listForDigestMutex.RUnlock()
+	return len(fake.listForDigestArgsForCall)
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForDigestCalls(stub func(string, string) ([]pivnet.ArtifactReference, error)) {
+	fake.listForDigestMutex.Lock()
+	defer fake.listForDigestMutex.Unlock()
+	fake.ListForDigestStub = stub
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForDigestArgsForCall(i int) (string, string) {
+	fake.listForDigestMutex.RLock()
+	defer fake.listForDigestMutex.RUnlock()
+	argsForCall := fake.listForDigestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForDigestReturns(result1 []pivnet.ArtifactReference, result2 error) {
+	fake.listForDigestMutex.Lock()
+	defer fake.listForDigestMutex.Unlock()
+	fake.ListForDigestStub = nil
+	fake.listForDigestReturns = struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForDigestReturnsOnCall(i int, result1 []pivnet.ArtifactReference, result2 error) {
+	fake.listForDigestMutex.Lock()
+	defer fake.listForDigestMutex.Unlock()
+	fake.ListForDigestStub = nil
+	if fake.listForDigestReturnsOnCall == nil {
+		fake.listForDigestReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ArtifactReference
+			result2 error
+		})
+	}
+	fake.listForDigestReturnsOnCall[i] = struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForDigestContext(arg1 context.Context, arg2 string, arg3 string) ([]pivnet.ArtifactReference, error) {
+	fake.listForDigestContextMutex.Lock()
+	ret, specificReturn := fake.listForDigestContextReturnsOnCall[len(fake.listForDigestContextArgsForCall)]
+	fake.listForDigestContextArgsForCall = append(fake.listForDigestContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ListForDigestContextStub
+	fakeReturns := fake.listForDigestContextReturns
+	fake.recordInvocation("ListForDigestContext", []interface{}{arg1, arg2, arg3})
+	fake.listForDigestContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForDigestContextCallCount() int {
+	fake.listForDigestContextMutex.RLock()
+	defer fake.listForDigestContextMutex.RUnlock()
+	return len(fake.listForDigestContextArgsForCall)
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForDigestContextCalls(stub func(context.Context, string, string) ([]pivnet.ArtifactReference, error)) {
+	fake.listForDigestContextMutex.Lock()
+	defer fake.listForDigestContextMutex.Unlock()
+	fake.ListForDigestContextStub = stub
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForDigestContextArgsForCall(i int) (context.Context, string, string) {
+	fake.listForDigestContextMutex.RLock()
+	defer fake.listForDigestContextMutex.RUnlock()
+	argsForCall := fake.listForDigestContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForDigestContextReturns(result1 []pivnet.ArtifactReference, result2 error) {
+	fake.listForDigestContextMutex.Lock()
+	defer fake.listForDigestContextMutex.Unlock()
+	fake.ListForDigestContextStub = nil
+	fake.listForDigestContextReturns = struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForDigestContextReturnsOnCall(i int, result1 []pivnet.ArtifactReference, result2 error) {
+	fake.listForDigestContextMutex.Lock()
+	defer fake.listForDigestContextMutex.Unlock()
+	fake.ListForDigestContextStub = nil
+	if fake.listForDigestContextReturnsOnCall == nil {
+		fake.listForDigestContextReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ArtifactReference
+			result2 error
+		})
+	}
+	fake.listForDigestContextReturnsOnCall[i] = struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForRelease(arg1 string, arg2 int) ([]pivnet.ArtifactReference, error) {
+	fake.listForReleaseMutex.Lock()
+	ret, specificReturn := fake.listForReleaseReturnsOnCall[len(fake.listForReleaseArgsForCall)]
+	fake.listForReleaseArgsForCall = append(fake.listForReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ListForReleaseStub
+	fakeReturns := fake.listForReleaseReturns
+	fake.recordInvocation("ListForRelease", []interface{}{arg1, arg2})
+	fake.listForReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForReleaseCallCount() int {
+	fake.listForReleaseMutex.RLock()
+	defer fake.listForReleaseMutex.RUnlock()
+	return len(fake.listForReleaseArgsForCall)
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForReleaseCalls(stub func(string, int) ([]pivnet.ArtifactReference, error)) {
+	fake.listForReleaseMutex.Lock()
+	defer fake.listForReleaseMutex.Unlock()
+	fake.ListForReleaseStub = stub
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForReleaseArgsForCall(i int) (string, int) {
+	fake.listForReleaseMutex.RLock()
+	defer fake.listForReleaseMutex.RUnlock()
+	argsForCall := fake.listForReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForReleaseReturns(result1 []pivnet.ArtifactReference, result2 error) {
+	fake.listForReleaseMutex.Lock()
+	defer fake.listForReleaseMutex.Unlock()
+	fake.ListForReleaseStub = nil
+	fake.listForReleaseReturns = struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForReleaseReturnsOnCall(i int, result1 []pivnet.ArtifactReference, result2 error) {
+	fake.listForReleaseMutex.Lock()
+	defer fake.listForReleaseMutex.Unlock()
+	fake.ListForReleaseStub = nil
+	if fake.listForReleaseReturnsOnCall == nil {
+		fake.listForReleaseReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ArtifactReference
+			result2 error
+		})
+	}
+	fake.listForReleaseReturnsOnCall[i] = struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForReleaseContext(arg1 context.Context, arg2 string, arg3 int) ([]pivnet.ArtifactReference, error) {
+	fake.listForReleaseContextMutex.Lock()
+	ret, specificReturn := fake.listForReleaseContextReturnsOnCall[len(fake.listForReleaseContextArgsForCall)]
+	fake.listForReleaseContextArgsForCall = append(fake.listForReleaseContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.ListForReleaseContextStub
+	fakeReturns := fake.listForReleaseContextReturns
+	fake.recordInvocation("ListForReleaseContext", []interface{}{arg1, arg2, arg3})
+	fake.listForReleaseContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForReleaseContextCallCount() int {
+	fake.listForReleaseContextMutex.RLock()
+	defer fake.listForReleaseContextMutex.RUnlock()
+	return len(fake.listForReleaseContextArgsForCall)
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForReleaseContextCalls(stub func(context.Context, string, int) ([]pivnet.ArtifactReference, error)) {
+	fake.listForReleaseContextMutex.Lock()
+	defer fake.listForReleaseContextMutex.Unlock()
+	fake.ListForReleaseContextStub = stub
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForReleaseContextArgsForCall(i int) (context.Context, string, int) {
+	fake.listForReleaseContextMutex.RLock()
+	defer fake.listForReleaseContextMutex.RUnlock()
+	argsForCall := fake.listForReleaseContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForReleaseContextReturns(result1 []pivnet.ArtifactReference, result2 error) {
+	fake.listForReleaseContextMutex.Lock()
+	defer fake.listForReleaseContextMutex.Unlock()
+	fake.ListForReleaseContextStub = nil
+	fake.listForReleaseContextReturns = struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) ListForReleaseContextReturnsOnCall(i int, result1 []pivnet.ArtifactReference, result2 error) {
+	fake.listForReleaseContextMutex.Lock()
+	defer fake.listForReleaseContextMutex.Unlock()
+	fake.ListForReleaseContextStub = nil
+	if fake.listForReleaseContextReturnsOnCall == nil {
+		fake.listForReleaseContextReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ArtifactReference
+			result2 error
+		})
+	}
+	fake.listForReleaseContextReturnsOnCall[i] = struct {
+		result1 []pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) RemoveFromRelease(arg1 string, arg2 int, arg3 int) error {
+	fake.removeFromReleaseMutex.Lock()
+	ret, specificReturn := fake.removeFromReleaseReturnsOnCall[len(fake.removeFromReleaseArgsForCall)]
+	fake.removeFromReleaseArgsForCall = append(fake.removeFromReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveFromReleaseStub
+	fakeReturns := fake.removeFromReleaseReturns
+	fake.recordInvocation("RemoveFromRelease", []interface{}{arg1, arg2, arg3})
+	fake.removeFromReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeArtifactReferencesAPI) RemoveFromReleaseCallCount() int {
+	fake.removeFromReleaseMutex.RLock()
+	defer fake.removeFromReleaseMutex.RUnlock()
+	return len(fake.removeFromReleaseArgsForCall)
+}
+
+func (fake *FakeArtifactReferencesAPI) RemoveFromReleaseCalls(stub func(string, int, int) error) {
+	fake.removeFromReleaseMutex.Lock()
+	defer fake.removeFromReleaseMutex.Unlock()
+	fake.RemoveFromReleaseStub = stub
+}
+
+func (fake *FakeArtifactReferencesAPI) RemoveFromReleaseArgsForCall(i int) (string, int, int) {
+	fake.removeFromReleaseMutex.RLock()
+	defer fake.removeFromReleaseMutex.RUnlock()
+	argsForCall := fake.removeFromReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeArtifactReferencesAPI) RemoveFromReleaseReturns(result1 error) {
+	fake.removeFromReleaseMutex.Lock()
+	defer fake.removeFromReleaseMutex.Unlock()
+	fake.RemoveFromReleaseStub = nil
+	fake.removeFromReleaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeArtifactReferencesAPI) RemoveFromReleaseReturnsOnCall(i int, result1 error) {
+	fake.removeFromReleaseMutex.Lock()
+	defer fake.removeFromReleaseMutex.Unlock()
+	fake.RemoveFromReleaseStub = nil
+	if fake.removeFromReleaseReturnsOnCall == nil {
+		fake.removeFromReleaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeFromReleaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeArtifactReferencesAPI) RemoveFromReleaseContext(arg1 context.Context, arg2 string, arg3 int, arg4 int) error {
+	fake.removeFromReleaseContextMutex.Lock()
+	ret, specificReturn := fake.removeFromReleaseContextReturnsOnCall[len(fake.removeFromReleaseContextArgsForCall)]
+	fake.removeFromReleaseContextArgsForCall = append(fake.removeFromReleaseContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RemoveFromReleaseContextStub
+	fakeReturns := fake.removeFromReleaseContextReturns
+	fake.recordInvocation("RemoveFromReleaseContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.removeFromReleaseContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeArtifactReferencesAPI) RemoveFromReleaseContextCallCount() int {
+	fake.removeFromReleaseContextMutex.RLock()
+	defer fake.removeFromReleaseContextMutex.RUnlock()
+	return len(fake.removeFromReleaseContextArgsForCall)
+}
+
+func (fake *FakeArtifactReferencesAPI) RemoveFromReleaseContextCalls(stub func(context.Context, string, int, int) error) {
+	fake.removeFromReleaseContextMutex.Lock()
+	defer fake.removeFromReleaseContextMutex.Unlock()
+	fake.RemoveFromReleaseContextStub = stub
+}
+
+func (fake *FakeArtifactReferencesAPI) RemoveFromReleaseContextArgsForCall(i int) (context.Context, string, int, int) {
+	fake.removeFromReleaseContextMutex.RLock()
+	defer fake.removeFromReleaseContextMutex.RUnlock()
+	argsForCall := fake.removeFromReleaseContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeArtifactReferencesAPI) RemoveFromReleaseContextReturns(result1 error) {
+	fake.removeFromReleaseContextMutex.Lock()
+	defer fake.removeFromReleaseContextMutex.Unlock()
+	fake.RemoveFromReleaseContextStub = nil
+	fake.removeFromReleaseContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeArtifactReferencesAPI) RemoveFromReleaseContextReturnsOnCall(i int, result1 error) {
+	fake.removeFromReleaseContextMutex.Lock()
+	defer fake.removeFromReleaseContextMutex.Unlock()
+	fake.RemoveFromReleaseContextStub = nil
+	if fake.removeFromReleaseContextReturnsOnCall == nil {
+		fake.removeFromReleaseContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeFromReleaseContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeArtifactReferencesAPI) Update(arg1 string, arg2 pivnet.ArtifactReference) (pivnet.ArtifactReference, error) {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 string
+		arg2 pivnet.ArtifactReference
+	}{arg1, arg2})
+	stub := fake.UpdateStub
+	fakeReturns := fake.updateReturns
+	fake.recordInvocation("Update", []interface{}{arg1, arg2})
+	fake.updateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeArtifactReferencesAPI) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeArtifactReferencesAPI) UpdateCalls(stub func(string, pivnet.ArtifactReference) (pivnet.ArtifactReference, error)) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = stub
+}
+
+func (fake *FakeArtifactReferencesAPI) UpdateArgsForCall(i int) (string, pivnet.ArtifactReference) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	argsForCall := fake.updateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeArtifactReferencesAPI) UpdateReturns(result1 pivnet.ArtifactReference, result2 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) UpdateReturnsOnCall(i int, result1 pivnet.ArtifactReference, result2 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ArtifactReference
+			result2 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) UpdateContext(arg1 context.Context, arg2 string, arg3 pivnet.ArtifactReference) (pivnet.ArtifactReference, error) {
+	fake.updateContextMutex.Lock()
+	ret, specificReturn := fake.updateContextReturnsOnCall[len(fake.updateContextArgsForCall)]
+	fake.updateContextArgsForCall = append(fake.updateContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 pivnet.ArtifactReference
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateContextStub
+	fakeReturns := fake.updateContextReturns
+	fake.recordInvocation("UpdateContext", []interface{}{arg1, arg2, arg3})
+	fake.updateContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeArtifactReferencesAPI) UpdateContextCallCount() int {
+	fake.updateContextMutex.RLock()
+	defer fake.updateContextMutex.RUnlock()
+	return len(fake.updateContextArgsForCall)
+}
+
+func (fake *FakeArtifactReferencesAPI) UpdateContextCalls(stub func(context.Context, string, pivnet.ArtifactReference) (pivnet.ArtifactReference, error)) {
+	fake.updateContextMutex.Lock()
+	defer fake.updateContextMutex.Unlock()
+	fake.UpdateContextStub = stub
+}
+
+func (fake *FakeArtifactReferencesAPI) UpdateContextArgsForCall(i int) (context.Context, string, pivnet.ArtifactReference) {
+	fake.updateContextMutex.RLock()
+	defer fake.updateContextMutex.RUnlock()
+	argsForCall := fake.updateContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeArtifactReferencesAPI) UpdateContextReturns(result1 pivnet.ArtifactReference, result2 error) {
+	fake.updateContextMutex.Lock()
+	defer fake.updateContextMutex.Unlock()
+	fake.UpdateContextStub = nil
+	fake.updateContextReturns = struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) UpdateContextReturnsOnCall(i int, result1 pivnet.ArtifactReference, result2 error) {
+	fake.updateContextMutex.Lock()
+	defer fake.updateContextMutex.Unlock()
+	fake.UpdateContextStub = nil
+	if fake.updateContextReturnsOnCall == nil {
+		fake.updateContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ArtifactReference
+			result2 error
+		})
+	}
+	fake.updateContextReturnsOnCall[i] = struct {
+		result1 pivnet.ArtifactReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactReferencesAPI) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.addToReleaseMutex.RLock()
+	defer fake.addToReleaseMutex.RUnlock()
+	fake.addToReleaseContextMutex.RLock()
+	defer fake.addToReleaseContextMutex.RUnlock()
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	fake.getForReleaseMutex.RLock()
+	defer fake.getForReleaseMutex.RUnlock()
+	fake.getForReleaseContextMutex.RLock()
+	defer fake.getForReleaseContextMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	fake.listForDigestMutex.RLock()
+	defer fake.listForDigestMutex.RUnlock()
+	fake.listForDigestContextMutex.RLock()
+	defer fake.listForDigestContextMutex.RUnlock()
+	fake.listForReleaseMutex.RLock()
+	defer fake.listForReleaseMutex.RUnlock()
+	fake.listForReleaseContextMutex.RLock()
+	defer fake.listForReleaseContextMutex.RUnlock()
+	fake.removeFromReleaseMutex.RLock()
+	defer fake.removeFromReleaseMutex.RUnlock()
+	fake.removeFromReleaseContextMutex.RLock()
+	defer fake.removeFromReleaseContextMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	fake.updateContextMutex.RLock()
+	defer fake.updateContextMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeArtifactReferencesAPI) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pivnet.ArtifactReferencesAPI = new(FakeArtifactReferencesAPI)
@@ -0,0 +1,347 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package gopivnetfakes
+
+import (
+	"context"
+	"sync"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v9"
+)
+
+type FakeAuthAPI struct {
+	CheckStub        func() (bool, error)
+	checkMutex       sync.RWMutex
+	checkArgsForCall []struct {
+	}
+	checkReturns struct {
+		result1 bool
+		result2 error
+	}
+	checkReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	CheckContextStub        func(context.Context) (bool, error)
+	checkContextMutex       sync.RWMutex
+	checkContextArgsForCall []struct {
+		arg1 context.Context
+	}
+	checkContextReturns struct {
+		result1 bool
+		result2 error
+	}
+	checkContextReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	FetchUAATokenStub        func(string) (pivnet.UAATokenResponse, error)
+	fetchUAATokenMutex       sync.RWMutex
+	fetchUAATokenArgsForCall []struct {
+		arg1 string
+	}
+	fetchUAATokenReturns struct {
+		result1 pivnet.UAATokenResponse
+		result2 error
+	}
+	fetchUAATokenReturnsOnCall map[int]struct {
+		result1 pivnet.UAATokenResponse
+		result2 error
+	}
+	FetchUAATokenContextStub        func(context.Context, string) (pivnet.UAATokenResponse, error)
+	fetchUAATokenContextMutex       sync.RWMutex
+	fetchUAATokenContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	fetchUAATokenContextReturns struct {
+		result1 pivnet.UAATokenResponse
+		result2 error
+	}
+	fetchUAATokenContextReturnsOnCall map[int]struct {
+		result1 pivnet.UAATokenResponse
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAuthAPI) Check() (bool, error) {
+	fake.checkMutex.Lock()
+	ret, specificReturn := fake.checkReturnsOnCall[len(fake.checkArgsForCall)]
+	fake.checkArgsForCall = append(fake.checkArgsForCall, struct {
+	}{})
+	stub := fake.CheckStub
+	fakeReturns := fake.checkReturns
+	fake.recordInvocation("Check", []interface{}{})
+	fake.checkMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuthAPI) CheckCallCount() int {
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	return len(fake.checkArgsForCall)
+}
+
+func (fake *FakeAuthAPI) CheckCalls(stub func() (bool, error)) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = stub
+}
+
+func (fake *FakeAuthAPI) CheckReturns(result1 bool, result2 error) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = nil
+	fake.checkReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthAPI) CheckReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.checkMutex.Lock()
+	defer fake.checkMutex.Unlock()
+	fake.CheckStub = nil
+	if fake.checkReturnsOnCall == nil {
+		fake.checkReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.checkReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthAPI) CheckContext(arg1 context.Context) (bool, error) {
+	fake.checkContextMutex.Lock()
+	ret, specificReturn := fake.checkContextReturnsOnCall[len(fake.checkContextArgsForCall)]
+	fake.checkContextArgsForCall = append(fake.checkContextArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.CheckContextStub
+	fakeReturns := fake.checkContextReturns
+	fake.recordInvocation("CheckContext", []interface{}{arg1})
+	fake.checkContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuthAPI) CheckContextCallCount() int {
+	fake.checkContextMutex.RLock()
+	defer fake.checkContextMutex.RUnlock()
+	return len(fake.checkContextArgsForCall)
+}
+
+func (fake *FakeAuthAPI) CheckContextCalls(stub func(context.Context) (bool, error)) {
+	fake.checkContextMutex.Lock()
+	defer fake.checkContextMutex.Unlock()
+	fake.CheckContextStub = stub
+}
+
+func (fake *FakeAuthAPI) CheckContextArgsForCall(i int) context.Context {
+	fake.checkContextMutex.RLock()
+	defer fake.checkContextMutex.RUnlock()
+	argsForCall := fake.checkContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAuthAPI) CheckContextReturns(result1 bool, result2 error) {
+	fake.checkContextMutex.Lock()
+	defer fake.checkContextMutex.Unlock()
+	fake.CheckContextStub = nil
+	fake.checkContextReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthAPI) CheckContextReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.checkContextMutex.Lock()
+	defer fake.checkContextMutex.Unlock()
+	fake.CheckContextStub = nil
+	if fake.checkContextReturnsOnCall == nil {
+		fake.checkContextReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.checkContextReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthAPI) FetchUAAToken(arg1 string) (pivnet.UAATokenResponse, error) {
+	fake.fetchUAATokenMutex.Lock()
+	ret, specificReturn := fake.fetchUAATokenReturnsOnCall[len(fake.fetchUAATokenArgsForCall)]
+	fake.fetchUAATokenArgsForCall = append(fake.fetchUAATokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.FetchUAATokenStub
+	fakeReturns := fake.fetchUAATokenReturns
+	fake.recordInvocation("FetchUAAToken", []interface{}{arg1})
+	fake.fetchUAATokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuthAPI) FetchUAATokenCallCount() int {
+	fake.fetchUAATokenMutex.RLock()
+	defer fake.fetchUAATokenMutex.RUnlock()
+	return len(fake.fetchUAATokenArgsForCall)
+}
+
+func (fake *FakeAuthAPI) FetchUAATokenCalls(stub func(string) (pivnet.UAATokenResponse, error)) {
+	fake.fetchUAATokenMutex.Lock()
+	defer fake.fetchUAATokenMutex.Unlock()
+	fake.FetchUAATokenStub = stub
+}
+
+func (fake *FakeAuthAPI) FetchUAATokenArgsForCall(i int) string {
+	fake.fetchUAATokenMutex.RLock()
+	defer fake.fetchUAATokenMutex.RUnlock()
+	argsForCall := fake.fetchUAATokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAuthAPI) FetchUAATokenReturns(result1 pivnet.UAATokenResponse, result2 error) {
+	fake.fetchUAATokenMutex.Lock()
+	defer fake.fetchUAATokenMutex.Unlock()
+	fake.FetchUAATokenStub = nil
+	fake.fetchUAATokenReturns = struct {
+		result1 pivnet.UAATokenResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthAPI) FetchUAATokenReturnsOnCall(i int, result1 pivnet.UAATokenResponse, result2 error) {
+	fake.fetchUAATokenMutex.Lock()
+	defer fake.fetchUAATokenMutex.Unlock()
+	fake.FetchUAATokenStub = nil
+	if fake.fetchUAATokenReturnsOnCall == nil {
+		fake.fetchUAATokenReturnsOnCall = make(map[int]struct {
+			result1 pivnet.UAATokenResponse
+			result2 error
+		})
+	}
+	fake.fetchUAATokenReturnsOnCall[i] = struct {
+		result1 pivnet.UAATokenResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthAPI) FetchUAATokenContext(arg1 context.Context, arg2 string) (pivnet.UAATokenResponse, error) {
+	fake.fetchUAATokenContextMutex.Lock()
+	ret, specificReturn := fake.fetchUAATokenContextReturnsOnCall[len(fake.fetchUAATokenContextArgsForCall)]
+	fake.fetchUAATokenContextArgsForCall = append(fake.fetchUAATokenContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.FetchUAATokenContextStub
+	fakeReturns := fake.fetchUAATokenContextReturns
+	fake.recordInvocation("FetchUAATokenContext", []interface{}{arg1, arg2})
+	fake.fetchUAATokenContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuthAPI) FetchUAATokenContextCallCount() int {
+	fake.fetchUAATokenContextMutex.RLock()
+	defer fake.fetchUAATokenContextMutex.RUnlock()
+	return len(fake.fetchUAATokenContextArgsForCall)
+}
+
+func (fake *FakeAuthAPI) FetchUAATokenContextCalls(stub func(context.Context, string) (pivnet.UAATokenResponse, error)) {
+	fake.fetchUAATokenContextMutex.Lock()
+	defer fake.fetchUAATokenContextMutex.Unlock()
+	fake.FetchUAATokenContextStub = stub
+}
+
+func (fake *FakeAuthAPI) FetchUAATokenContextArgsForCall(i int) (context.Context, string) {
+	fake.fetchUAATokenContextMutex.RLock()
+	defer fake.fetchUAATokenContextMutex.RUnlock()
+	argsForCall := fake.fetchUAATokenContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAuthAPI) FetchUAATokenContextReturns(result1 pivnet.UAATokenResponse, result2 error) {
+	fake.fetchUAATokenContextMutex.Lock()
+	defer fake.fetchUAATokenContextMutex.Unlock()
+	fake.FetchUAATokenContextStub = nil
+	fake.fetchUAATokenContextReturns = struct {
+		result1 pivnet.UAATokenResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthAPI) FetchUAATokenContextReturnsOnCall(i int, result1 pivnet.UAATokenResponse, result2 error) {
+	fake.fetchUAATokenContextMutex.Lock()
+	defer fake.fetchUAATokenContextMutex.Unlock()
+	fake.FetchUAATokenContextStub = nil
+	if fake.fetchUAATokenContextReturnsOnCall == nil {
+		fake.fetchUAATokenContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.UAATokenResponse
+			result2 error
+		})
+	}
+	fake.fetchUAATokenContextReturnsOnCall[i] = struct {
+		result1 pivnet.UAATokenResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthAPI) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	fake.checkContextMutex.RLock()
+	defer fake.checkContextMutex.RUnlock()
+	fake.fetchUAATokenMutex.RLock()
+	defer fake.fetchUAATokenMutex.RUnlock()
+	fake.fetchUAATokenContextMutex.RLock()
+	defer fake.fetchUAATokenContextMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAuthAPI) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pivnet.AuthAPI = new(FakeAuthAPI)
@@ -0,0 +1,1077 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package gopivnetfakes
+
+import (
+	"sync"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v9"
+)
+
+type FakeClientAPI struct {
+	ArtifactReferencesAPIStub        func() pivnet.ArtifactReferencesAPI
+	artifactReferencesAPIMutex       sync.RWMutex
+	artifactReferencesAPIArgsForCall []struct {
+	}
+	artifactReferencesAPIReturns struct {
+		result1 pivnet.ArtifactReferencesAPI
+	}
+	artifactReferencesAPIReturnsOnCall map[int]struct {
+		result1 pivnet.ArtifactReferencesAPI
+	}
+	AuthAPIStub        func() pivnet.AuthAPI
+	authAPIMutex       sync.RWMutex
+	authAPIArgsForCall []struct {
+	}
+	authAPIReturns struct {
+		result1 pivnet.AuthAPI
+	}
+	authAPIReturnsOnCall map[int]struct {
+		result1 pivnet.AuthAPI
+	}
+	DependencySpecifiersAPIStub        func() pivnet.DependencySpecifiersAPI
+	dependencySpecifiersAPIMutex       sync.RWMutex
+	dependencySpecifiersAPIArgsForCall []struct {
+	}
+	dependencySpecifiersAPIReturns struct {
+		result1 pivnet.DependencySpecifiersAPI
+	}
+	dependencySpecifiersAPIReturnsOnCall map[int]struct {
+		result1 pivnet.DependencySpecifiersAPI
+	}
+	EULAsAPIStub        func() pivnet.EULAsAPI
+	eULAsAPIMutex       sync.RWMutex
+	eULAsAPIArgsForCall []struct {
+	}
+	eULAsAPIReturns struct {
+		result1 pivnet.EULAsAPI
+	}
+	eULAsAPIReturnsOnCall map[int]struct {
+		result1 pivnet.EULAsAPI
+	}
+	FederationTokenAPIStub        func() pivnet.FederationTokenAPI
+	federationTokenAPIMutex       sync.RWMutex
+	federationTokenAPIArgsForCall []struct {
+	}
+	federationTokenAPIReturns struct {
+		result1 pivnet.FederationTokenAPI
+	}
+	federationTokenAPIReturnsOnCall map[int]struct {
+		result1 pivnet.FederationTokenAPI
+	}
+	FileGroupsAPIStub        func() pivnet.FileGroupsAPI
+	fileGroupsAPIMutex       sync.RWMutex
+	fileGroupsAPIArgsForCall []struct {
+	}
+	fileGroupsAPIReturns struct {
+		result1 pivnet.FileGroupsAPI
+	}
+	fileGroupsAPIReturnsOnCall map[int]struct {
+		result1 pivnet.FileGroupsAPI
+	}
+	PivnetVersionsAPIStub        func() pivnet.PivnetVersionsAPI
+	pivnetVersionsAPIMutex       sync.RWMutex
+	pivnetVersionsAPIArgsForCall []struct {
+	}
+	pivnetVersionsAPIReturns struct {
+		result1 pivnet.PivnetVersionsAPI
+	}
+	pivnetVersionsAPIReturnsOnCall map[int]struct {
+		result1 pivnet.PivnetVersionsAPI
+	}
+	ProductFilesAPIStub        func() pivnet.ProductFilesAPI
+	productFilesAPIMutex       sync.RWMutex
+	productFilesAPIArgsForCall []struct {
+	}
+	productFilesAPIReturns struct {
+		result1 pivnet.ProductFilesAPI
+	}
+	productFilesAPIReturnsOnCall map[int]struct {
+		result1 pivnet.ProductFilesAPI
+	}
+	ProductsAPIStub        func() pivnet.ProductsAPI
+	productsAPIMutex       sync.RWMutex
+	productsAPIArgsForCall []struct {
+	}
+	productsAPIReturns struct {
+		result1 pivnet.ProductsAPI
+	}
+	productsAPIReturnsOnCall map[int]struct {
+		result1 pivnet.ProductsAPI
+	}
+	ReleaseDependenciesAPIStub        func() pivnet.ReleaseDependenciesAPI
+	releaseDependenciesAPIMutex       sync.RWMutex
+	releaseDependenciesAPIArgsForCall []struct {
+	}
+	releaseDependenciesAPIReturns struct {
+		result1 pivnet.ReleaseDependenciesAPI
+	}
+	releaseDependenciesAPIReturnsOnCall map[int]struct {
+		result1 pivnet.ReleaseDependenciesAPI
+	}
+	ReleaseTypesAPIStub        func() pivnet.ReleaseTypesAPI
+	releaseTypesAPIMutex       sync.RWMutex
+	releaseTypesAPIArgsForCall []struct {
+	}
+	releaseTypesAPIReturns struct {
+		result1 pivnet.ReleaseTypesAPI
+	}
+	releaseTypesAPIReturnsOnCall map[int]struct {
+		result1 pivnet.ReleaseTypesAPI
+	}
+	ReleaseUpgradePathsAPIStub        func() pivnet.ReleaseUpgradePathsAPI
+	releaseUpgradePathsAPIMutex       sync.RWMutex
+	releaseUpgradePathsAPIArgsForCall []struct {
+	}
+	releaseUpgradePathsAPIReturns struct {
+		result1 pivnet.ReleaseUpgradePathsAPI
+	}
+	releaseUpgradePathsAPIReturnsOnCall map[int]struct {
+		result1 pivnet.ReleaseUpgradePathsAPI
+	}
+	ReleasesAPIStub        func() pivnet.ReleasesAPI
+	releasesAPIMutex       sync.RWMutex
+	releasesAPIArgsForCall []struct {
+	}
+	releasesAPIReturns struct {
+		result1 pivnet.ReleasesAPI
+	}
+	releasesAPIReturnsOnCall map[int]struct {
+		result1 pivnet.ReleasesAPI
+	}
+	SubscriptionGroupsAPIStub        func() pivnet.SubscriptionGroupsAPI
+	subscriptionGroupsAPIMutex       sync.RWMutex
+	subscriptionGroupsAPIArgsForCall []struct {
+	}
+	subscriptionGroupsAPIReturns struct {
+		result1 pivnet.SubscriptionGroupsAPI
+	}
+	subscriptionGroupsAPIReturnsOnCall map[int]struct {
+		result1 pivnet.SubscriptionGroupsAPI
+	}
+	UpgradePathSpecifiersAPIStub        func() pivnet.UpgradePathSpecifiersAPI
+	upgradePathSpecifiersAPIMutex       sync.RWMutex
+	upgradePathSpecifiersAPIArgsForCall []struct {
+	}
+	upgradePathSpecifiersAPIReturns struct {
+		result1 pivnet.UpgradePathSpecifiersAPI
+	}
+	upgradePathSpecifiersAPIReturnsOnCall map[int]struct {
+		result1 pivnet.UpgradePathSpecifiersAPI
+	}
+	UserGroupsAPIStub        func() pivnet.UserGroupsAPI
+	userGroupsAPIMutex       sync.RWMutex
+	userGroupsAPIArgsForCall []struct {
+	}
+	userGroupsAPIReturns struct {
+		result1 pivnet.UserGroupsAPI
+	}
+	userGroupsAPIReturnsOnCall map[int]struct {
+		result1 pivnet.UserGroupsAPI
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeClientAPI) ArtifactReferencesAPI() pivnet.ArtifactReferencesAPI {
+	fake.artifactReferencesAPIMutex.Lock()
+	ret, specificReturn := fake.artifactReferencesAPIReturnsOnCall[len(fake.artifactReferencesAPIArgsForCall)]
+	fake.artifactReferencesAPIArgsForCall = append(fake.artifactReferencesAPIArgsForCall, struct {
+	}{})
+	stub := fake.ArtifactReferencesAPIStub
+	fakeReturns := fake.artifactReferencesAPIReturns
+	fake.recordInvocation("ArtifactReferencesAPI", []interface{}{})
+	fake.artifactReferencesAPIMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientAPI) ArtifactReferencesAPICallCount() int {
+	fake.artifactReferencesAPIMutex.RLock()
+	defer fake.artifactReferencesAPIMutex.RUnlock()
+	return len(fake.artifactReferencesAPIArgsForCall)
+}
+
+func (fake *FakeClientAPI) ArtifactReferencesAPICalls(stub func() pivnet.ArtifactReferencesAPI) {
+	fake.artifactReferencesAPIMutex.Lock()
+	defer fake.artifactReferencesAPIMutex.Unlock()
+	fake.ArtifactReferencesAPIStub = stub
+}
+
+func (fake *FakeClientAPI) ArtifactReferencesAPIReturns(result1 pivnet.ArtifactReferencesAPI) {
+	fake.artifactReferencesAPIMutex.Lock()
+	defer fake.artifactReferencesAPIMutex.Unlock()
+	fake.ArtifactReferencesAPIStub = nil
+	fake.artifactReferencesAPIReturns = struct {
+		result1 pivnet.ArtifactReferencesAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) ArtifactReferencesAPIReturnsOnCall(i int, result1 pivnet.ArtifactReferencesAPI) {
+	fake.artifactReferencesAPIMutex.Lock()
+	defer fake.artifactReferencesAPIMutex.Unlock()
+	fake.ArtifactReferencesAPIStub = nil
+	if fake.artifactReferencesAPIReturnsOnCall == nil {
+		fake.artifactReferencesAPIReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ArtifactReferencesAPI
+		})
+	}
+	fake.artifactReferencesAPIReturnsOnCall[i] = struct {
+		result1 pivnet.ArtifactReferencesAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) AuthAPI() pivnet.AuthAPI {
+	fake.authAPIMutex.Lock()
+	ret, specificReturn := fake.authAPIReturnsOnCall[len(fake.authAPIArgsForCall)]
+	fake.authAPIArgsForCall = append(fake.authAPIArgsForCall, struct {
+	}{})
+	stub := fake.AuthAPIStub
+	fakeReturns := fake.authAPIReturns
+	fake.recordInvocation("AuthAPI", []interface{}{})
+	fake.authAPIMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientAPI) AuthAPICallCount() int {
+	fake.authAPIMutex.RLock()
+	defer fake.authAPIMutex.RUnlock()
+	return len(fake.authAPIArgsForCall)
+}
+
+func (fake *FakeClientAPI) AuthAPICalls(stub func() pivnet.AuthAPI) {
+	fake.authAPIMutex.Lock()
+	defer fake.authAPIMutex.Unlock()
+	fake.AuthAPIStub = stub
+}
+
+func (fake *FakeClientAPI) AuthAPIReturns(result1 pivnet.AuthAPI) {
+	fake.authAPIMutex.Lock()
+	defer fake.authAPIMutex.Unlock()
+	fake.AuthAPIStub = nil
+	fake.authAPIReturns = struct {
+		result1 pivnet.AuthAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) AuthAPIReturnsOnCall(i int, result1 pivnet.AuthAPI) {
+	fake.authAPIMutex.Lock()
+	defer fake.authAPIMutex.Unlock()
+	fake.AuthAPIStub = nil
+	if fake.authAPIReturnsOnCall == nil {
+		fake.authAPIReturnsOnCall = make(map[int]struct {
+			result1 pivnet.AuthAPI
+		})
+	}
+	fake.authAPIReturnsOnCall[i] = struct {
+		result1 pivnet.AuthAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) DependencySpecifiersAPI() pivnet.DependencySpecifiersAPI {
+	fake.dependencySpecifiersAPIMutex.Lock()
+	ret, specificReturn := fake.dependencySpecifiersAPIReturnsOnCall[len(fake.dependencySpecifiersAPIArgsForCall)]
+	fake.dependencySpecifiersAPIArgsForCall = append(fake.dependencySpecifiersAPIArgsForCall, struct {
+	}{})
+	stub := fake.DependencySpecifiersAPIStub
+	fakeReturns := fake.dependencySpecifiersAPIReturns
+	fake.recordInvocation("DependencySpecifiersAPI", []interface{}{})
+	fake.dependencySpecifiersAPIMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientAPI) DependencySpecifiersAPICallCount() int {
+	fake.dependencySpecifiersAPIMutex.RLock()
+	defer fake.dependencySpecifiersAPIMutex.RUnlock()
+	return len(fake.dependencySpecifiersAPIArgsForCall)
+}
+
+func (fake *FakeClientAPI) DependencySpecifiersAPICalls(stub func() pivnet.DependencySpecifiersAPI) {
+	fake.dependencySpecifiersAPIMutex.Lock()
+	defer fake.dependencySpecifiersAPIMutex.Unlock()
+	fake.DependencySpecifiersAPIStub = stub
+}
+
+func (fake *FakeClientAPI) DependencySpecifiersAPIReturns(result1 pivnet.DependencySpecifiersAPI) {
+	fake.dependencySpecifiersAPIMutex.Lock()
+	defer fake.dependencySpecifiersAPIMutex.Unlock()
+	fake.DependencySpecifiersAPIStub = nil
+	fake.dependencySpecifiersAPIReturns = struct {
+		result1 pivnet.DependencySpecifiersAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) DependencySpecifiersAPIReturnsOnCall(i int, result1 pivnet.DependencySpecifiersAPI) {
+	fake.dependencySpecifiersAPIMutex.Lock()
+	defer fake.dependencySpecifiersAPIMutex.Unlock()
+	fake.DependencySpecifiersAPIStub = nil
+	if fake.dependencySpecifiersAPIReturnsOnCall == nil {
+		fake.dependencySpecifiersAPIReturnsOnCall = make(map[int]struct {
+			result1 pivnet.DependencySpecifiersAPI
+		})
+	}
+	fake.dependencySpecifiersAPIReturnsOnCall[i] = struct {
+		result1 pivnet.DependencySpecifiersAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) EULAsAPI() pivnet.EULAsAPI {
+	fake.eULAsAPIMutex.Lock()
+	ret, specificReturn := fake.eULAsAPIReturnsOnCall[len(fake.eULAsAPIArgsForCall)]
+	fake.eULAsAPIArgsForCall = append(fake.eULAsAPIArgsForCall, struct {
+	}{})
+	stub := fake.EULAsAPIStub
+	fakeReturns := fake.eULAsAPIReturns
+	fake.recordInvocation("EULAsAPI", []interface{}{})
+	fake.eULAsAPIMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientAPI) EULAsAPICallCount() int {
+	fake.eULAsAPIMutex.RLock()
+	defer fake.eULAsAPIMutex.RUnlock()
+	return len(fake.eULAsAPIArgsForCall)
+}
+
+func (fake *FakeClientAPI) EULAsAPICalls(stub func() pivnet.EULAsAPI) {
+	fake.eULAsAPIMutex.Lock()
+	defer fake.eULAsAPIMutex.Unlock()
+	fake.EULAsAPIStub = stub
+}
+
+func (fake *FakeClientAPI) EULAsAPIReturns(result1 pivnet.EULAsAPI) {
+	fake.eULAsAPIMutex.Lock()
+	defer fake.eULAsAPIMutex.Unlock()
+	fake.EULAsAPIStub = nil
+	fake.eULAsAPIReturns = struct {
+		result1 pivnet.EULAsAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) EULAsAPIReturnsOnCall(i int, result1 pivnet.EULAsAPI) {
+	fake.eULAsAPIMutex.Lock()
+	defer fake.eULAsAPIMutex.Unlock()
+	fake.EULAsAPIStub = nil
+	if fake.eULAsAPIReturnsOnCall == nil {
+		fake.eULAsAPIReturnsOnCall = make(map[int]struct {
+			result1 pivnet.EULAsAPI
+		})
+	}
+	fake.eULAsAPIReturnsOnCall[i] = struct {
+		result1 pivnet.EULAsAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) FederationTokenAPI() pivnet.FederationTokenAPI {
+	fake.federationTokenAPIMutex.Lock()
+	ret, specificReturn := fake.federationTokenAPIReturnsOnCall[len(fake.federationTokenAPIArgsForCall)]
+	fake.federationTokenAPIArgsForCall = append(fake.federationTokenAPIArgsForCall, struct {
+	}{})
+	stub := fake.FederationTokenAPIStub
+	fakeReturns := fake.federationTokenAPIReturns
+	fake.recordInvocation("FederationTokenAPI", []interface{}{})
+	fake.federationTokenAPIMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientAPI) FederationTokenAPICallCount() int {
+	fake.federationTokenAPIMutex.RLock()
+	defer fake.federationTokenAPIMutex.RUnlock()
+	return len(fake.federationTokenAPIArgsForCall)
+}
+
+func (fake *FakeClientAPI) FederationTokenAPICalls(stub func() pivnet.FederationTokenAPI) {
+	fake.federationTokenAPIMutex.Lock()
+	defer fake.federationTokenAPIMutex.Unlock()
+	fake.FederationTokenAPIStub = stub
+}
+
+func (fake *FakeClientAPI) FederationTokenAPIReturns(result1 pivnet.FederationTokenAPI) {
+	fake.federationTokenAPIMutex.Lock()
+	defer fake.federationTokenAPIMutex.Unlock()
+	fake.FederationTokenAPIStub = nil
+	fake.federationTokenAPIReturns = struct {
+		result1 pivnet.FederationTokenAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) FederationTokenAPIReturnsOnCall(i int, result1 pivnet.FederationTokenAPI) {
+	fake.federationTokenAPIMutex.Lock()
+	defer fake.federationTokenAPIMutex.Unlock()
+	fake.FederationTokenAPIStub = nil
+	if fake.federationTokenAPIReturnsOnCall == nil {
+		fake.federationTokenAPIReturnsOnCall = make(map[int]struct {
+			result1 pivnet.FederationTokenAPI
+		})
+	}
+	fake.federationTokenAPIReturnsOnCall[i] = struct {
+		result1 pivnet.FederationTokenAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) FileGroupsAPI() pivnet.FileGroupsAPI {
+	fake.fileGroupsAPIMutex.Lock()
+	ret, specificReturn := fake.fileGroupsAPIReturnsOnCall[len(fake.fileGroupsAPIArgsForCall)]
+	fake.fileGroupsAPIArgsForCall = append(fake.fileGroupsAPIArgsForCall, struct {
+	}{})
+	stub := fake.FileGroupsAPIStub
+	fakeReturns := fake.fileGroupsAPIReturns
+	fake.recordInvocation("FileGroupsAPI", []interface{}{})
+	fake.fileGroupsAPIMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientAPI) FileGroupsAPICallCount() int {
+	fake.fileGroupsAPIMutex.RLock()
+	defer fake.fileGroupsAPIMutex.RUnlock()
+	return len(fake.fileGroupsAPIArgsForCall)
+}
+
+func (fake *FakeClientAPI) FileGroupsAPICalls(stub func() pivnet.FileGroupsAPI) {
+	fake.fileGroupsAPIMutex.Lock()
+	defer fake.fileGroupsAPIMutex.Unlock()
+	fake.FileGroupsAPIStub = stub
+}
+
+func (fake *FakeClientAPI) FileGroupsAPIReturns(result1 pivnet.FileGroupsAPI) {
+	fake.fileGroupsAPIMutex.Lock()
+	defer fake.fileGroupsAPIMutex.Unlock()
+	fake.FileGroupsAPIStub = nil
+	fake.fileGroupsAPIReturns = struct {
+		result1 pivnet.FileGroupsAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) FileGroupsAPIReturnsOnCall(i int, result1 pivnet.FileGroupsAPI) {
+	fake.fileGroupsAPIMutex.Lock()
+	defer fake.fileGroupsAPIMutex.Unlock()
+	fake.FileGroupsAPIStub = nil
+	if fake.fileGroupsAPIReturnsOnCall == nil {
+		fake.fileGroupsAPIReturnsOnCall = make(map[int]struct {
+			result1 pivnet.FileGroupsAPI
+		})
+	}
+	fake.fileGroupsAPIReturnsOnCall[i] = struct {
+		result1 pivnet.FileGroupsAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) PivnetVersionsAPI() pivnet.PivnetVersionsAPI {
+	fake.pivnetVersionsAPIMutex.Lock()
+	ret, specificReturn := fake.pivnetVersionsAPIReturnsOnCall[len(fake.pivnetVersionsAPIArgsForCall)]
+	fake.pivnetVersionsAPIArgsForCall = append(fake.pivnetVersionsAPIArgsForCall, struct {
+	}{})
+	stub := fake.PivnetVersionsAPIStub
+	fakeReturns := fake.pivnetVersionsAPIReturns
+	fake.recordInvocation("PivnetVersionsAPI", []interface{}{})
+	fake.pivnetVersionsAPIMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientAPI) PivnetVersionsAPICallCount() int {
+	fake.pivnetVersionsAPIMutex.RLock()
+	defer fake.pivnetVersionsAPIMutex.RUnlock()
+	return len(fake.pivnetVersionsAPIArgsForCall)
+}
+
+func (fake *FakeClientAPI) PivnetVersionsAPICalls(stub func() pivnet.PivnetVersionsAPI) {
+	fake.pivnetVersionsAPIMutex.Lock()
+	defer fake.pivnetVersionsAPIMutex.Unlock()
+	fake.PivnetVersionsAPIStub = stub
+}
+
+func (fake *FakeClientAPI) PivnetVersionsAPIReturns(result1 pivnet.PivnetVersionsAPI) {
+	fake.pivnetVersionsAPIMutex.Lock()
+	defer fake.pivnetVersionsAPIMutex.Unlock()
+	fake.PivnetVersionsAPIStub = nil
+	fake.pivnetVersionsAPIReturns = struct {
+		result1 pivnet.PivnetVersionsAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) PivnetVersionsAPIReturnsOnCall(i int, result1 pivnet.PivnetVersionsAPI) {
+	fake.pivnetVersionsAPIMutex.Lock()
+	defer fake.pivnetVersionsAPIMutex.Unlock()
+	fake.PivnetVersionsAPIStub = nil
+	if fake.pivnetVersionsAPIReturnsOnCall == nil {
+		fake.pivnetVersionsAPIReturnsOnCall = make(map[int]struct {
+			result1 pivnet.PivnetVersionsAPI
+		})
+	}
+	fake.pivnetVersionsAPIReturnsOnCall[i] = struct {
+		result1 pivnet.PivnetVersionsAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) ProductFilesAPI() pivnet.ProductFilesAPI {
+	fake.productFilesAPIMutex.Lock()
+	ret, specificReturn := fake.productFilesAPIReturnsOnCall[len(fake.productFilesAPIArgsForCall)]
+	fake.productFilesAPIArgsForCall = append(fake.productFilesAPIArgsForCall, struct {
+	}{})
+	stub := fake.ProductFilesAPIStub
+	fakeReturns := fake.productFilesAPIReturns
+	fake.recordInvocation("ProductFilesAPI", []interface{}{})
+	fake.productFilesAPIMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientAPI) ProductFilesAPICallCount() int {
+	fake.productFilesAPIMutex.RLock()
+	defer fake.productFilesAPIMutex.RUnlock()
+	return len(fake.productFilesAPIArgsForCall)
+}
+
+func (fake *FakeClientAPI) ProductFilesAPICalls(stub func() pivnet.ProductFilesAPI) {
+	fake.productFilesAPIMutex.Lock()
+	defer fake.productFilesAPIMutex.Unlock()
+	fake.ProductFilesAPIStub = stub
+}
+
+func (fake *FakeClientAPI) ProductFilesAPIReturns(result1 pivnet.ProductFilesAPI) {
+	fake.productFilesAPIMutex.Lock()
+	defer fake.productFilesAPIMutex.Unlock()
+	fake.ProductFilesAPIStub = nil
+	fake.productFilesAPIReturns = struct {
+		result1 pivnet.ProductFilesAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) ProductFilesAPIReturnsOnCall(i int, result1 pivnet.ProductFilesAPI) {
+	fake.productFilesAPIMutex.Lock()
+	defer fake.productFilesAPIMutex.Unlock()
+	fake.ProductFilesAPIStub = nil
+	if fake.productFilesAPIReturnsOnCall == nil {
+		fake.productFilesAPIReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ProductFilesAPI
+		})
+	}
+	fake.productFilesAPIReturnsOnCall[i] = struct {
+		result1 pivnet.ProductFilesAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) ProductsAPI() pivnet.ProductsAPI {
+	fake.productsAPIMutex.Lock()
+	ret, specificReturn := fake.productsAPIReturnsOnCall[len(fake.productsAPIArgsForCall)]
+	fake.productsAPIArgsForCall = append(fake.productsAPIArgsForCall, struct {
+	}{})
+	stub := fake.ProductsAPIStub
+	fakeReturns := fake.productsAPIReturns
+	fake.recordInvocation("ProductsAPI", []interface{}{})
+	fake.productsAPIMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientAPI) ProductsAPICallCount() int {
+	fake.productsAPIMutex.RLock()
+	defer fake.productsAPIMutex.RUnlock()
+	return len(fake.productsAPIArgsForCall)
+}
+
+func (fake *FakeClientAPI) ProductsAPICalls(stub func() pivnet.ProductsAPI) {
+	fake.productsAPIMutex.Lock()
+	defer fake.productsAPIMutex.Unlock()
+	fake.ProductsAPIStub = stub
+}
+
+func (fake *FakeClientAPI) ProductsAPIReturns(result1 pivnet.ProductsAPI) {
+	fake.productsAPIMutex.Lock()
+	defer fake.productsAPIMutex.Unlock()
+	fake.ProductsAPIStub = nil
+	fake.productsAPIReturns = struct {
+		result1 pivnet.ProductsAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) ProductsAPIReturnsOnCall(i int, result1 pivnet.ProductsAPI) {
+	fake.productsAPIMutex.Lock()
+	defer fake.productsAPIMutex.Unlock()
+	fake.ProductsAPIStub = nil
+	if fake.productsAPIReturnsOnCall == nil {
+		fake.productsAPIReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ProductsAPI
+		})
+	}
+	fake.productsAPIReturnsOnCall[i] = struct {
+		result1 pivnet.ProductsAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) ReleaseDependenciesAPI() pivnet.ReleaseDependenciesAPI {
+	fake.releaseDependenciesAPIMutex.Lock()
+	ret, specificReturn := fake.releaseDependenciesAPIReturnsOnCall[len(fake.releaseDependenciesAPIArgsForCall)]
+	fake.releaseDependenciesAPIArgsForCall = append(fake.releaseDependenciesAPIArgsForCall, struct {
+	}{})
+	stub := fake.ReleaseDependenciesAPIStub
+	fakeReturns := fake.releaseDependenciesAPIReturns
+	fake.recordInvocation("ReleaseDependenciesAPI", []interface{}{})
+	fake.releaseDependenciesAPIMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientAPI) ReleaseDependenciesAPICallCount() int {
+	fake.releaseDependenciesAPIMutex.RLock()
+	defer fake.releaseDependenciesAPIMutex.RUnlock()
+	return len(fake.releaseDependenciesAPIArgsForCall)
+}
+
+func (fake *FakeClientAPI) ReleaseDependenciesAPICalls(stub func() pivnet.ReleaseDependenciesAPI) {
+	fake.releaseDependenciesAPIMutex.Lock()
+	defer fake.releaseDependenciesAPIMutex.Unlock()
+	fake.ReleaseDependenciesAPIStub = stub
+}
+
+func (fake *FakeClientAPI) ReleaseDependenciesAPIReturns(result1 pivnet.ReleaseDependenciesAPI) {
+	fake.releaseDependenciesAPIMutex.Lock()
+	defer fake.releaseDependenciesAPIMutex.Unlock()
+	fake.ReleaseDependenciesAPIStub = nil
+	fake.releaseDependenciesAPIReturns = struct {
+		result1 pivnet.ReleaseDependenciesAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) ReleaseDependenciesAPIReturnsOnCall(i int, result1 pivnet.ReleaseDependenciesAPI) {
+	fake.releaseDependenciesAPIMutex.Lock()
+	defer fake.releaseDependenciesAPIMutex.Unlock()
+	fake.ReleaseDependenciesAPIStub = nil
+	if fake.releaseDependenciesAPIReturnsOnCall == nil {
+		fake.releaseDependenciesAPIReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ReleaseDependenciesAPI
+		})
+	}
+	fake.releaseDependenciesAPIReturnsOnCall[i] = struct {
+		result1 pivnet.ReleaseDependenciesAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) ReleaseTypesAPI() pivnet.ReleaseTypesAPI {
+	fake.releaseTypesAPIMutex.Lock()
+	ret, specificReturn := fake.releaseTypesAPIReturnsOnCall[len(fake.releaseTypesAPIArgsForCall)]
+	fake.releaseTypesAPIArgsForCall = append(fake.releaseTypesAPIArgsForCall, struct {
+	}{})
+	stub := fake.ReleaseTypesAPIStub
+	fakeReturns := fake.releaseTypesAPIReturns
+	fake.recordInvocation("ReleaseTypesAPI", []interface{}{})
+	fake.releaseTypesAPIMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientAPI) ReleaseTypesAPICallCount() int {
+	fake.releaseTypesAPIMutex.RLock()
+	defer fake.releaseTypesAPIMutex.RUnlock()
+	return len(fake.releaseTypesAPIArgsForCall)
+}
+
+func (fake *FakeClientAPI) ReleaseTypesAPICalls(stub func() pivnet.ReleaseTypesAPI) {
+	fake.releaseTypesAPIMutex.Lock()
+	defer fake.releaseTypesAPIMutex.Unlock()
+	fake.ReleaseTypesAPIStub = stub
+}
+
+func (fake *FakeClientAPI) ReleaseTypesAPIReturns(result1 pivnet.ReleaseTypesAPI) {
+	fake.releaseTypesAPIMutex.Lock()
+	defer fake.releaseTypesAPIMutex.Unlock()
+	fake.ReleaseTypesAPIStub = nil
+	fake.releaseTypesAPIReturns = struct {
+		result1 pivnet.ReleaseTypesAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) ReleaseTypesAPIReturnsOnCall(i int, result1 pivnet.ReleaseTypesAPI) {
+	fake.releaseTypesAPIMutex.Lock()
+	defer fake.releaseTypesAPIMutex.Unlock()
+	fake.ReleaseTypesAPIStub = nil
+	if fake.releaseTypesAPIReturnsOnCall == nil {
+		fake.releaseTypesAPIReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ReleaseTypesAPI
+		})
+	}
+	fake.releaseTypesAPIReturnsOnCall[i] = struct {
+		result1 pivnet.ReleaseTypesAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) ReleaseUpgradePathsAPI() pivnet.ReleaseUpgradePathsAPI {
+	fake.releaseUpgradePathsAPIMutex.Lock()
+	ret, specificReturn := fake.releaseUpgradePathsAPIReturnsOnCall[len(fake.releaseUpgradePathsAPIArgsForCall)]
+	fake.releaseUpgradePathsAPIArgsForCall = append(fake.releaseUpgradePathsAPIArgsForCall, struct {
+	}{})
+	stub := fake.ReleaseUpgradePathsAPIStub
+	fakeReturns := fake.releaseUpgradePathsAPIReturns
+	fake.recordInvocation("ReleaseUpgradePathsAPI", []interface{}{})
+	fake.releaseUpgradePathsAPIMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientAPI) ReleaseUpgradePathsAPICallCount() int {
+	fake.releaseUpgradePathsAPIMutex.RLock()
+	defer fake.releaseUpgradePathsAPIMutex.RUnlock()
+	return len(fake.releaseUpgradePathsAPIArgsForCall)
+}
+
+func (fake *FakeClientAPI) ReleaseUpgradePathsAPICalls(stub func() pivnet.ReleaseUpgradePathsAPI) {
+	fake.releaseUpgradePathsAPIMutex.Lock()
+	defer fake.releaseUpgradePathsAPIMutex.Unlock()
+	fake.ReleaseUpgradePathsAPIStub = stub
+}
+
+func (fake *FakeClientAPI) ReleaseUpgradePathsAPIReturns(result1 pivnet.ReleaseUpgradePathsAPI) {
+	fake.releaseUpgradePathsAPIMutex.Lock()
+	defer fake.releaseUpgradePathsAPIMutex.Unlock()
+	fake.ReleaseUpgradePathsAPIStub = nil
+	fake.releaseUpgradePathsAPIReturns = struct {
+		result1 pivnet.ReleaseUpgradePathsAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) ReleaseUpgradePathsAPIReturnsOnCall(i int, result1 pivnet.ReleaseUpgradePathsAPI) {
+	fake.releaseUpgradePathsAPIMutex.Lock()
+	defer fake.releaseUpgradePathsAPIMutex.Unlock()
+	fake.ReleaseUpgradePathsAPIStub = nil
+	if fake.releaseUpgradePathsAPIReturnsOnCall == nil {
+		fake.releaseUpgradePathsAPIReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ReleaseUpgradePathsAPI
+		})
+	}
+	fake.releaseUpgradePathsAPIReturnsOnCall[i] = struct {
+		result1 pivnet.ReleaseUpgradePathsAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) ReleasesAPI() pivnet.ReleasesAPI {
+	fake.releasesAPIMutex.Lock()
+	ret, specificReturn := fake.releasesAPIReturnsOnCall[len(fake.releasesAPIArgsForCall)]
+	fake.releasesAPIArgsForCall = append(fake.releasesAPIArgsForCall, struct {
+	}{})
+	stub := fake.ReleasesAPIStub
+	fakeReturns := fake.releasesAPIReturns
+	fake.recordInvocation("ReleasesAPI", []interface{}{})
+	fake.releasesAPIMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientAPI) ReleasesAPICallCount() int {
+	fake.releasesAPIMutex.RLock()
+	defer fake.releasesAPIMutex.RUnlock()
+	return len(fake.releasesAPIArgsForCall)
+}
+
+func (fake *FakeClientAPI) ReleasesAPICalls(stub func() pivnet.ReleasesAPI) {
+	fake.releasesAPIMutex.Lock()
+	defer fake.releasesAPIMutex.Unlock()
+	fake.ReleasesAPIStub = stub
+}
+
+func (fake *FakeClientAPI) ReleasesAPIReturns(result1 pivnet.ReleasesAPI) {
+	fake.releasesAPIMutex.Lock()
+	defer fake.releasesAPIMutex.Unlock()
+	fake.ReleasesAPIStub = nil
+	fake.releasesAPIReturns = struct {
+		result1 pivnet.ReleasesAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) ReleasesAPIReturnsOnCall(i int, result1 pivnet.ReleasesAPI) {
+	fake.releasesAPIMutex.Lock()
+	defer fake.releasesAPIMutex.Unlock()
+	fake.ReleasesAPIStub = nil
+	if fake.releasesAPIReturnsOnCall == nil {
+		fake.releasesAPIReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ReleasesAPI
+		})
+	}
+	fake.releasesAPIReturnsOnCall[i] = struct {
+		result1 pivnet.ReleasesAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) SubscriptionGroupsAPI() pivnet.SubscriptionGroupsAPI {
+	fake.subscriptionGroupsAPIMutex.Lock()
+	ret, specificReturn := fake.subscriptionGroupsAPIReturnsOnCall[len(fake.subscriptionGroupsAPIArgsForCall)]
+	fake.subscriptionGroupsAPIArgsForCall = append(fake.subscriptionGroupsAPIArgsForCall, struct {
+	}{})
+	stub := fake.SubscriptionGroupsAPIStub
+	fakeReturns := fake.subscriptionGroupsAPIReturns
+	fake.recordInvocation("SubscriptionGroupsAPI", []interface{}{})
+	fake.subscriptionGroupsAPIMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientAPI) SubscriptionGroupsAPICallCount() int {
+	fake.subscriptionGroupsAPIMutex.RLock()
+	defer fake.subscriptionGroupsAPIMutex.RUnlock()
+	return len(fake.subscriptionGroupsAPIArgsForCall)
+}
+
+func (fake *FakeClientAPI) SubscriptionGroupsAPICalls(stub func() pivnet.SubscriptionGroupsAPI) {
+	fake.subscriptionGroupsAPIMutex.Lock()
+	defer fake.subscriptionGroupsAPIMutex.Unlock()
+	fake.SubscriptionGroupsAPIStub = stub
+}
+
+func (fake *FakeClientAPI) SubscriptionGroupsAPIReturns(result1 pivnet.SubscriptionGroupsAPI) {
+	fake.subscriptionGroupsAPIMutex.Lock()
+	defer fake.subscriptionGroupsAPIMutex.Unlock()
+	fake.SubscriptionGroupsAPIStub = nil
+	fake.subscriptionGroupsAPIReturns = struct {
+		result1 pivnet.SubscriptionGroupsAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) SubscriptionGroupsAPIReturnsOnCall(i int, result1 pivnet.SubscriptionGroupsAPI) {
+	fake.subscriptionGroupsAPIMutex.Lock()
+	defer fake.subscriptionGroupsAPIMutex.Unlock()
+	fake.SubscriptionGroupsAPIStub = nil
+	if fake.subscriptionGroupsAPIReturnsOnCall == nil {
+		fake.subscriptionGroupsAPIReturnsOnCall = make(map[int]struct {
+			result1 pivnet.SubscriptionGroupsAPI
+		})
+	}
+	fake.subscriptionGroupsAPIReturnsOnCall[i] = struct {
+		result1 pivnet.SubscriptionGroupsAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) UpgradePathSpecifiersAPI() pivnet.UpgradePathSpecifiersAPI {
+	fake.upgradePathSpecifiersAPIMutex.Lock()
+	ret, specificReturn := fake.upgradePathSpecifiersAPIReturnsOnCall[len(fake.upgradePathSpecifiersAPIArgsForCall)]
+	fake.upgradePathSpecifiersAPIArgsForCall = append(fake.upgradePathSpecifiersAPIArgsForCall, struct {
+	}{})
+	stub := fake.UpgradePathSpecifiersAPIStub
+	fakeReturns := fake.upgradePathSpecifiersAPIReturns
+	fake.recordInvocation("UpgradePathSpecifiersAPI", []interface{}{})
+	fake.upgradePathSpecifiersAPIMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientAPI) UpgradePathSpecifiersAPICallCount() int {
+	fake.upgradePathSpecifiersAPIMutex.RLock()
+	defer fake.upgradePathSpecifiersAPIMutex.RUnlock()
+	return len(fake.upgradePathSpecifiersAPIArgsForCall)
+}
+
+func (fake *FakeClientAPI) UpgradePathSpecifiersAPICalls(stub func() pivnet.UpgradePathSpecifiersAPI) {
+	fake.upgradePathSpecifiersAPIMutex.Lock()
+	defer fake.upgradePathSpecifiersAPIMutex.Unlock()
+	fake.UpgradePathSpecifiersAPIStub = stub
+}
+
+func (fake *FakeClientAPI) UpgradePathSpecifiersAPIReturns(result1 pivnet.UpgradePathSpecifiersAPI) {
+	fake.upgradePathSpecifiersAPIMutex.Lock()
+	defer fake.upgradePathSpecifiersAPIMutex.Unlock()
+	fake.UpgradePathSpecifiersAPIStub = nil
+	fake.upgradePathSpecifiersAPIReturns = struct {
+		result1 pivnet.UpgradePathSpecifiersAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) UpgradePathSpecifiersAPIReturnsOnCall(i int, result1 pivnet.UpgradePathSpecifiersAPI) {
+	fake.upgradePathSpecifiersAPIMutex.Lock()
+	defer fake.upgradePathSpecifiersAPIMutex.Unlock()
+	fake.UpgradePathSpecifiersAPIStub = nil
+	if fake.upgradePathSpecifiersAPIReturnsOnCall == nil {
+		fake.upgradePathSpecifiersAPIReturnsOnCall = make(map[int]struct {
+			result1 pivnet.UpgradePathSpecifiersAPI
+		})
+	}
+	fake.upgradePathSpecifiersAPIReturnsOnCall[i] = struct {
+		result1 pivnet.UpgradePathSpecifiersAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) UserGroupsAPI() pivnet.UserGroupsAPI {
+	fake.userGroupsAPIMutex.Lock()
+	ret, specificReturn := fake.userGroupsAPIReturnsOnCall[len(fake.userGroupsAPIArgsForCall)]
+	fake.userGroupsAPIArgsForCall = append(fake.userGroupsAPIArgsForCall, struct {
+	}{})
+	stub := fake.UserGroupsAPIStub
+	fakeReturns := fake.userGroupsAPIReturns
+	fake.recordInvocation("UserGroupsAPI", []interface{}{})
+	fake.userGroupsAPIMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClientAPI) UserGroupsAPICallCount() int {
+	fake.userGroupsAPIMutex.RLock()
+	defer fake.userGroupsAPIMutex.RUnlock()
+	return len(fake.userGroupsAPIArgsForCall)
+}
+
+func (fake *FakeClientAPI) UserGroupsAPICalls(stub func() pivnet.UserGroupsAPI) {
+	fake.userGroupsAPIMutex.Lock()
+	defer fake.userGroupsAPIMutex.Unlock()
+	fake.UserGroupsAPIStub = stub
+}
+
+func (fake *FakeClientAPI) UserGroupsAPIReturns(result1 pivnet.UserGroupsAPI) {
+	fake.userGroupsAPIMutex.Lock()
+	defer fake.userGroupsAPIMutex.Unlock()
+	fake.UserGroupsAPIStub = nil
+	fake.userGroupsAPIReturns = struct {
+		result1 pivnet.UserGroupsAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) UserGroupsAPIReturnsOnCall(i int, result1 pivnet.UserGroupsAPI) {
+	fake.userGroupsAPIMutex.Lock()
+	defer fake.userGroupsAPIMutex.Unlock()
+	fake.UserGroupsAPIStub = nil
+	if fake.userGroupsAPIReturnsOnCall == nil {
+		fake.userGroupsAPIReturnsOnCall = make(map[int]struct {
+			result1 pivnet.UserGroupsAPI
+		})
+	}
+	fake.userGroupsAPIReturnsOnCall[i] = struct {
+		result1 pivnet.UserGroupsAPI
+	}{result1}
+}
+
+func (fake *FakeClientAPI) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.artifactReferencesAPIMutex.RLock()
+	defer fake.artifactReferencesAPIMutex.RUnlock()
+	fake.authAPIMutex.RLock()
+	defer fake.authAPIMutex.RUnlock()
+	fake.dependencySpecifiersAPIMutex.RLock()
+	defer fake.dependencySpecifiersAPIMutex.RUnlock()
+	fake.eULAsAPIMutex.RLock()
+	defer fake.eULAsAPIMutex.RUnlock()
+	fake.federationTokenAPIMutex.RLock()
+	defer fake.federationTokenAPIMutex.RUnlock()
+	fake.fileGroupsAPIMutex.RLock()
+	defer fake.fileGroupsAPIMutex.RUnlock()
+	fake.pivnetVersionsAPIMutex.RLock()
+	defer fake.pivnetVersionsAPIMutex.RUnlock()
+	fake.productFilesAPIMutex.RLock()
+	defer fake.productFilesAPIMutex.RUnlock()
+	fake.productsAPIMutex.RLock()
+	defer fake.productsAPIMutex.RUnlock()
+	fake.releaseDependenciesAPIMutex.RLock()
+	defer fake.releaseDependenciesAPIMutex.RUnlock()
+	fake.releaseTypesAPIMutex.RLock()
+	defer fake.releaseTypesAPIMutex.RUnlock()
+	fake.releaseUpgradePathsAPIMutex.RLock()
+	defer fake.releaseUpgradePathsAPIMutex.RUnlock()
+	fake.releasesAPIMutex.RLock()
+	defer fake.releasesAPIMutex.RUnlock()
+	fake.subscriptionGroupsAPIMutex.RLock()
+	defer fake.subscriptionGroupsAPIMutex.RUnlock()
+	fake.upgradePathSpecifiersAPIMutex.RLock()
+	defer fake.upgradePathSpecifiersAPIMutex.RUnlock()
+	fake.userGroupsAPIMutex.RLock()
+	defer fake.userGroupsAPIMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeClientAPI) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pivnet.ClientAPI = new(FakeClientAPI)
@@ -0,0 +1,501 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package gopivnetfakes
+
+import (
+	"context"
+	"sync"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v9"
+)
+
+type FakeEULAsAPI struct {
+	AcceptStub        func(string, int) error
+	acceptMutex       sync.RWMutex
+	acceptArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	acceptReturns struct {
+		result1 error
+	}
+	acceptReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AcceptContextStub        func(context.Context, string, int) error
+	acceptContextMutex       sync.RWMutex
+	acceptContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	acceptContextReturns struct {
+		result1 error
+	}
+	acceptContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetStub        func(string) (pivnet.EULA, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+	}
+	getReturns struct {
+		result1 pivnet.EULA
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 pivnet.EULA
+		result2 error
+	}
+	GetContextStub        func(context.Context, string) (pivnet.EULA, error)
+	getContextMutex       sync.RWMutex
+	getContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getContextReturns struct {
+		result1 pivnet.EULA
+		result2 error
+	}
+	getContextReturnsOnCall map[int]struct {
+		result1 pivnet.EULA
+		result2 error
+	}
+	ListStub        func() ([]pivnet.EULA, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+	}
+	listReturns struct {
+		result1 []pivnet.EULA
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []pivnet.EULA
+		result2 error
+	}
+	ListContextStub        func(context.Context) ([]pivnet.EULA, error)
+	listContextMutex       sync.RWMutex
+	listContextArgsForCall []struct {
+		arg1 context.Context
+	}
+	listContextReturns struct {
+		result1 []pivnet.EULA
+		result2 error
+	}
+	listContextReturnsOnCall map[int]struct {
+		result1 []pivnet.EULA
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeEULAsAPI) Accept(arg1 string, arg2 int) error {
+	fake.acceptMutex.Lock()
+	ret, specificReturn := fake.acceptReturnsOnCall[len(fake.acceptArgsForCall)]
+	fake.acceptArgsForCall = append(fake.acceptArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.AcceptStub
+	fakeReturns := fake.acceptReturns
+	fake.recordInvocation("Accept", []interface{}{arg1, arg2})
+	fake.acceptMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEULAsAPI) AcceptCallCount() int {
+	fake.acceptMutex.RLock()
+	defer fake.acceptMutex.RUnlock()
+	return len(fake.acceptArgsForCall)
+}
+
+func (fake *FakeEULAsAPI) AcceptCalls(stub func(string, int) error) {
+	fake.acceptMutex.Lock()
+	defer fake.acceptMutex.Unlock()
+	fake.AcceptStub = stub
+}
+
+func (fake *FakeEULAsAPI) AcceptArgsForCall(i int) (string, int) {
+	fake.acceptMutex.RLock()
+	defer fake.acceptMutex.RUnlock()
+	argsForCall := fake.acceptArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeEULAsAPI) AcceptReturns(result1 error) {
+	fake.acceptMutex.Lock()
+	defer fake.acceptMutex.Unlock()
+	fake.AcceptStub = nil
+	fake.acceptReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEULAsAPI) AcceptReturnsOnCall(i int, result1 error) {
+	fake.acceptMutex.Lock()
+	defer fake.acceptMutex.Unlock()
+	fake.AcceptStub = nil
+	if fake.acceptReturnsOnCall == nil {
+		fake.acceptReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.acceptReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEULAsAPI) AcceptContext(arg1 context.Context, arg2 string, arg3 int) error {
+	fake.acceptContextMutex.Lock()
+	ret, specificReturn := fake.acceptContextReturnsOnCall[len(fake.acceptContextArgsForCall)]
+	fake.acceptContextArgsForCall = append(fake.acceptContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.AcceptContextStub
+	fakeReturns := fake.acceptContextReturns
+	fake.recordInvocation("AcceptContext", []interface{}{arg1, arg2, arg3})
+	fake.acceptContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEULAsAPI) AcceptContextCallCount() int {
+	fake.acceptContextMutex.RLock()
+	defer fake.acceptContextMutex.RUnlock()
+	return len(fake.acceptContextArgsForCall)
+}
+
+func (fake *FakeEULAsAPI) AcceptContextCalls(stub func(context.Context, string, int) error) {
+	fake.acceptContextMutex.Lock()
+	defer fake.acceptContextMutex.Unlock()
+	fake.AcceptContextStub = stub
+}
+
+func (fake *FakeEULAsAPI) AcceptContextArgsForCall(i int) (context.Context, string, int) {
+	fake.acceptContextMutex.RLock()
+	defer fake.acceptContextMutex.RUnlock()
+	argsForCall := fake.acceptContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeEULAsAPI) AcceptContextReturns(result1 error) {
+	fake.acceptContextMutex.Lock()
+	defer fake.acceptContextMutex.Unlock()
+	fake.AcceptContextStub = nil
+	fake.acceptContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEULAsAPI) AcceptContextReturnsOnCall(i int, result1 error) {
+	fake.acceptContextMutex.Lock()
+	defer fake.acceptContextMutex.Unlock()
+	fake.AcceptContextStub = nil
+	if fake.acceptContextReturnsOnCall == nil {
+		fake.acceptContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.acceptContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEULAsAPI) Get(arg1 string) (pivnet.EULA, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1})
+	fake.getMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEULAsAPI) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeEULAsAPI) GetCalls(stub func(string) (pivnet.EULA, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeEULAsAPI) GetArgsForCall(i int) string {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeEULAsAPI) GetReturns(result1 pivnet.EULA, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 pivnet.EULA
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEULAsAPI) GetReturnsOnCall(i int, result1 pivnet.EULA, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 pivnet.EULA
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 pivnet.EULA
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEULAsAPI) GetContext(arg1 context.Context, arg2 string) (pivnet.EULA, error) {
+	fake.getContextMutex.Lock()
+	ret, specificReturn := fake.getContextReturnsOnCall[len(fake.getContextArgsForCall)]
+	fake.getContextArgsForCall = append(fake.getContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetContextStub
+	fakeReturns := fake.getContextReturns
+	fake.recordInvocation("GetContext", []interface{}{arg1, arg2})
+	fake.getContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEULAsAPI) GetContextCallCount() int {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	return len(fake.getContextArgsForCall)
+}
+
+func (fake *FakeEULAsAPI) GetContextCalls(stub func(context.Context, string) (pivnet.EULA, error)) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = stub
+}
+
+func (fake *FakeEULAsAPI) GetContextArgsForCall(i int) (context.Context, string) {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	argsForCall := fake.getContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeEULAsAPI) GetContextReturns(result1 pivnet.EULA, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	fake.getContextReturns = struct {
+		result1 pivnet.EULA
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEULAsAPI) GetContextReturnsOnCall(i int, result1 pivnet.EULA, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	if fake.getContextReturnsOnCall == nil {
+		fake.getContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.EULA
+			result2 error
+		})
+	}
+	fake.getContextReturnsOnCall[i] = struct {
+		result1 pivnet.EULA
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEULAsAPI) List() ([]pivnet.EULA, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+	}{})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEULAsAPI) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeEULAsAPI) ListCalls(stub func() ([]pivnet.EULA, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeEULAsAPI) ListReturns(result1 []pivnet.EULA, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []pivnet.EULA
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEULAsAPI) ListReturnsOnCall(i int, result1 []pivnet.EULA, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.EULA
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []pivnet.EULA
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEULAsAPI) ListContext(arg1 context.Context) ([]pivnet.EULA, error) {
+	fake.listContextMutex.Lock()
+	ret, specificReturn := fake.listContextReturnsOnCall[len(fake.listContextArgsForCall)]
+	fake.listContextArgsForCall = append(fake.listContextArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListContextStub
+	fakeReturns := fake.listContextReturns
+	fake.recordInvocation("ListContext", []interface{}{arg1})
+	fake.listContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEULAsAPI) ListContextCallCount() int {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	return len(fake.listContextArgsForCall)
+}
+
+func (fake *FakeEULAsAPI) ListContextCalls(stub func(context.Context) ([]pivnet.EULA, error)) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = stub
+}
+
+func (fake *FakeEULAsAPI) ListContextArgsForCall(i int) context.Context {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	argsForCall := fake.listContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeEULAsAPI) ListContextReturns(result1 []pivnet.EULA, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	fake.listContextReturns = struct {
+		result1 []pivnet.EULA
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEULAsAPI) ListContextReturnsOnCall(i int, result1 []pivnet.EULA, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	if fake.listContextReturnsOnCall == nil {
+		fake.listContextReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.EULA
+			result2 error
+		})
+	}
+	fake.listContextReturnsOnCall[i] = struct {
+		result1 []pivnet.EULA
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEULAsAPI) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.acceptMutex.RLock()
+	defer fake.acceptMutex.RUnlock()
+	fake.acceptContextMutex.RLock()
+	defer fake.acceptContextMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeEULAsAPI) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pivnet.EULAsAPI = new(FakeEULAsAPI)
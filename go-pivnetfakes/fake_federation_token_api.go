@@ -0,0 +1,198 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package gopivnetfakes
+
+import (
+	"context"
+	"sync"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v9"
+)
+
+type FakeFederationTokenAPI struct {
+	GenerateFederationTokenStub        func(string) (pivnet.FederationToken, error)
+	generateFederationTokenMutex       sync.RWMutex
+	generateFederationTokenArgsForCall []struct {
+		arg1 string
+	}
+	generateFederationTokenReturns struct {
+		result1 pivnet.FederationToken
+		result2 error
+	}
+	generateFederationTokenReturnsOnCall map[int]struct {
+		result1 pivnet.FederationToken
+		result2 error
+	}
+	GenerateFederationTokenContextStub        func(context.Context, string) (pivnet.FederationToken, error)
+	generateFederationTokenContextMutex       sync.RWMutex
+	generateFederationTokenContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	generateFederationTokenContextReturns struct {
+		result1 pivnet.FederationToken
+		result2 error
+	}
+	generateFederationTokenContextReturnsOnCall map[int]struct {
+		result1 pivnet.FederationToken
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeFederationTokenAPI) GenerateFederationToken(arg1 string) (pivnet.FederationToken, error) {
+	fake.generateFederationTokenMutex.Lock()
+	ret, specificReturn := fake.generateFederationTokenReturnsOnCall[len(fake.generateFederationTokenArgsForCall)]
+	fake.generateFederationTokenArgsForCall = append(fake.generateFederationTokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GenerateFederationTokenStub
+	fakeReturns := fake.generateFederationTokenReturns
+	fake.recordInvocation("GenerateFederationToken", []interface{}{arg1})
+	fake.generateFederationTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFederationTokenAPI) GenerateFederationTokenCallCount() int {
+	fake.generateFederationTokenMutex.RLock()
+	defer fake.generateFederationTokenMutex.RUnlock()
+	return len(fake.generateFederationTokenArgsForCall)
+}
+
+func (fake *FakeFederationTokenAPI) GenerateFederationTokenCalls(stub func(string) (pivnet.FederationToken, error)) {
+	fake.generateFederationTokenMutex.Lock()
+	defer fake.generateFederationTokenMutex.Unlock()
+	fake.GenerateFederationTokenStub = stub
+}
+
+func (fake *FakeFederationTokenAPI) GenerateFederationTokenArgsForCall(i int) string {
+	fake.generateFederationTokenMutex.RLock()
+	defer fake.generateFederationTokenMutex.RUnlock()
+	argsForCall := fake.generateFederationTokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeFederationTokenAPI) GenerateFederationTokenReturns(result1 pivnet.FederationToken, result2 error) {
+	fake.generateFederationTokenMutex.Lock()
+	defer fake.generateFederationTokenMutex.Unlock()
+	fake.GenerateFederationTokenStub = nil
+	fake.generateFederationTokenReturns = struct {
+		result1 pivnet.FederationToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFederationTokenAPI) GenerateFederationTokenReturnsOnCall(i int, result1 pivnet.FederationToken, result2 error) {
+	fake.generateFederationTokenMutex.Lock()
+	defer fake.generateFederationTokenMutex.Unlock()
+	fake.GenerateFederationTokenStub = nil
+	if fake.generateFederationTokenReturnsOnCall == nil {
+		fake.generateFederationTokenReturnsOnCall = make(map[int]struct {
+			result1 pivnet.FederationToken
+			result2 error
+		})
+	}
+	fake.generateFederationTokenReturnsOnCall[i] = struct {
+		result1 pivnet.FederationToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFederationTokenAPI) GenerateFederationTokenContext(arg1 context.Context, arg2 string) (pivnet.FederationToken, error) {
+	fake.generateFederationTokenContextMutex.Lock()
+	ret, specificReturn := fake.generateFederationTokenContextReturnsOnCall[len(fake.generateFederationTokenContextArgsForCall)]
+	fake.generateFederationTokenContextArgsForCall = append(fake.generateFederationTokenContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GenerateFederationTokenContextStub
+	fakeReturns := fake.generateFederationTokenContextReturns
+	fake.recordInvocation("GenerateFederationTokenContext", []interface{}{arg1, arg2})
+	fake.generateFederationTokenContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFederationTokenAPI) GenerateFederationTokenContextCallCount() int {
+	fake.generateFederationTokenContextMutex.RLock()
+	defer fake.generateFederationTokenContextMutex.RUnlock()
+	return len(fake.generateFederationTokenContextArgsForCall)
+}
+
+func (fake *FakeFederationTokenAPI) GenerateFederationTokenContextCalls(stub func(context.Context, string) (pivnet.FederationToken, error)) {
+	fake.generateFederationTokenContextMutex.Lock()
+	defer fake.generateFederationTokenContextMutex.Unlock()
+	fake.GenerateFederationTokenContextStub = stub
+}
+
+func (fake *FakeFederationTokenAPI) GenerateFederationTokenContextArgsForCall(i int) (context.Context, string) {
+	fake.generateFederationTokenContextMutex.RLock()
+	defer fake.generateFederationTokenContextMutex.RUnlock()
+	argsForCall := fake.generateFederationTokenContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeFederationTokenAPI) GenerateFederationTokenContextReturns(result1 pivnet.FederationToken, result2 error) {
+	fake.generateFederationTokenContextMutex.Lock()
+	defer fake.generateFederationTokenContextMutex.Unlock()
+	fake.GenerateFederationTokenContextStub = nil
+	fake.generateFederationTokenContextReturns = struct {
+		result1 pivnet.FederationToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFederationTokenAPI) GenerateFederationTokenContextReturnsOnCall(i int, result1 pivnet.FederationToken, result2 error) {
+	fake.generateFederationTokenContextMutex.Lock()
+	defer fake.generateFederationTokenContextMutex.Unlock()
+	fake.GenerateFederationTokenContextStub = nil
+	if fake.generateFederationTokenContextReturnsOnCall == nil {
+		fake.generateFederationTokenContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.FederationToken
+			result2 error
+		})
+	}
+	fake.generateFederationTokenContextReturnsOnCall[i] = struct {
+		result1 pivnet.FederationToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFederationTokenAPI) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.generateFederationTokenMutex.RLock()
+	defer fake.generateFederationTokenMutex.RUnlock()
+	fake.generateFederationTokenContextMutex.RLock()
+	defer fake.generateFederationTokenContextMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeFederationTokenAPI) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pivnet.FederationTokenAPI = new(FakeFederationTokenAPI)
@@ -0,0 +1,1330 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package gopivnetfakes
+
+import (
+	"context"
+	"sync"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v9"
+)
+
+type FakeFileGroupsAPI struct {
+	AddToReleaseStub        func(string, int, int) error
+	addToReleaseMutex       sync.RWMutex
+	addToReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	addToReleaseReturns struct {
+		result1 error
+	}
+	addToReleaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddToReleaseContextStub        func(context.Context, string, int, int) error
+	addToReleaseContextMutex       sync.RWMutex
+	addToReleaseContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	addToReleaseContextReturns struct {
+		result1 error
+	}
+	addToReleaseContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateStub        func(pivnet.CreateFileGroupConfig) (pivnet.FileGroup, error)
+	createMutex       sync.RWMutex
+	createArgsForCall []struct {
+		arg1 pivnet.CreateFileGroupConfig
+	}
+	createReturns struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}
+	createReturnsOnCall map[int]struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}
+	CreateContextStub        func(context.Context, pivnet.CreateFileGroupConfig) (pivnet.FileGroup, error)
+	createContextMutex       sync.RWMutex
+	createContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 pivnet.CreateFileGroupConfig
+	}
+	createContextReturns struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}
+	createContextReturnsOnCall map[int]struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}
+	DeleteStub        func(string, int) (pivnet.FileGroup, error)
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	deleteReturns struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}
+	DeleteContextStub        func(context.Context, string, int) (pivnet.FileGroup, error)
+	deleteContextMutex       sync.RWMutex
+	deleteContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	deleteContextReturns struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}
+	deleteContextReturnsOnCall map[int]struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}
+	GetStub        func(string, int) (pivnet.FileGroup, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	getReturns struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}
+	GetContextStub        func(context.Context, string, int) (pivnet.FileGroup, error)
+	getContextMutex       sync.RWMutex
+	getContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	getContextReturns struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}
+	getContextReturnsOnCall map[int]struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}
+	ListStub        func(string) ([]pivnet.FileGroup, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		arg1 string
+	}
+	listReturns struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}
+	ListContextStub        func(context.Context, string) ([]pivnet.FileGroup, error)
+	listContextMutex       sync.RWMutex
+	listContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	listContextReturns struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}
+	listContextReturnsOnCall map[int]struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}
+	ListForReleaseStub        func(string, int) ([]pivnet.FileGroup, error)
+	listForReleaseMutex       sync.RWMutex
+	listForReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	listForReleaseReturns struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}
+	listForReleaseReturnsOnCall map[int]struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}
+	ListForReleaseContextStub        func(context.Context, string, int) ([]pivnet.FileGroup, error)
+	listForReleaseContextMutex       sync.RWMutex
+	listForReleaseContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	listForReleaseContextReturns struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}
+	listForReleaseContextReturnsOnCall map[int]struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}
+	RemoveFromReleaseStub        func(string, int, int) error
+	removeFromReleaseMutex       sync.RWMutex
+	removeFromReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	removeFromReleaseReturns struct {
+		result1 error
+	}
+	removeFromReleaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveFromReleaseContextStub        func(context.Context, string, int, int) error
+	removeFromReleaseContextMutex       sync.RWMutex
+	removeFromReleaseContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	removeFromReleaseContextReturns struct {
+		result1 error
+	}
+	removeFromReleaseContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateStub        func(string, pivnet.FileGroup) (pivnet.FileGroup, error)
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 string
+		arg2 pivnet.FileGroup
+	}
+	updateReturns struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}
+	UpdateContextStub        func(context.Context, string, pivnet.FileGroup) (pivnet.FileGroup, error)
+	updateContextMutex       sync.RWMutex
+	updateContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 pivnet.FileGroup
+	}
+	updateContextReturns struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}
+	updateContextReturnsOnCall map[int]struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeFileGroupsAPI) AddToRelease(arg1 string, arg2 int, arg3 int) error {
+	fake.addToReleaseMutex.Lock()
+	ret, specificReturn := fake.addToReleaseReturnsOnCall[len(fake.addToReleaseArgsForCall)]
+	fake.addToReleaseArgsForCall = append(fake.addToReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.AddToReleaseStub
+	fakeReturns := fake.addToReleaseReturns
+	fake.recordInvocation("AddToRelease", []interface{}{arg1, arg2, arg3})
+	fake.addToReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeFileGroupsAPI) AddToReleaseCallCount() int {
+	fake.addToReleaseMutex.RLock()
+	defer fake.addToReleaseMutex.RUnlock()
+	return len(fake.addToReleaseArgsForCall)
+}
+
+func (fake *FakeFileGroupsAPI) AddToReleaseCalls(stub func(string, int, int) error) {
+	fake.addToReleaseMutex.Lock()
+	defer fake.addToReleaseMutex.Unlock()
+	fake.AddToReleaseStub = stub
+}
+
+func (fake *FakeFileGroupsAPI) AddToReleaseArgsForCall(i int) (string, int, int) {
+	fake.addToReleaseMutex.RLock()
+	defer fake.addToReleaseMutex.RUnlock()
+	argsForCall := fake.addToReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeFileGroupsAPI) AddToReleaseReturns(result1 error) {
+	fake.addToReleaseMutex.Lock()
+	defer fake.addToReleaseMutex.Unlock()
+	fake.AddToReleaseStub = nil
+	fake.addToReleaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeFileGroupsAPI) AddToReleaseReturnsOnCall(i int, result1 error) {
+	fake.addToReleaseMutex.Lock()
+	defer fake.addToReleaseMutex.Unlock()
+	fake.AddToReleaseStub = nil
+	if fake.addToReleaseReturnsOnCall == nil {
+		fake.addToReleaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addToReleaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeFileGroupsAPI) AddToReleaseContext(arg1 context.Context, arg2 string, arg3 int, arg4 int) error {
+	fake.addToReleaseContextMutex.Lock()
+	ret, specificReturn := fake.addToReleaseContextReturnsOnCall[len(fake.addToReleaseContextArgsForCall)]
+	fake.addToReleaseContextArgsForCall = append(fake.addToReleaseContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AddToReleaseContextStub
+	fakeReturns := fake.addToReleaseContextReturns
+	fake.recordInvocation("AddToReleaseContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.addToReleaseContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeFileGroupsAPI) AddToReleaseContextCallCount() int {
+	fake.addToReleaseContextMutex.RLock()
+	defer fake.addToReleaseContextMutex.RUnlock()
+	return len(fake.addToReleaseContextArgsForCall)
+}
+
+func (fake *FakeFileGroupsAPI) AddToReleaseContextCalls(stub func(context.Context, string, int, int) error) {
+	fake.addToReleaseContextMutex.Lock()
+	defer fake.addToReleaseContextMutex.Unlock()
+	fake.AddToReleaseContextStub = stub
+}
+
+func (fake *FakeFileGroupsAPI) AddToReleaseContextArgsForCall(i int) (context.Context, string, int, int) {
+	fake.addToReleaseContextMutex.RLock()
+	defer fake.addToReleaseContextMutex.RUnlock()
+	argsForCall := fake.addToReleaseContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeFileGroupsAPI) AddToReleaseContextReturns(result1 error) {
+	fake.addToReleaseContextMutex.Lock()
+	defer fake.addToReleaseContextMutex.Unlock()
+	fake.AddToReleaseContextStub = nil
+	fake.addToReleaseContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeFileGroupsAPI) AddToReleaseContextReturnsOnCall(i int, result1 error) {
+	fake.addToReleaseContextMutex.Lock()
+	defer fake.addToReleaseContextMutex.Unlock()
+	fake.AddToReleaseContextStub = nil
+	if fake.addToReleaseContextReturnsOnCall == nil {
+		fake.addToReleaseContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addToReleaseContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeFileGroupsAPI) Create(arg1 pivnet.CreateFileGroupConfig) (pivnet.FileGroup, error) {
+	fake.createMutex.Lock()
+	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
+	fake.createArgsForCall = append(fake.createArgsForCall, struct {
+		arg1 pivnet.CreateFileGroupConfig
+	}{arg1})
+	stub := fake.CreateStub
+	fakeReturns := fake.createReturns
+	fake.recordInvocation("Create", []interface{}{arg1})
+	fake.createMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFileGroupsAPI) CreateCallCount() int {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return len(fake.createArgsForCall)
+}
+
+func (fake *FakeFileGroupsAPI) CreateCalls(stub func(pivnet.CreateFileGroupConfig) (pivnet.FileGroup, error)) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = stub
+}
+
+func (fake *FakeFileGroupsAPI) CreateArgsForCall(i int) pivnet.CreateFileGroupConfig {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	argsForCall := fake.createArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeFileGroupsAPI) CreateReturns(result1 pivnet.FileGroup, result2 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	fake.createReturns = struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) CreateReturnsOnCall(i int, result1 pivnet.FileGroup, result2 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 pivnet.FileGroup
+			result2 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) CreateContext(arg1 context.Context, arg2 pivnet.CreateFileGroupConfig) (pivnet.FileGroup, error) {
+	fake.createContextMutex.Lock()
+	ret, specificReturn := fake.createContextReturnsOnCall[len(fake.createContextArgsForCall)]
+	fake.createContextArgsForCall = append(fake.createContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 pivnet.CreateFileGroupConfig
+	}{arg1, arg2})
+	stub := fake.CreateContextStub
+	fakeReturns := fake.createContextReturns
+	fake.recordInvocation("CreateContext", []interface{}{arg1, arg2})
+	fake.createContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFileGroupsAPI) CreateContextCallCount() int {
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	return len(fake.createContextArgsForCall)
+}
+
+func (fake *FakeFileGroupsAPI) CreateContextCalls(stub func(context.Context, pivnet.CreateFileGroupConfig) (pivnet.FileGroup, error)) {
+	fake.createContextMutex.Lock()
+	defer fake.createContextMutex.Unlock()
+	fake.CreateContextStub = stub
+}
+
+func (fake *FakeFileGroupsAPI) CreateContextArgsForCall(i int) (context.Context, pivnet.CreateFileGroupConfig) {
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	argsForCall := fake.createContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeFileGroupsAPI) CreateContextReturns(result1 pivnet.FileGroup, result2 error) {
+	fake.createContextMutex.Lock()
+	defer fake.createContextMutex.Unlock()
+	fake.CreateContextStub = nil
+	fake.createContextReturns = struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) CreateContextReturnsOnCall(i int, result1 pivnet.FileGroup, result2 error) {
+	fake.createContextMutex.Lock()
+	defer fake.createContextMutex.Unlock()
+	fake.CreateContextStub = nil
+	if fake.createContextReturnsOnCall == nil {
+		fake.createContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.FileGroup
+			result2 error
+		})
+	}
+	fake.createContextReturnsOnCall[i] = struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) Delete(arg1 string, arg2 int) (pivnet.FileGroup, error) {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.DeleteStub
+	fakeReturns := fake.deleteReturns
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
+	fake.deleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFileGroupsAPI) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeFileGroupsAPI) DeleteCalls(stub func(string, int) (pivnet.FileGroup, error)) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = stub
+}
+
+func (fake *FakeFileGroupsAPI) DeleteArgsForCall(i int) (string, int) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	argsForCall := fake.deleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeFileGroupsAPI) DeleteReturns(result1 pivnet.FileGroup, result2 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) DeleteReturnsOnCall(i int, result1 pivnet.FileGroup, result2 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 pivnet.FileGroup
+			result2 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) DeleteContext(arg1 context.Context, arg2 string, arg3 int) (pivnet.FileGroup, error) {
+	fake.deleteContextMutex.Lock()
+	ret, specificReturn := fake.deleteContextReturnsOnCall[len(fake.deleteContextArgsForCall)]
+	fake.deleteContextArgsForCall = append(fake.deleteContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteContextStub
+	fakeReturns := fake.deleteContextReturns
+	fake.recordInvocation("DeleteContext", []interface{}{arg1, arg2, arg3})
+	fake.deleteContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFileGroupsAPI) DeleteContextCallCount() int {
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	return len(fake.deleteContextArgsForCall)
+}
+
+func (fake *FakeFileGroupsAPI) DeleteContextCalls(stub func(context.Context, string, int) (pivnet.FileGroup, error)) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = stub
+}
+
+func (fake *FakeFileGroupsAPI) DeleteContextArgsForCall(i int) (context.Context, string, int) {
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	argsForCall := fake.deleteContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeFileGroupsAPI) DeleteContextReturns(result1 pivnet.FileGroup, result2 error) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = nil
+	fake.deleteContextReturns = struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) DeleteContextReturnsOnCall(i int, result1 pivnet.FileGroup, result2 error) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = nil
+	if fake.deleteContextReturnsOnCall == nil {
+		fake.deleteContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.FileGroup
+			result2 error
+		})
+	}
+	fake.deleteContextReturnsOnCall[i] = struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) Get(arg1 string, arg2 int) (pivnet.FileGroup, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1, arg2})
+	fake.getMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFileGroupsAPI) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeFileGroupsAPI) GetCalls(stub func(string, int) (pivnet.FileGroup, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeFileGroupsAPI) GetArgsForCall(i int) (string, int) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeFileGroupsAPI) GetReturns(result1 pivnet.FileGroup, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) GetReturnsOnCall(i int, result1 pivnet.FileGroup, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 pivnet.FileGroup
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) GetContext(arg1 context.Context, arg2 string, arg3 int) (pivnet.FileGroup, error) {
+	fake.getContextMutex.Lock()
+	ret, specificReturn := fake.getContextReturnsOnCall[len(fake.getContextArgsForCall)]
+	fake.getContextArgsForCall = append(fake.getContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GetContextStub
+	fakeReturns := fake.getContextReturns
+	fake.recordInvocation("GetContext", []interface{}{arg1, arg2, arg3})
+	fake.getContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFileGroupsAPI) GetContextCallCount() int {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	return len(fake.getContextArgsForCall)
+}
+
+func (fake *FakeFileGroupsAPI) GetContextCalls(stub func(context.Context, string, int) (pivnet.FileGroup, error)) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = stub
+}
+
+func (fake *FakeFileGroupsAPI) GetContextArgsForCall(i int) (context.Context, string, int) {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	argsForCall := fake.getContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeFileGroupsAPI) GetContextReturns(result1 pivnet.FileGroup, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	fake.getContextReturns = struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) GetContextReturnsOnCall(i int, result1 pivnet.FileGroup, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	if fake.getContextReturnsOnCall == nil {
+		fake.getContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.FileGroup
+			result2 error
+		})
+	}
+	fake.getContextReturnsOnCall[i] = struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) List(arg1 string) ([]pivnet.FileGroup, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{arg1})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFileGroupsAPI) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeFileGroupsAPI) ListCalls(stub func(string) ([]pivnet.FileGroup, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeFileGroupsAPI) ListArgsForCall(i int) string {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	argsForCall := fake.listArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeFileGroupsAPI) ListReturns(result1 []pivnet.FileGroup, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) ListReturnsOnCall(i int, result1 []pivnet.FileGroup, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.FileGroup
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) ListContext(arg1 context.Context, arg2 string) ([]pivnet.FileGroup, error) {
+	fake.listContextMutex.Lock()
+	ret, specificReturn := fake.listContextReturnsOnCall[len(fake.listContextArgsForCall)]
+	fake.listContextArgsForCall = append(fake.listContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ListContextStub
+	fakeReturns := fake.listContextReturns
+	fake.recordInvocation("ListContext", []interface{}{arg1, arg2})
+	fake.listContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFileGroupsAPI) ListContextCallCount() int {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	return len(fake.listContextArgsForCall)
+}
+
+func (fake *FakeFileGroupsAPI) ListContextCalls(stub func(context.Context, string) ([]pivnet.FileGroup, error)) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = stub
+}
+
+func (fake *FakeFileGroupsAPI) ListContextArgsForCall(i int) (context.Context, string) {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	argsForCall := fake.listContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeFileGroupsAPI) ListContextReturns(result1 []pivnet.FileGroup, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	fake.listContextReturns = struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) ListContextReturnsOnCall(i int, result1 []pivnet.FileGroup, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	if fake.listContextReturnsOnCall == nil {
+		fake.listContextReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.FileGroup
+			result2 error
+		})
+	}
+	fake.listContextReturnsOnCall[i] = struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) ListForRelease(arg1 string, arg2 int) ([]pivnet.FileGroup, error) {
+	fake.listForReleaseMutex.Lock()
+	ret, specificReturn := fake.listForReleaseReturnsOnCall[len(fake.listForReleaseArgsForCall)]
+	fake.listForReleaseArgsForCall = append(fake.listForReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ListForReleaseStub
+	fakeReturns := fake.listForReleaseReturns
+	fake.recordInvocation("ListForRelease", []interface{}{arg1, arg2})
+	fake.listForReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFileGroupsAPI) ListForReleaseCallCount() int {
+	fake.listForReleaseMutex.RLock()
+	defer fake.listForReleaseMutex.RUnlock()
+	return len(fake.listForReleaseArgsForCall)
+}
+
+func (fake *FakeFileGroupsAPI) ListForReleaseCalls(stub func(string, int) ([]pivnet.FileGroup, error)) {
+	fake.listForReleaseMutex.Lock()
+	defer fake.listForReleaseMutex.Unlock()
+	fake.ListForReleaseStub = stub
+}
+
+func (fake *FakeFileGroupsAPI) ListForReleaseArgsForCall(i int) (string, int) {
+	fake.listForReleaseMutex.RLock()
+	defer fake.listForReleaseMutex.RUnlock()
+	argsForCall := fake.listForReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeFileGroupsAPI) ListForReleaseReturns(result1 []pivnet.FileGroup, result2 error) {
+	fake.listForReleaseMutex.Lock()
+	defer fake.listForReleaseMutex.Unlock()
+	fake.ListForReleaseStub = nil
+	fake.listForReleaseReturns = struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) ListForReleaseReturnsOnCall(i int, result1 []pivnet.FileGroup, result2 error) {
+	fake.listForReleaseMutex.Lock()
+	defer fake.listForReleaseMutex.Unlock()
+	fake.ListForReleaseStub = nil
+	if fake.listForReleaseReturnsOnCall == nil {
+		fake.listForReleaseReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.FileGroup
+			result2 error
+		})
+	}
+	fake.listForReleaseReturnsOnCall[i] = struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) ListForReleaseContext(arg1 context.Context, arg2 string, arg3 int) ([]pivnet.FileGroup, error) {
+	fake.listForReleaseContextMutex.Lock()
+	ret, specificReturn := fake.listForReleaseContextReturnsOnCall[len(fake.listForReleaseContextArgsForCall)]
+	fake.listForReleaseContextArgsForCall = append(fake.listForReleaseContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.ListForReleaseContextStub
+	fakeReturns := fake.listForReleaseContextReturns
+	fake.recordInvocation("ListForReleaseContext", []interface{}{arg1, arg2, arg3})
+	fake.listForReleaseContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFileGroupsAPI) ListForReleaseContextCallCount() int {
+	fake.listForReleaseContextMutex.RLock()
+	defer fake.listForReleaseContextMutex.RUnlock()
+	return len(fake.listForReleaseContextArgsForCall)
+}
+
+func (fake *FakeFileGroupsAPI) ListForReleaseContextCalls(stub func(context.Context, string, int) ([]pivnet.FileGroup, error)) {
+	fake.listForReleaseContextMutex.Lock()
+	defer fake.listForReleaseContextMutex.Unlock()
+	fake.ListForReleaseContextStub = stub
+}
+
+func (fake *FakeFileGroupsAPI) ListForReleaseContextArgsForCall(i int) (context.Context, string, int) {
+	fake.listForReleaseContextMutex.RLock()
+	defer fake.listForReleaseContextMutex.RUnlock()
+	argsForCall := fake.listForReleaseContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeFileGroupsAPI) ListForReleaseContextReturns(result1 []pivnet.FileGroup, result2 error) {
+	fake.listForReleaseContextMutex.Lock()
+	defer fake.listForReleaseContextMutex.Unlock()
+	fake.ListForReleaseContextStub = nil
+	fake.listForReleaseContextReturns = struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) ListForReleaseContextReturnsOnCall(i int, result1 []pivnet.FileGroup, result2 error) {
+	fake.listForReleaseContextMutex.Lock()
+	defer fake.listForReleaseContextMutex.Unlock()
+	fake.ListForReleaseContextStub = nil
+	if fake.listForReleaseContextReturnsOnCall == nil {
+		fake.listForReleaseContextReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.FileGroup
+			result2 error
+		})
+	}
+	fake.listForReleaseContextReturnsOnCall[i] = struct {
+		result1 []pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) RemoveFromRelease(arg1 string, arg2 int, arg3 int) error {
+	fake.removeFromReleaseMutex.Lock()
+	ret, specificReturn := fake.removeFromReleaseReturnsOnCall[len(fake.removeFromReleaseArgsForCall)]
+	fake.removeFromReleaseArgsForCall = append(fake.removeFromReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveFromReleaseStub
+	fakeReturns := fake.removeFromReleaseReturns
+	fake.recordInvocation("RemoveFromRelease", []interface{}{arg1, arg2, arg3})
+	fake.removeFromReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeFileGroupsAPI) RemoveFromReleaseCallCount() int {
+	fake.removeFromReleaseMutex.RLock()
+	defer fake.removeFromReleaseMutex.RUnlock()
+	return len(fake.removeFromReleaseArgsForCall)
+}
+
+func (fake *FakeFileGroupsAPI) RemoveFromReleaseCalls(stub func(string, int, int) error) {
+	fake.removeFromReleaseMutex.Lock()
+	defer fake.removeFromReleaseMutex.Unlock()
+	fake.RemoveFromReleaseStub = stub
+}
+
+func (fake *FakeFileGroupsAPI) RemoveFromReleaseArgsForCall(i int) (string, int, int) {
+	fake.removeFromReleaseMutex.RLock()
+	defer fake.removeFromReleaseMutex.RUnlock()
+	argsForCall := fake.removeFromReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeFileGroupsAPI) RemoveFromReleaseReturns(result1 error) {
+	fake.removeFromReleaseMutex.Lock()
+	defer fake.removeFromReleaseMutex.Unlock()
+	fake.RemoveFromReleaseStub = nil
+	fake.removeFromReleaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeFileGroupsAPI) RemoveFromReleaseReturnsOnCall(i int, result1 error) {
+	fake.removeFromReleaseMutex.Lock()
+	defer fake.removeFromReleaseMutex.Unlock()
+	fake.RemoveFromReleaseStub = nil
+	if fake.removeFromReleaseReturnsOnCall == nil {
+		fake.removeFromReleaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeFromReleaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeFileGroupsAPI) RemoveFromReleaseContext(arg1 context.Context, arg2 string, arg3 int, arg4 int) error {
+	fake.removeFromReleaseContextMutex.Lock()
+	ret, specificReturn := fake.removeFromReleaseContextReturnsOnCall[len(fake.removeFromReleaseContextArgsForCall)]
+	fake.removeFromReleaseContextArgsForCall = append(fake.removeFromReleaseContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RemoveFromReleaseContextStub
+	fakeReturns := fake.removeFromReleaseContextReturns
+	fake.recordInvocation("RemoveFromReleaseContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.removeFromReleaseContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeFileGroupsAPI) RemoveFromReleaseContextCallCount() int {
+	fake.removeFromReleaseContextMutex.RLock()
+	defer fake.removeFromReleaseContextMutex.RUnlock()
+	return len(fake.removeFromReleaseContextArgsForCall)
+}
+
+func (fake *FakeFileGroupsAPI) RemoveFromReleaseContextCalls(stub func(context.Context, string, int, int) error) {
+	fake.removeFromReleaseContextMutex.Lock()
+	defer fake.removeFromReleaseContextMutex.Unlock()
+	fake.RemoveFromReleaseContextStub = stub
+}
+
+func (fake *FakeFileGroupsAPI) RemoveFromReleaseContextArgsForCall(i int) (context.Context, string, int, int) {
+	fake.removeFromReleaseContextMutex.RLock()
+	defer fake.removeFromReleaseContextMutex.RUnlock()
+	argsForCall := fake.removeFromReleaseContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeFileGroupsAPI) RemoveFromReleaseContextReturns(result1 error) {
+	fake.removeFromReleaseContextMutex.Lock()
+	defer fake.removeFromReleaseContextMutex.Unlock()
+	fake.RemoveFromReleaseContextStub = nil
+	fake.removeFromReleaseContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeFileGroupsAPI) RemoveFromReleaseContextReturnsOnCall(i int, result1 error) {
+	fake.removeFromReleaseContextMutex.Lock()
+	defer fake.removeFromReleaseContextMutex.Unlock()
+	fake.RemoveFromReleaseContextStub = nil
+	if fake.removeFromReleaseContextReturnsOnCall == nil {
+		fake.removeFromReleaseContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeFromReleaseContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeFileGroupsAPI) Update(arg1 string, arg2 pivnet.FileGroup) (pivnet.FileGroup, error) {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 string
+		arg2 pivnet.FileGroup
+	}{arg1, arg2})
+	stub := fake.UpdateStub
+	fakeReturns := fake.updateReturns
+	fake.recordInvocation("Update", []interface{}{arg1, arg2})
+	fake.updateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFileGroupsAPI) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeFileGroupsAPI) UpdateCalls(stub func(string, pivnet.FileGroup) (pivnet.FileGroup, error)) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = stub
+}
+
+func (fake *FakeFileGroupsAPI) UpdateArgsForCall(i int) (string, pivnet.FileGroup) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	argsForCall := fake.updateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeFileGroupsAPI) UpdateReturns(result1 pivnet.FileGroup, result2 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) UpdateReturnsOnCall(i int, result1 pivnet.FileGroup, result2 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 pivnet.FileGroup
+			result2 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) UpdateContext(arg1 context.Context, arg2 string, arg3 pivnet.FileGroup) (pivnet.FileGroup, error) {
+	fake.updateContextMutex.Lock()
+	ret, specificReturn := fake.updateContextReturnsOnCall[len(fake.updateContextArgsForCall)]
+	fake.updateContextArgsForCall = append(fake.updateContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 pivnet.FileGroup
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateContextStub
+	fakeReturns := fake.updateContextReturns
+	fake.recordInvocation("UpdateContext", []interface{}{arg1, arg2, arg3})
+	fake.updateContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFileGroupsAPI) UpdateContextCallCount() int {
+	fake.updateContextMutex.RLock()
+	defer fake.updateContextMutex.RUnlock()
+	return len(fake.updateContextArgsForCall)
+}
+
+func (fake *FakeFileGroupsAPI) UpdateContextCalls(stub func(context.Context, string, pivnet.FileGroup) (pivnet.FileGroup, error)) {
+	fake.updateContextMutex.Lock()
+	defer fake.updateContextMutex.Unlock()
+	fake.UpdateContextStub = stub
+}
+
+func (fake *FakeFileGroupsAPI) UpdateContextArgsForCall(i int) (context.Context, string, pivnet.FileGroup) {
+	fake.updateContextMutex.RLock()
+	defer fake.updateContextMutex.RUnlock()
+	argsForCall := fake.updateContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeFileGroupsAPI) UpdateContextReturns(result1 pivnet.FileGroup, result2 error) {
+	fake.updateContextMutex.Lock()
+	defer fake.updateContextMutex.Unlock()
+	fake.UpdateContextStub = nil
+	fake.updateContextReturns = struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) UpdateContextReturnsOnCall(i int, result1 pivnet.FileGroup, result2 error) {
+	fake.updateContextMutex.Lock()
+	defer fake.updateContextMutex.Unlock()
+	fake.UpdateContextStub = nil
+	if fake.updateContextReturnsOnCall == nil {
+		fake.updateContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.FileGroup
+			result2 error
+		})
+	}
+	fake.updateContextReturnsOnCall[i] = struct {
+		result1 pivnet.FileGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFileGroupsAPI) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.addToReleaseMutex.RLock()
+	defer fake.addToReleaseMutex.RUnlock()
+	fake.addToReleaseContextMutex.RLock()
+	defer fake.addToReleaseContextMutex.RUnlock()
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	fake.listForReleaseMutex.RLock()
+	defer fake.listForReleaseMutex.RUnlock()
+	fake.listForReleaseContextMutex.RLock()
+	defer fake.listForReleaseContextMutex.RUnlock()
+	fake.removeFromReleaseMutex.RLock()
+	defer fake.removeFromReleaseMutex.RUnlock()
+	fake.removeFromReleaseContextMutex.RLock()
+	defer fake.removeFromReleaseContextMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	fake.updateContextMutex.RLock()
+	defer fake.updateContextMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeFileGroupsAPI) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pivnet.FileGroupsAPI = new(FakeFileGroupsAPI)
@@ -0,0 +1,187 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package gopivnetfakes
+
+import (
+	"context"
+	"sync"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v9"
+)
+
+type FakePivnetVersionsAPI struct {
+	ListStub        func() (pivnet.PivnetVersions, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+	}
+	listReturns struct {
+		result1 pivnet.PivnetVersions
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 pivnet.PivnetVersions
+		result2 error
+	}
+	ListContextStub        func(context.Context) (pivnet.PivnetVersions, error)
+	listContextMutex       sync.RWMutex
+	listContextArgsForCall []struct {
+		arg1 context.Context
+	}
+	listContextReturns struct {
+		result1 pivnet.PivnetVersions
+		result2 error
+	}
+	listContextReturnsOnCall map[int]struct {
+		result1 pivnet.PivnetVersions
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePivnetVersionsAPI) List() (pivnet.PivnetVersions, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+	}{})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetVersionsAPI) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakePivnetVersionsAPI) ListCalls(stub func() (pivnet.PivnetVersions, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakePivnetVersionsAPI) ListReturns(result1 pivnet.PivnetVersions, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 pivnet.PivnetVersions
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetVersionsAPI) ListReturnsOnCall(i int, result1 pivnet.PivnetVersions, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 pivnet.PivnetVersions
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 pivnet.PivnetVersions
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetVersionsAPI) ListContext(arg1 context.Context) (pivnet.PivnetVersions, error) {
+	fake.listContextMutex.Lock()
+	ret, specificReturn := fake.listContextReturnsOnCall[len(fake.listContextArgsForCall)]
+	fake.listContextArgsForCall = append(fake.listContextArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListContextStub
+	fakeReturns := fake.listContextReturns
+	fake.recordInvocation("ListContext", []interface{}{arg1})
+	fake.listContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePivnetVersionsAPI) ListContextCallCount() int {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	return len(fake.listContextArgsForCall)
+}
+
+func (fake *FakePivnetVersionsAPI) ListContextCalls(stub func(context.Context) (pivnet.PivnetVersions, error)) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = stub
+}
+
+func (fake *FakePivnetVersionsAPI) ListContextArgsForCall(i int) context.Context {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	argsForCall := fake.listContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePivnetVersionsAPI) ListContextReturns(result1 pivnet.PivnetVersions, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	fake.listContextReturns = struct {
+		result1 pivnet.PivnetVersions
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetVersionsAPI) ListContextReturnsOnCall(i int, result1 pivnet.PivnetVersions, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	if fake.listContextReturnsOnCall == nil {
+		fake.listContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.PivnetVersions
+			result2 error
+		})
+	}
+	fake.listContextReturnsOnCall[i] = struct {
+		result1 pivnet.PivnetVersions
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePivnetVersionsAPI) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePivnetVersionsAPI) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pivnet.PivnetVersionsAPI = new(FakePivnetVersionsAPI)
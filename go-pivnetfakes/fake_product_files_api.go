@@ -0,0 +1,2802 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package gopivnetfakes
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v9"
+	"github.com/pivotal-cf/go-pivnet/v9/download"
+)
+
+type FakeProductFilesAPI struct {
+	AddToFileGroupStub        func(string, int, int) error
+	addToFileGroupMutex       sync.RWMutex
+	addToFileGroupArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	addToFileGroupReturns struct {
+		result1 error
+	}
+	addToFileGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddToFileGroupContextStub        func(context.Context, string, int, int) error
+	addToFileGroupContextMutex       sync.RWMutex
+	addToFileGroupContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	addToFileGroupContextReturns struct {
+		result1 error
+	}
+	addToFileGroupContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddToReleaseStub        func(string, int, int) error
+	addToReleaseMutex       sync.RWMutex
+	addToReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	addToReleaseReturns struct {
+		result1 error
+	}
+	addToReleaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddToReleaseContextStub        func(context.Context, string, int, int) error
+	addToReleaseContextMutex       sync.RWMutex
+	addToReleaseContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	addToReleaseContextReturns struct {
+		result1 error
+	}
+	addToReleaseContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateStub        func(pivnet.CreateProductFileConfig) (pivnet.ProductFile, error)
+	createMutex       sync.RWMutex
+	createArgsForCall []struct {
+		arg1 pivnet.CreateProductFileConfig
+	}
+	createReturns struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	createReturnsOnCall map[int]struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	CreateContextStub        func(context.Context, pivnet.CreateProductFileConfig) (pivnet.ProductFile, error)
+	createContextMutex       sync.RWMutex
+	createContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 pivnet.CreateProductFileConfig
+	}
+	createContextReturns struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	createContextReturnsOnCall map[int]struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	DeleteStub        func(string, int) (pivnet.ProductFile, error)
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	deleteReturns struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	DeleteContextStub        func(context.Context, string, int) (pivnet.ProductFile, error)
+	deleteContextMutex       sync.RWMutex
+	deleteContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	deleteContextReturns struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	deleteContextReturnsOnCall map[int]struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	DownloadForReleaseStub        func(*download.FileInfo, string, int, int, io.Writer) error
+	downloadForReleaseMutex       sync.RWMutex
+	downloadForReleaseArgsForCall []struct {
+		arg1 *download.FileInfo
+		arg2 string
+		arg3 int
+		arg4 int
+		arg5 io.Writer
+	}
+	downloadForReleaseReturns struct {
+		result1 error
+	}
+	downloadForReleaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DownloadForReleaseContextStub        func(context.Context, *download.FileInfo, string, int, int, io.Writer) error
+	downloadForReleaseContextMutex       sync.RWMutex
+	downloadForReleaseContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 *download.FileInfo
+		arg3 string
+		arg4 int
+		arg5 int
+		arg6 io.Writer
+	}
+	downloadForReleaseContextReturns struct {
+		result1 error
+	}
+	downloadForReleaseContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DownloadReleaseStub        func(string, string, int, pivnet.DownloadReleaseConfig, io.Writer) (pivnet.DownloadReport, error)
+	downloadReleaseMutex       sync.RWMutex
+	downloadReleaseArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+		arg4 pivnet.DownloadReleaseConfig
+		arg5 io.Writer
+	}
+	downloadReleaseReturns struct {
+		result1 pivnet.DownloadReport
+		result2 error
+	}
+	downloadReleaseReturnsOnCall map[int]struct {
+		result1 pivnet.DownloadReport
+		result2 error
+	}
+	DownloadReleaseContextStub        func(context.Context, string, string, int, pivnet.DownloadReleaseConfig, io.Writer) (pivnet.DownloadReport, error)
+	downloadReleaseContextMutex       sync.RWMutex
+	downloadReleaseContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 int
+		arg5 pivnet.DownloadReleaseConfig
+		arg6 io.Writer
+	}
+	downloadReleaseContextReturns struct {
+		result1 pivnet.DownloadReport
+		result2 error
+	}
+	downloadReleaseContextReturnsOnCall map[int]struct {
+		result1 pivnet.DownloadReport
+		result2 error
+	}
+	DownloadToWriterStub        func(io.Writer, string, int, int, io.Writer) error
+	downloadToWriterMutex       sync.RWMutex
+	downloadToWriterArgsForCall []struct {
+		arg1 io.Writer
+		arg2 string
+		arg3 int
+		arg4 int
+		arg5 io.Writer
+	}
+	downloadToWriterReturns struct {
+		result1 error
+	}
+	downloadToWriterReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DownloadToWriterContextStub        func(context.Context, io.Writer, string, int, int, io.Writer) error
+	downloadToWriterContextMutex       sync.RWMutex
+	downloadToWriterContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 io.Writer
+		arg3 string
+		arg4 int
+		arg5 int
+		arg6 io.Writer
+	}
+	downloadToWriterContextReturns struct {
+		result1 error
+	}
+	downloadToWriterContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetStub        func(string, int) (pivnet.ProductFile, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	getReturns struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	GetContextStub        func(context.Context, string, int) (pivnet.ProductFile, error)
+	getContextMutex       sync.RWMutex
+	getContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	getContextReturns struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	getContextReturnsOnCall map[int]struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	GetForReleaseStub        func(string, int, int) (pivnet.ProductFile, error)
+	getForReleaseMutex       sync.RWMutex
+	getForReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	getForReleaseReturns struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	getForReleaseReturnsOnCall map[int]struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	GetForReleaseContextStub        func(context.Context, string, int, int) (pivnet.ProductFile, error)
+	getForReleaseContextMutex       sync.RWMutex
+	getForReleaseContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	getForReleaseContextReturns struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	getForReleaseContextReturnsOnCall map[int]struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	IterateStub        func(string, pivnet.ListOptions) *pivnet.ProductFileIterator
+	iterateMutex       sync.RWMutex
+	iterateArgsForCall []struct {
+		arg1 string
+		arg2 pivnet.ListOptions
+	}
+	iterateReturns struct {
+		result1 *pivnet.ProductFileIterator
+	}
+	iterateReturnsOnCall map[int]struct {
+		result1 *pivnet.ProductFileIterator
+	}
+	IterateContextStub        func(context.Context, string, pivnet.ListOptions) *pivnet.ProductFileIterator
+	iterateContextMutex       sync.RWMutex
+	iterateContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 pivnet.ListOptions
+	}
+	iterateContextReturns struct {
+		result1 *pivnet.ProductFileIterator
+	}
+	iterateContextReturnsOnCall map[int]struct {
+		result1 *pivnet.ProductFileIterator
+	}
+	ListStub        func(string) ([]pivnet.ProductFile, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		arg1 string
+	}
+	listReturns struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	ListAllStub        func(string, pivnet.ListOptions) ([]pivnet.ProductFile, error)
+	listAllMutex       sync.RWMutex
+	listAllArgsForCall []struct {
+		arg1 string
+		arg2 pivnet.ListOptions
+	}
+	listAllReturns struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	listAllReturnsOnCall map[int]struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	ListAllContextStub        func(context.Context, string, pivnet.ListOptions) ([]pivnet.ProductFile, error)
+	listAllContextMutex       sync.RWMutex
+	listAllContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 pivnet.ListOptions
+	}
+	listAllContextReturns struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	listAllContextReturnsOnCall map[int]struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	ListContextStub        func(context.Context, string) ([]pivnet.ProductFile, error)
+	listContextMutex       sync.RWMutex
+	listContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	listContextReturns struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	listContextReturnsOnCall map[int]struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	ListForReleaseStub        func(string, int) ([]pivnet.ProductFile, error)
+	listForReleaseMutex       sync.RWMutex
+	listForReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	listForReleaseReturns struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	listForReleaseReturnsOnCall map[int]struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	ListForReleaseContextStub        func(context.Context, string, int) ([]pivnet.ProductFile, error)
+	listForReleaseContextMutex       sync.RWMutex
+	listForReleaseContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	listForReleaseContextReturns struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	listForReleaseContextReturnsOnCall map[int]struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}
+	RemoveFromFileGroupStub        func(string, int, int) error
+	removeFromFileGroupMutex       sync.RWMutex
+	removeFromFileGroupArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	removeFromFileGroupReturns struct {
+		result1 error
+	}
+	removeFromFileGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveFromFileGroupContextStub        func(context.Context, string, int, int) error
+	removeFromFileGroupContextMutex       sync.RWMutex
+	removeFromFileGroupContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	removeFromFileGroupContextReturns struct {
+		result1 error
+	}
+	removeFromFileGroupContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveFromReleaseStub        func(string, int, int) error
+	removeFromReleaseMutex       sync.RWMutex
+	removeFromReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	removeFromReleaseReturns struct {
+		result1 error
+	}
+	removeFromReleaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveFromReleaseContextStub        func(context.Context, string, int, int) error
+	removeFromReleaseContextMutex       sync.RWMutex
+	removeFromReleaseContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	removeFromReleaseContextReturns struct {
+		result1 error
+	}
+	removeFromReleaseContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateStub        func(string, pivnet.ProductFile) (pivnet.ProductFile, error)
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 string
+		arg2 pivnet.ProductFile
+	}
+	updateReturns struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	UpdateContextStub        func(context.Context, string, pivnet.ProductFile) (pivnet.ProductFile, error)
+	updateContextMutex       sync.RWMutex
+	updateContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 pivnet.ProductFile
+	}
+	updateContextReturns struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	updateContextReturnsOnCall map[int]struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	UploadStub        func(pivnet.UploadProductFileConfig) (pivnet.ProductFile, error)
+	uploadMutex       sync.RWMutex
+	uploadArgsForCall []struct {
+		arg1 pivnet.UploadProductFileConfig
+	}
+	uploadReturns struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	uploadReturnsOnCall map[int]struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	UploadContextStub        func(context.Context, pivnet.UploadProductFileConfig) (pivnet.ProductFile, error)
+	uploadContextMutex       sync.RWMutex
+	uploadContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 pivnet.UploadProductFileConfig
+	}
+	uploadContextReturns struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	uploadContextReturnsOnCall map[int]struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeProductFilesAPI) AddToFileGroup(arg1 string, arg2 int, arg3 int) error {
+	fake.addToFileGroupMutex.Lock()
+	ret, specificReturn := fake.addToFileGroupReturnsOnCall[len(fake.addToFileGroupArgsForCall)]
+	fake.addToFileGroupArgsForCall = append(fake.addToFileGroupArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.AddToFileGroupStub
+	fakeReturns := fake.addToFileGroupReturns
+	fake.recordInvocation("AddToFileGroup", []interface{}{arg1, arg2, arg3})
+	fake.addToFileGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeProductFilesAPI) AddToFileGroupCallCount() int {
+	fake.addToFileGroupMutex.RLock()
+	defer fake.addToFileGroupMutex.RUnlock()
+	return len(fake.addToFileGroupArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) AddToFileGroupCalls(stub func(string, int, int) error) {
+	fake.addToFileGroupMutex.Lock()
+	defer fake.addToFileGroupMutex.Unlock()
+	fake.AddToFileGroupStub = stub
+}
+
+func (fake *FakeProductFilesAPI) AddToFileGroupArgsForCall(i int) (string, int, int) {
+	fake.addToFileGroupMutex.RLock()
+	defer fake.addToFileGroupMutex.RUnlock()
+	argsForCall := fake.addToFileGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeProductFilesAPI) AddToFileGroupReturns(result1 error) {
+	fake.addToFileGroupMutex.Lock()
+	defer fake.addToFileGroupMutex.Unlock()
+	fake.AddToFileGroupStub = nil
+	fake.addToFileGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) AddToFileGroupReturnsOnCall(i int, result1 error) {
+	fake.addToFileGroupMutex.Lock()
+	defer fake.addToFileGroupMutex.Unlock()
+	fake.AddToFileGroupStub = nil
+	if fake.addToFileGroupReturnsOnCall == nil {
+		fake.addToFileGroupReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addToFileGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) AddToFileGroupContext(arg1 context.Context, arg2 string, arg3 int, arg4 int) error {
+	fake.addToFileGroupContextMutex.Lock()
+	ret, specificReturn := fake.addToFileGroupContextReturnsOnCall[len(fake.addToFileGroupContextArgsForCall)]
+	fake.addToFileGroupContextArgsForCall = append(fake.addToFileGroupContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AddToFileGroupContextStub
+	fakeReturns := fake.addToFileGroupContextReturns
+	fake.recordInvocation("AddToFileGroupContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.addToFileGroupContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeProductFilesAPI) AddToFileGroupContextCallCount() int {
+	fake.addToFileGroupContextMutex.RLock()
+	defer fake.addToFileGroupContextMutex.RUnlock()
+	return len(fake.addToFileGroupContextArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) AddToFileGroupContextCalls(stub func(context.Context, string, int, int) error) {
+	fake.addToFileGroupContextMutex.Lock()
+	defer fake.addToFileGroupContextMutex.Unlock()
+	fake.AddToFileGroupContextStub = stub
+}
+
+func (fake *FakeProductFilesAPI) AddToFileGroupContextArgsForCall(i int) (context.Context, string, int, int) {
+	fake.addToFileGroupContextMutex.RLock()
+	defer fake.addToFileGroupContextMutex.RUnlock()
+	argsForCall := fake.addToFileGroupContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeProductFilesAPI) AddToFileGroupContextReturns(result1 error) {
+	fake.addToFileGroupContextMutex.Lock()
+	defer fake.addToFileGroupContextMutex.Unlock()
+	fake.AddToFileGroupContextStub = nil
+	fake.addToFileGroupContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) AddToFileGroupContextReturnsOnCall(i int, result1 error) {
+	fake.addToFileGroupContextMutex.Lock()
+	defer fake.addToFileGroupContextMutex.Unlock()
+	fake.AddToFileGroupContextStub = nil
+	if fake.addToFileGroupContextReturnsOnCall == nil {
+		fake.addToFileGroupContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addToFileGroupContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) AddToRelease(arg1 string, arg2 int, arg3 int) error {
+	fake.addToReleaseMutex.Lock()
+	ret, specificReturn := fake.addToReleaseReturnsOnCall[len(fake.addToReleaseArgsForCall)]
+	fake.addToReleaseArgsForCall = append(fake.addToReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.AddToReleaseStub
+	fakeReturns := fake.addToReleaseReturns
+	fake.recordInvocation("AddToRelease", []interface{}{arg1, arg2, arg3})
+	fake.addToReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeProductFilesAPI) AddToReleaseCallCount() int {
+	fake.addToReleaseMutex.RLock()
+	defer fake.addToReleaseMutex.RUnlock()
+	return len(fake.addToReleaseArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) AddToReleaseCalls(stub func(string, int, int) error) {
+	fake.addToReleaseMutex.Lock()
+	defer fake.addToReleaseMutex.Unlock()
+	fake.AddToReleaseStub = stub
+}
+
+func (fake *FakeProductFilesAPI) AddToReleaseArgsForCall(i int) (string, int, int) {
+	fake.addToReleaseMutex.RLock()
+	defer fake.addToReleaseMutex.RUnlock()
+	argsForCall := fake.addToReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeProductFilesAPI) AddToReleaseReturns(result1 error) {
+	fake.addToReleaseMutex.Lock()
+	defer fake.addToReleaseMutex.Unlock()
+	fake.AddToReleaseStub = nil
+	fake.addToReleaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) AddToReleaseReturnsOnCall(i int, result1 error) {
+	fake.addToReleaseMutex.Lock()
+	defer fake.addToReleaseMutex.Unlock()
+	fake.AddToReleaseStub = nil
+	if fake.addToReleaseReturnsOnCall == nil {
+		fake.addToReleaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addToReleaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) AddToReleaseContext(arg1 context.Context, arg2 string, arg3 int, arg4 int) error {
+	fake.addToReleaseContextMutex.Lock()
+	ret, specificReturn := fake.addToReleaseContextReturnsOnCall[len(fake.addToReleaseContextArgsForCall)]
+	fake.addToReleaseContextArgsForCall = append(fake.addToReleaseContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AddToReleaseContextStub
+	fakeReturns := fake.addToReleaseContextReturns
+	fake.recordInvocation("AddToReleaseContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.addToReleaseContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeProductFilesAPI) AddToReleaseContextCallCount() int {
+	fake.addToReleaseContextMutex.RLock()
+	defer fake.addToReleaseContextMutex.RUnlock()
+	return len(fake.addToReleaseContextArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) AddToReleaseContextCalls(stub func(context.Context, string, int, int) error) {
+	fake.addToReleaseContextMutex.Lock()
+	defer fake.addToReleaseContextMutex.Unlock()
+	fake.AddToReleaseContextStub = stub
+}
+
+func (fake *FakeProductFilesAPI) AddToReleaseContextArgsForCall(i int) (context.Context, string, int, int) {
+	fake.addToReleaseContextMutex.RLock()
+	defer fake.addToReleaseContextMutex.RUnlock()
+	argsForCall := fake.addToReleaseContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeProductFilesAPI) AddToReleaseContextReturns(result1 error) {
+	fake.addToReleaseContextMutex.Lock()
+	defer fake.addToReleaseContextMutex.Unlock()
+	fake.AddToReleaseContextStub = nil
+	fake.addToReleaseContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) AddToReleaseContextReturnsOnCall(i int, result1 error) {
+	fake.addToReleaseContextMutex.Lock()
+	defer fake.addToReleaseContextMutex.Unlock()
+	fake.AddToReleaseContextStub = nil
+	if fake.addToReleaseContextReturnsOnCall == nil {
+		fake.addToReleaseContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addToReleaseContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) Create(arg1 pivnet.CreateProductFileConfig) (pivnet.ProductFile, error) {
+	fake.createMutex.Lock()
+	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
+	fake.createArgsForCall = append(fake.createArgsForCall, struct {
+		arg1 pivnet.CreateProductFileConfig
+	}{arg1})
+	stub := fake.CreateStub
+	fakeReturns := fake.createReturns
+	fake.recordInvocation("Create", []interface{}{arg1})
+	fake.createMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductFilesAPI) CreateCallCount() int {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return len(fake.createArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) CreateCalls(stub func(pivnet.CreateProductFileConfig) (pivnet.ProductFile, error)) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = stub
+}
+
+func (fake *FakeProductFilesAPI) CreateArgsForCall(i int) pivnet.CreateProductFileConfig {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	argsForCall := fake.createArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeProductFilesAPI) CreateReturns(result1 pivnet.ProductFile, result2 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	fake.createReturns = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) CreateReturnsOnCall(i int, result1 pivnet.ProductFile, result2 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) CreateContext(arg1 context.Context, arg2 pivnet.CreateProductFileConfig) (pivnet.ProductFile, error) {
+	fake.createContextMutex.Lock()
+	ret, specificReturn := fake.createContextReturnsOnCall[len(fake.createContextArgsForCall)]
+	fake.createContextArgsForCall = append(fake.createContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 pivnet.CreateProductFileConfig
+	}{arg1, arg2})
+	stub := fake.CreateContextStub
+	fakeReturns := fake.createContextReturns
+	fake.recordInvocation("CreateContext", []interface{}{arg1, arg2})
+	fake.createContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductFilesAPI) CreateContextCallCount() int {
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	return len(fake.createContextArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) CreateContextCalls(stub func(context.Context, pivnet.CreateProductFileConfig) (pivnet.ProductFile, error)) {
+	fake.createContextMutex.Lock()
+	defer fake.createContextMutex.Unlock()
+	fake.CreateContextStub = stub
+}
+
+func (fake *FakeProductFilesAPI) CreateContextArgsForCall(i int) (context.Context, pivnet.CreateProductFileConfig) {
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	argsForCall := fake.createContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeProductFilesAPI) CreateContextReturns(result1 pivnet.ProductFile, result2 error) {
+	fake.createContextMutex.Lock()
+	defer fake.createContextMutex.Unlock()
+	fake.CreateContextStub = nil
+	fake.createContextReturns = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) CreateContextReturnsOnCall(i int, result1 pivnet.ProductFile, result2 error) {
+	fake.createContextMutex.Lock()
+	defer fake.createContextMutex.Unlock()
+	fake.CreateContextStub = nil
+	if fake.createContextReturnsOnCall == nil {
+		fake.createContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.createContextReturnsOnCall[i] = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) Delete(arg1 string, arg2 int) (pivnet.ProductFile, error) {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.DeleteStub
+	fakeReturns := fake.deleteReturns
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
+	fake.deleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductFilesAPI) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) DeleteCalls(stub func(string, int) (pivnet.ProductFile, error)) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = stub
+}
+
+func (fake *FakeProductFilesAPI) DeleteArgsForCall(i int) (string, int) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	argsForCall := fake.deleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeProductFilesAPI) DeleteReturns(result1 pivnet.ProductFile, result2 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) DeleteReturnsOnCall(i int, result1 pivnet.ProductFile, result2 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) DeleteContext(arg1 context.Context, arg2 string, arg3 int) (pivnet.ProductFile, error) {
+	fake.deleteContextMutex.Lock()
+	ret, specificReturn := fake.deleteContextReturnsOnCall[len(fake.deleteContextArgsForCall)]
+	fake.deleteContextArgsForCall = append(fake.deleteContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteContextStub
+	fakeReturns := fake.deleteContextReturns
+	fake.recordInvocation("DeleteContext", []interface{}{arg1, arg2, arg3})
+	fake.deleteContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductFilesAPI) DeleteContextCallCount() int {
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	return len(fake.deleteContextArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) DeleteContextCalls(stub func(context.Context, string, int) (pivnet.ProductFile, error)) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = stub
+}
+
+func (fake *FakeProductFilesAPI) DeleteContextArgsForCall(i int) (context.Context, string, int) {
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	argsForCall := fake.deleteContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeProductFilesAPI) DeleteContextReturns(result1 pivnet.ProductFile, result2 error) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = nil
+	fake.deleteContextReturns = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) DeleteContextReturnsOnCall(i int, result1 pivnet.ProductFile, result2 error) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = nil
+	if fake.deleteContextReturnsOnCall == nil {
+		fake.deleteContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.deleteContextReturnsOnCall[i] = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) DownloadForRelease(arg1 *download.FileInfo, arg2 string, arg3 int, arg4 int, arg5 io.Writer) error {
+	fake.downloadForReleaseMutex.Lock()
+	ret, specificReturn := fake.downloadForReleaseReturnsOnCall[len(fake.downloadForReleaseArgsForCall)]
+	fake.downloadForReleaseArgsForCall = append(fake.downloadForReleaseArgsForCall, struct {
+		arg1 *download.FileInfo
+		arg2 string
+		arg3 int
+		arg4 int
+		arg5 io.Writer
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.DownloadForReleaseStub
+	fakeReturns := fake.downloadForReleaseReturns
+	fake.recordInvocation("DownloadForRelease", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.downloadForReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeProductFilesAPI) DownloadForReleaseCallCount() int {
+	fake.downloadForReleaseMutex.RLock()
+	defer fake.downloadForReleaseMutex.RUnlock()
+	return len(fake.downloadForReleaseArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) DownloadForReleaseCalls(stub func(*download.FileInfo, string, int, int, io.Writer) error) {
+	fake.downloadForReleaseMutex.Lock()
+	defer fake.downloadForReleaseMutex.Unlock()
+	fake.DownloadForReleaseStub = stub
+}
+
+func (fake *FakeProductFilesAPI) DownloadForReleaseArgsForCall(i int) (*download.FileInfo, string, int, int, io.Writer) {
+	fake.downloadForReleaseMutex.RLock()
+	defer fake.downloadForReleaseMutex.RUnlock()
+	argsForCall := fake.downloadForReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeProductFilesAPI) DownloadForReleaseReturns(result1 error) {
+	fake.downloadForReleaseMutex.Lock()
+	defer fake.downloadForReleaseMutex.Unlock()
+	fake.DownloadForReleaseStub = nil
+	fake.downloadForReleaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) DownloadForReleaseReturnsOnCall(i int, result1 error) {
+	fake.downloadForReleaseMutex.Lock()
+	defer fake.downloadForReleaseMutex.Unlock()
+	fake.DownloadForReleaseStub = nil
+	if fake.downloadForReleaseReturnsOnCall == nil {
+		fake.downloadForReleaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.downloadForReleaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) DownloadForReleaseContext(arg1 context.Context, arg2 *download.FileInfo, arg3 string, arg4 int, arg5 int, arg6 io.Writer) error {
+	fake.downloadForReleaseContextMutex.Lock()
+	ret, specificReturn := fake.downloadForReleaseContextReturnsOnCall[len(fake.downloadForReleaseContextArgsForCall)]
+	fake.downloadForReleaseContextArgsForCall = append(fake.downloadForReleaseContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 *download.FileInfo
+		arg3 string
+		arg4 int
+		arg5 int
+		arg6 io.Writer
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.DownloadForReleaseContextStub
+	fakeReturns := fake.downloadForReleaseContextReturns
+	fake.recordInvocation("DownloadForReleaseContext", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.downloadForReleaseContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeProductFilesAPI) DownloadForReleaseContextCallCount() int {
+	fake.downloadForReleaseContextMutex.RLock()
+	defer fake.downloadForReleaseContextMutex.RUnlock()
+	return len(fake.downloadForReleaseContextArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) DownloadForReleaseContextCalls(stub func(context.Context, *download.FileInfo, string, int, int, io.Writer) error) {
+	fake.downloadForReleaseContextMutex.Lock()
+	defer fake.downloadForReleaseContextMutex.Unlock()
+	fake.DownloadForReleaseContextStub = stub
+}
+
+func (fake *FakeProductFilesAPI) DownloadForReleaseContextArgsForCall(i int) (context.Context, *download.FileInfo, string, int, int, io.Writer) {
+	fake.downloadForReleaseContextMutex.RLock()
+	defer fake.downloadForReleaseContextMutex.RUnlock()
+	argsForCall := fake.downloadForReleaseContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeProductFilesAPI) DownloadForReleaseContextReturns(result1 error) {
+	fake.downloadForReleaseContextMutex.Lock()
+	defer fake.downloadForReleaseContextMutex.Unlock()
+	fake.DownloadForReleaseContextStub = nil
+	fake.downloadForReleaseContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) DownloadForReleaseContextReturnsOnCall(i int, result1 error) {
+	fake.downloadForReleaseContextMutex.Lock()
+	defer fake.downloadForReleaseContextMutex.Unlock()
+	fake.DownloadForReleaseContextStub = nil
+	if fake.downloadForReleaseContextReturnsOnCall == nil {
+		fake.downloadForReleaseContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.downloadForReleaseContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) DownloadRelease(arg1 string, arg2 string, arg3 int, arg4 pivnet.DownloadReleaseConfig, arg5 io.Writer) (pivnet.DownloadReport, error) {
+	fake.downloadReleaseMutex.Lock()
+	ret, specificReturn := fake.downloadReleaseReturnsOnCall[len(fake.downloadReleaseArgsForCall)]
+	fake.downloadReleaseArgsForCall = append(fake.downloadReleaseArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+		arg4 pivnet.DownloadReleaseConfig
+		arg5 io.Writer
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.DownloadReleaseStub
+	fakeReturns := fake.downloadReleaseReturns
+	fake.recordInvocation("DownloadRelease", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.downloadReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductFilesAPI) DownloadReleaseCallCount() int {
+	fake.downloadReleaseMutex.RLock()
+	defer fake.downloadReleaseMutex.RUnlock()
+	return len(fake.downloadReleaseArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) DownloadReleaseCalls(stub func(string, string, int, pivnet.DownloadReleaseConfig, io.Writer) (pivnet.DownloadReport, error)) {
+	fake.downloadReleaseMutex.Lock()
+	defer fake.downloadReleaseMutex.Unlock()
+	fake.DownloadReleaseStub = stub
+}
+
+func (fake *FakeProductFilesAPI) DownloadReleaseArgsForCall(i int) (string, string, int, pivnet.DownloadReleaseConfig, io.Writer) {
+	fake.downloadReleaseMutex.RLock()
+	defer fake.downloadReleaseMutex.RUnlock()
+	argsForCall := fake.downloadReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeProductFilesAPI) DownloadReleaseReturns(result1 pivnet.DownloadReport, result2 error) {
+	fake.downloadReleaseMutex.Lock()
+	defer fake.downloadReleaseMutex.Unlock()
+	fake.DownloadReleaseStub = nil
+	fake.downloadReleaseReturns = struct {
+		result1 pivnet.DownloadReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) DownloadReleaseReturnsOnCall(i int, result1 pivnet.DownloadReport, result2 error) {
+	fake.downloadReleaseMutex.Lock()
+	defer fake.downloadReleaseMutex.Unlock()
+	fake.DownloadReleaseStub = nil
+	if fake.downloadReleaseReturnsOnCall == nil {
+		fake.downloadReleaseReturnsOnCall = make(map[int]struct {
+			result1 pivnet.DownloadReport
+			result2 error
+		})
+	}
+	fake.downloadReleaseReturnsOnCall[i] = struct {
+		result1 pivnet.DownloadReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) DownloadReleaseContext(arg1 context.Context, arg2 string, arg3 string, arg4 int, arg5 pivnet.DownloadReleaseConfig, arg6 io.Writer) (pivnet.DownloadReport, error) {
+	fake.downloadReleaseContextMutex.Lock()
+	ret, specificReturn := fake.downloadReleaseContextReturnsOnCall[len(fake.downloadReleaseContextArgsForCall)]
+	fake.downloadReleaseContextArgsForCall = append(fake.downloadReleaseContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 int
+		arg5 pivnet.DownloadReleaseConfig
+		arg6 io.Writer
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.DownloadReleaseContextStub
+	fakeReturns := fake.downloadReleaseContextReturns
+	fake.recordInvocation("DownloadReleaseContext", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.downloadReleaseContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductFilesAPI) DownloadReleaseContextCallCount() int {
+	fake.downloadReleaseContextMutex.RLock()
+	defer fake.downloadReleaseContextMutex.RUnlock()
+	return len(fake.downloadReleaseContextArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) DownloadReleaseContextCalls(stub func(context.Context, string, string, int, pivnet.DownloadReleaseConfig, io.Writer) (pivnet.DownloadReport, error)) {
+	fake.downloadReleaseContextMutex.Lock()
+	defer fake.downloadReleaseContextMutex.Unlock()
+	fake.DownloadReleaseContextStub = stub
+}
+
+func (fake *FakeProductFilesAPI) DownloadReleaseContextArgsForCall(i int) (context.Context, string, string, int, pivnet.DownloadReleaseConfig, io.Writer) {
+	fake.downloadReleaseContextMutex.RLock()
+	defer fake.downloadReleaseContextMutex.RUnlock()
+	argsForCall := fake.downloadReleaseContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeProductFilesAPI) DownloadReleaseContextReturns(result1 pivnet.DownloadReport, result2 error) {
+	fake.downloadReleaseContextMutex.Lock()
+	defer fake.downloadReleaseContextMutex.Unlock()
+	fake.DownloadReleaseContextStub = nil
+	fake.downloadReleaseContextReturns = struct {
+		result1 pivnet.DownloadReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) DownloadReleaseContextReturnsOnCall(i int, result1 pivnet.DownloadReport, result2 error) {
+	fake.downloadReleaseContextMutex.Lock()
+	defer fake.downloadReleaseContextMutex.Unlock()
+	fake.DownloadReleaseContextStub = nil
+	if fake.downloadReleaseContextReturnsOnCall == nil {
+		fake.downloadReleaseContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.DownloadReport
+			result2 error
+		})
+	}
+	fake.downloadReleaseContextReturnsOnCall[i] = struct {
+		result1 pivnet.DownloadReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) DownloadToWriter(arg1 io.Writer, arg2 string, arg3 int, arg4 int, arg5 io.Writer) error {
+	fake.downloadToWriterMutex.Lock()
+	ret, specificReturn := fake.downloadToWriterReturnsOnCall[len(fake.downloadToWriterArgsForCall)]
+	fake.downloadToWriterArgsForCall = append(fake.downloadToWriterArgsForCall, struct {
+		arg1 io.Writer
+		arg2 string
+		arg3 int
+		arg4 int
+		arg5 io.Writer
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.DownloadToWriterStub
+	fakeReturns := fake.downloadToWriterReturns
+	fake.recordInvocation("DownloadToWriter", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.downloadToWriterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeProductFilesAPI) DownloadToWriterCallCount() int {
+	fake.downloadToWriterMutex.RLock()
+	defer fake.downloadToWriterMutex.RUnlock()
+	return len(fake.downloadToWriterArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) DownloadToWriterCalls(stub func(io.Writer, string, int, int, io.Writer) error) {
+	fake.downloadToWriterMutex.Lock()
+	defer fake.downloadToWriterMutex.Unlock()
+	fake.DownloadToWriterStub = stub
+}
+
+func (fake *FakeProductFilesAPI) DownloadToWriterArgsForCall(i int) (io.Writer, string, int, int, io.Writer) {
+	fake.downloadToWriterMutex.RLock()
+	defer fake.downloadToWriterMutex.RUnlock()
+	argsForCall := fake.downloadToWriterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeProductFilesAPI) DownloadToWriterReturns(result1 error) {
+	fake.downloadToWriterMutex.Lock()
+	defer fake.downloadToWriterMutex.Unlock()
+	fake.DownloadToWriterStub = nil
+	fake.downloadToWriterReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) DownloadToWriterReturnsOnCall(i int, result1 error) {
+	fake.downloadToWriterMutex.Lock()
+	defer fake.downloadToWriterMutex.Unlock()
+	fake.DownloadToWriterStub = nil
+	if fake.downloadToWriterReturnsOnCall == nil {
+		fake.downloadToWriterReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.downloadToWriterReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) DownloadToWriterContext(arg1 context.Context, arg2 io.Writer, arg3 string, arg4 int, arg5 int, arg6 io.Writer) error {
+	fake.downloadToWriterContextMutex.Lock()
+	ret, specificReturn := fake.downloadToWriterContextReturnsOnCall[len(fake.downloadToWriterContextArgsForCall)]
+	fake.downloadToWriterContextArgsForCall = append(fake.downloadToWriterContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 io.Writer
+		arg3 string
+		arg4 int
+		arg5 int
+		arg6 io.Writer
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.DownloadToWriterContextStub
+	fakeReturns := fake.downloadToWriterContextReturns
+	fake.recordInvocation("DownloadToWriterContext", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.downloadToWriterContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeProductFilesAPI) DownloadToWriterContextCallCount() int {
+	fake.downloadToWriterContextMutex.RLock()
+	defer fake.downloadToWriterContextMutex.RUnlock()
+	return len(fake.downloadToWriterContextArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) DownloadToWriterContextCalls(stub func(context.Context, io.Writer, string, int, int, io.Writer) error) {
+	fake.downloadToWriterContextMutex.Lock()
+	defer fake.downloadToWriterContextMutex.Unlock()
+	fake.DownloadToWriterContextStub = stub
+}
+
+func (fake *FakeProductFilesAPI) DownloadToWriterContextArgsForCall(i int) (context.Context, io.Writer, string, int, int, io.Writer) {
+	fake.downloadToWriterContextMutex.RLock()
+	defer fake.downloadToWriterContextMutex.RUnlock()
+	argsForCall := fake.downloadToWriterContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeProductFilesAPI) DownloadToWriterContextReturns(result1 error) {
+	fake.downloadToWriterContextMutex.Lock()
+	defer fake.downloadToWriterContextMutex.Unlock()
+	fake.DownloadToWriterContextStub = nil
+	fake.downloadToWriterContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) DownloadToWriterContextReturnsOnCall(i int, result1 error) {
+	fake.downloadToWriterContextMutex.Lock()
+	defer fake.downloadToWriterContextMutex.Unlock()
+	fake.DownloadToWriterContextStub = nil
+	if fake.downloadToWriterContextReturnsOnCall == nil {
+		fake.downloadToWriterContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.downloadToWriterContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) Get(arg1 string, arg2 int) (pivnet.ProductFile, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1, arg2})
+	fake.getMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductFilesAPI) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) GetCalls(stub func(string, int) (pivnet.ProductFile, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeProductFilesAPI) GetArgsForCall(i int) (string, int) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeProductFilesAPI) GetReturns(result1 pivnet.ProductFile, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) GetReturnsOnCall(i int, result1 pivnet.ProductFile, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) GetContext(arg1 context.Context, arg2 string, arg3 int) (pivnet.ProductFile, error) {
+	fake.getContextMutex.Lock()
+	ret, specificReturn := fake.getContextReturnsOnCall[len(fake.getContextArgsForCall)]
+	fake.getContextArgsForCall = append(fake.getContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GetContextStub
+	fakeReturns := fake.getContextReturns
+	fake.recordInvocation("GetContext", []interface{}{arg1, arg2, arg3})
+	fake.getContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductFilesAPI) GetContextCallCount() int {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	return len(fake.getContextArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) GetContextCalls(stub func(context.Context, string, int) (pivnet.ProductFile, error)) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = stub
+}
+
+func (fake *FakeProductFilesAPI) GetContextArgsForCall(i int) (context.Context, string, int) {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	argsForCall := fake.getContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeProductFilesAPI) GetContextReturns(result1 pivnet.ProductFile, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	fake.getContextReturns = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) GetContextReturnsOnCall(i int, result1 pivnet.ProductFile, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	if fake.getContextReturnsOnCall == nil {
+		fake.getContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.getContextReturnsOnCall[i] = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) GetForRelease(arg1 string, arg2 int, arg3 int) (pivnet.ProductFile, error) {
+	fake.getForReleaseMutex.Lock()
+	ret, specificReturn := fake.getForReleaseReturnsOnCall[len(fake.getForReleaseArgsForCall)]
+	fake.getForReleaseArgsForCall = append(fake.getForReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GetForReleaseStub
+	fakeReturns := fake.getForReleaseReturns
+	fake.recordInvocation("GetForRelease", []interface{}{arg1, arg2, arg3})
+	fake.getForReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductFilesAPI) GetForReleaseCallCount() int {
+	fake.getForReleaseMutex.RLock()
+	defer fake.getForReleaseMutex.RUnlock()
+	return len(fake.getForReleaseArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) GetForReleaseCalls(stub func(string, int, int) (pivnet.ProductFile, error)) {
+	fake.getForReleaseMutex.Lock()
+	defer fake.getForReleaseMutex.Unlock()
+	fake.GetForReleaseStub = stub
+}
+
+func (fake *FakeProductFilesAPI) GetForReleaseArgsForCall(i int) (string, int, int) {
+	fake.getForReleaseMutex.RLock()
+	defer fake.getForReleaseMutex.RUnlock()
+	argsForCall := fake.getForReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeProductFilesAPI) GetForReleaseReturns(result1 pivnet.ProductFile, result2 error) {
+	fake.getForReleaseMutex.Lock()
+	defer fake.getForReleaseMutex.Unlock()
+	fake.GetForReleaseStub = nil
+	fake.getForReleaseReturns = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) GetForReleaseReturnsOnCall(i int, result1 pivnet.ProductFile, result2 error) {
+	fake.getForReleaseMutex.Lock()
+	defer fake.getForReleaseMutex.Unlock()
+	fake.GetForReleaseStub = nil
+	if fake.getForReleaseReturnsOnCall == nil {
+		fake.getForReleaseReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.getForReleaseReturnsOnCall[i] = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) GetForReleaseContext(arg1 context.Context, arg2 string, arg3 int, arg4 int) (pivnet.ProductFile, error) {
+	fake.getForReleaseContextMutex.Lock()
+	ret, specificReturn := fake.getForReleaseContextReturnsOnCall[len(fake.getForReleaseContextArgsForCall)]
+	fake.getForReleaseContextArgsForCall = append(fake.getForReleaseContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetForReleaseContextStub
+	fakeReturns := fake.getForReleaseContextReturns
+	fake.recordInvocation("GetForReleaseContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getForReleaseContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductFilesAPI) GetForReleaseContextCallCount() int {
+	fake.getForReleaseContextMutex.RLock()
+	defer fake.getForReleaseContextMutex.RUnlock()
+	return len(fake.getForReleaseContextArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) GetForReleaseContextCalls(stub func(context.Context, string, int, int) (pivnet.ProductFile, error)) {
+	fake.getForReleaseContextMutex.Lock()
+	defer fake.getForReleaseContextMutex.Unlock()
+	fake.GetForReleaseContextStub = stub
+}
+
+func (fake *FakeProductFilesAPI) GetForReleaseContextArgsForCall(i int) (context.Context, string, int, int) {
+	fake.getForReleaseContextMutex.RLock()
+	defer fake.getForReleaseContextMutex.RUnlock()
+	argsForCall := fake.getForReleaseContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeProductFilesAPI) GetForReleaseContextReturns(result1 pivnet.ProductFile, result2 error) {
+	fake.getForReleaseContextMutex.Lock()
+	defer fake.getForReleaseContextMutex.Unlock()
+	fake.GetForReleaseContextStub = nil
+	fake.getForReleaseContextReturns = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) GetForReleaseContextReturnsOnCall(i int, result1 pivnet.ProductFile, result2 error) {
+	fake.getForReleaseContextMutex.Lock()
+	defer fake.getForReleaseContextMutex.Unlock()
+	fake.GetForReleaseContextStub = nil
+	if fake.getForReleaseContextReturnsOnCall == nil {
+		fake.getForReleaseContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.getForReleaseContextReturnsOnCall[i] = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) Iterate(arg1 string, arg2 pivnet.ListOptions) *pivnet.ProductFileIterator {
+	fake.iterateMutex.Lock()
+	ret, specificReturn := fake.iterateReturnsOnCall[len(fake.iterateArgsForCall)]
+	fake.iterateArgsForCall = append(fake.iterateArgsForCall, struct {
+		arg1 string
+		arg2 pivnet.ListOptions
+	}{arg1, arg2})
+	stub := fake.IterateStub
+	fakeReturns := fake.iterateReturns
+	fake.recordInvocation("Iterate", []interface{}{arg1, arg2})
+	fake.iterateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeProductFilesAPI) IterateCallCount() int {
+	fake.iterateMutex.RLock()
+	defer fake.iterateMutex.RUnlock()
+	return len(fake.iterateArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) IterateCalls(stub func(string, pivnet.ListOptions) *pivnet.ProductFileIterator) {
+	fake.iterateMutex.Lock()
+	defer fake.iterateMutex.Unlock()
+	fake.IterateStub = stub
+}
+
+func (fake *FakeProductFilesAPI) IterateArgsForCall(i int) (string, pivnet.ListOptions) {
+	fake.iterateMutex.RLock()
+	defer fake.iterateMutex.RUnlock()
+	argsForCall := fake.iterateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeProductFilesAPI) IterateReturns(result1 *pivnet.ProductFileIterator) {
+	fake.iterateMutex.Lock()
+	defer fake.iterateMutex.Unlock()
+	fake.IterateStub = nil
+	fake.iterateReturns = struct {
+		result1 *pivnet.ProductFileIterator
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) IterateReturnsOnCall(i int, result1 *pivnet.ProductFileIterator) {
+	fake.iterateMutex.Lock()
+	defer fake.iterateMutex.Unlock()
+	fake.IterateStub = nil
+	if fake.iterateReturnsOnCall == nil {
+		fake.iterateReturnsOnCall = make(map[int]struct {
+			result1 *pivnet.ProductFileIterator
+		})
+	}
+	fake.iterateReturnsOnCall[i] = struct {
+		result1 *pivnet.ProductFileIterator
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) IterateContext(arg1 context.Context, arg2 string, arg3 pivnet.ListOptions) *pivnet.ProductFileIterator {
+	fake.iterateContextMutex.Lock()
+	ret, specificReturn := fake.iterateContextReturnsOnCall[len(fake.iterateContextArgsForCall)]
+	fake.iterateContextArgsForCall = append(fake.iterateContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 pivnet.ListOptions
+	}{arg1, arg2, arg3})
+	stub := fake.IterateContextStub
+	fakeReturns := fake.iterateContextReturns
+	fake.recordInvocation("IterateContext", []interface{}{arg1, arg2, arg3})
+	fake.iterateContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeProductFilesAPI) IterateContextCallCount() int {
+	fake.iterateContextMutex.RLock()
+	defer fake.iterateContextMutex.RUnlock()
+	return len(fake.iterateContextArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) IterateContextCalls(stub func(context.Context, string, pivnet.ListOptions) *pivnet.ProductFileIterator) {
+	fake.iterateContextMutex.Lock()
+	defer fake.iterateContextMutex.Unlock()
+	fake.IterateContextStub = stub
+}
+
+func (fake *FakeProductFilesAPI) IterateContextArgsForCall(i int) (context.Context, string, pivnet.ListOptions) {
+	fake.iterateContextMutex.RLock()
+	defer fake.iterateContextMutex.RUnlock()
+	argsForCall := fake.iterateContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeProductFilesAPI) IterateContextReturns(result1 *pivnet.ProductFileIterator) {
+	fake.iterateContextMutex.Lock()
+	defer fake.iterateContextMutex.Unlock()
+	fake.IterateContextStub = nil
+	fake.iterateContextReturns = struct {
+		result1 *pivnet.ProductFileIterator
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) IterateContextReturnsOnCall(i int, result1 *pivnet.ProductFileIterator) {
+	fake.iterateContextMutex.Lock()
+	defer fake.iterateContextMutex.Unlock()
+	fake.IterateContextStub = nil
+	if fake.iterateContextReturnsOnCall == nil {
+		fake.iterateContextReturnsOnCall = make(map[int]struct {
+			result1 *pivnet.ProductFileIterator
+		})
+	}
+	fake.iterateContextReturnsOnCall[i] = struct {
+		result1 *pivnet.ProductFileIterator
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) List(arg1 string) ([]pivnet.ProductFile, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{arg1})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductFilesAPI) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) ListCalls(stub func(string) ([]pivnet.ProductFile, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeProductFilesAPI) ListArgsForCall(i int) string {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	argsForCall := fake.listArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeProductFilesAPI) ListReturns(result1 []pivnet.ProductFile, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) ListReturnsOnCall(i int, result1 []pivnet.ProductFile, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) ListAll(arg1 string, arg2 pivnet.ListOptions) ([]pivnet.ProductFile, error) {
+	fake.listAllMutex.Lock()
+	ret, specificReturn := fake.listAllReturnsOnCall[len(fake.listAllArgsForCall)]
+	fake.listAllArgsForCall = append(fake.listAllArgsForCall, struct {
+		arg1 string
+		arg2 pivnet.ListOptions
+	}{arg1, arg2})
+	stub := fake.ListAllStub
+	fakeReturns := fake.listAllReturns
+	fake.recordInvocation("ListAll", []interface{}{arg1, arg2})
+	fake.listAllMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductFilesAPI) ListAllCallCount() int {
+	fake.listAllMutex.RLock()
+	defer fake.listAllMutex.RUnlock()
+	return len(fake.listAllArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) ListAllCalls(stub func(string, pivnet.ListOptions) ([]pivnet.ProductFile, error)) {
+	fake.listAllMutex.Lock()
+	defer fake.listAllMutex.Unlock()
+	fake.ListAllStub = stub
+}
+
+func (fake *FakeProductFilesAPI) ListAllArgsForCall(i int) (string, pivnet.ListOptions) {
+	fake.listAllMutex.RLock()
+	defer fake.listAllMutex.RUnlock()
+	argsForCall := fake.listAllArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeProductFilesAPI) ListAllReturns(result1 []pivnet.ProductFile, result2 error) {
+	fake.listAllMutex.Lock()
+	defer fake.listAllMutex.Unlock()
+	fake.ListAllStub = nil
+	fake.listAllReturns = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) ListAllReturnsOnCall(i int, result1 []pivnet.ProductFile, result2 error) {
+	fake.listAllMutex.Lock()
+	defer fake.listAllMutex.Unlock()
+	fake.ListAllStub = nil
+	if fake.listAllReturnsOnCall == nil {
+		fake.listAllReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.listAllReturnsOnCall[i] = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) ListAllContext(arg1 context.Context, arg2 string, arg3 pivnet.ListOptions) ([]pivnet.ProductFile, error) {
+	fake.listAllContextMutex.Lock()
+	ret, specificReturn := fake.listAllContextReturnsOnCall[len(fake.listAllContextArgsForCall)]
+	fake.listAllContextArgsForCall = append(fake.listAllContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 pivnet.ListOptions
+	}{arg1, arg2, arg3})
+	stub := fake.ListAllContextStub
+	fakeReturns := fake.listAllContextReturns
+	fake.recordInvocation("ListAllContext", []interface{}{arg1, arg2, arg3})
+	fake.listAllContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductFilesAPI) ListAllContextCallCount() int {
+	fake.listAllContextMutex.RLock()
+	defer fake.listAllContextMutex.RUnlock()
+	return len(fake.listAllContextArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) ListAllContextCalls(stub func(context.Context, string, pivnet.ListOptions) ([]pivnet.ProductFile, error)) {
+	fake.listAllContextMutex.Lock()
+	defer fake.listAllContextMutex.Unlock()
+	fake.ListAllContextStub = stub
+}
+
+func (fake *FakeProductFilesAPI) ListAllContextArgsForCall(i int) (context.Context, string, pivnet.ListOptions) {
+	fake.listAllContextMutex.RLock()
+	defer fake.listAllContextMutex.RUnlock()
+	argsForCall := fake.listAllContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeProductFilesAPI) ListAllContextReturns(result1 []pivnet.ProductFile, result2 error) {
+	fake.listAllContextMutex.Lock()
+	defer fake.listAllContextMutex.Unlock()
+	fake.ListAllContextStub = nil
+	fake.listAllContextReturns = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) ListAllContextReturnsOnCall(i int, result1 []pivnet.ProductFile, result2 error) {
+	fake.listAllContextMutex.Lock()
+	defer fake.listAllContextMutex.Unlock()
+	fake.ListAllContextStub = nil
+	if fake.listAllContextReturnsOnCall == nil {
+		fake.listAllContextReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.listAllContextReturnsOnCall[i] = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) ListContext(arg1 context.Context, arg2 string) ([]pivnet.ProductFile, error) {
+	fake.listContextMutex.Lock()
+	ret, specificReturn := fake.listContextReturnsOnCall[len(fake.listContextArgsForCall)]
+	fake.listContextArgsForCall = append(fake.listContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ListContextStub
+	fakeReturns := fake.listContextReturns
+	fake.recordInvocation("ListContext", []interface{}{arg1, arg2})
+	fake.listContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductFilesAPI) ListContextCallCount() int {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	return len(fake.listContextArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) ListContextCalls(stub func(context.Context, string) ([]pivnet.ProductFile, error)) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = stub
+}
+
+func (fake *FakeProductFilesAPI) ListContextArgsForCall(i int) (context.Context, string) {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	argsForCall := fake.listContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeProductFilesAPI) ListContextReturns(result1 []pivnet.ProductFile, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	fake.listContextReturns = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) ListContextReturnsOnCall(i int, result1 []pivnet.ProductFile, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	if fake.listContextReturnsOnCall == nil {
+		fake.listContextReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.listContextReturnsOnCall[i] = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) ListForRelease(arg1 string, arg2 int) ([]pivnet.ProductFile, error) {
+	fake.listForReleaseMutex.Lock()
+	ret, specificReturn := fake.listForReleaseReturnsOnCall[len(fake.listForReleaseArgsForCall)]
+	fake.listForReleaseArgsForCall = append(fake.listForReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ListForReleaseStub
+	fakeReturns := fake.listForReleaseReturns
+	fake.recordInvocation("ListForRelease", []interface{}{arg1, arg2})
+	fake.listForReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductFilesAPI) ListForReleaseCallCount() int {
+	fake.listForReleaseMutex.RLock()
+	defer fake.listForReleaseMutex.RUnlock()
+	return len(fake.listForReleaseArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) ListForReleaseCalls(stub func(string, int) ([]pivnet.ProductFile, error)) {
+	fake.listForReleaseMutex.Lock()
+	defer fake.listForReleaseMutex.Unlock()
+	fake.ListForReleaseStub = stub
+}
+
+func (fake *FakeProductFilesAPI) ListForReleaseArgsForCall(i int) (string, int) {
+	fake.listForReleaseMutex.RLock()
+	defer fake.listForReleaseMutex.RUnlock()
+	argsForCall := fake.listForReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeProductFilesAPI) ListForReleaseReturns(result1 []pivnet.ProductFile, result2 error) {
+	fake.listForReleaseMutex.Lock()
+	defer fake.listForReleaseMutex.Unlock()
+	fake.ListForReleaseStub = nil
+	fake.listForReleaseReturns = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) ListForReleaseReturnsOnCall(i int, result1 []pivnet.ProductFile, result2 error) {
+	fake.listForReleaseMutex.Lock()
+	defer fake.listForReleaseMutex.Unlock()
+	fake.ListForReleaseStub = nil
+	if fake.listForReleaseReturnsOnCall == nil {
+		fake.listForReleaseReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.listForReleaseReturnsOnCall[i] = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) ListForReleaseContext(arg1 context.Context, arg2 string, arg3 int) ([]pivnet.ProductFile, error) {
+	fake.listForReleaseContextMutex.Lock()
+	ret, specificReturn := fake.listForReleaseContextReturnsOnCall[len(fake.listForReleaseContextArgsForCall)]
+	fake.listForReleaseContextArgsForCall = append(fake.listForReleaseContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.ListForReleaseContextStub
+	fakeReturns := fake.listForReleaseContextReturns
+	fake.recordInvocation("ListForReleaseContext", []interface{}{arg1, arg2, arg3})
+	fake.listForReleaseContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductFilesAPI) ListForReleaseContextCallCount() int {
+	fake.listForReleaseContextMutex.RLock()
+	defer fake.listForReleaseContextMutex.RUnlock()
+	return len(fake.listForReleaseContextArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) ListForReleaseContextCalls(stub func(context.Context, string, int) ([]pivnet.ProductFile, error)) {
+	fake.listForReleaseContextMutex.Lock()
+	defer fake.listForReleaseContextMutex.Unlock()
+	fake.ListForReleaseContextStub = stub
+}
+
+func (fake *FakeProductFilesAPI) ListForReleaseContextArgsForCall(i int) (context.Context, string, int) {
+	fake.listForReleaseContextMutex.RLock()
+	defer fake.listForReleaseContextMutex.RUnlock()
+	argsForCall := fake.listForReleaseContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeProductFilesAPI) ListForReleaseContextReturns(result1 []pivnet.ProductFile, result2 error) {
+	fake.listForReleaseContextMutex.Lock()
+	defer fake.listForReleaseContextMutex.Unlock()
+	fake.ListForReleaseContextStub = nil
+	fake.listForReleaseContextReturns = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) ListForReleaseContextReturnsOnCall(i int, result1 []pivnet.ProductFile, result2 error) {
+	fake.listForReleaseContextMutex.Lock()
+	defer fake.listForReleaseContextMutex.Unlock()
+	fake.ListForReleaseContextStub = nil
+	if fake.listForReleaseContextReturnsOnCall == nil {
+		fake.listForReleaseContextReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.listForReleaseContextReturnsOnCall[i] = struct {
+		result1 []pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromFileGroup(arg1 string, arg2 int, arg3 int) error {
+	fake.removeFromFileGroupMutex.Lock()
+	ret, specificReturn := fake.removeFromFileGroupReturnsOnCall[len(fake.removeFromFileGroupArgsForCall)]
+	fake.removeFromFileGroupArgsForCall = append(fake.removeFromFileGroupArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveFromFileGroupStub
+	fakeReturns := fake.removeFromFileGroupReturns
+	fake.recordInvocation("RemoveFromFileGroup", []interface{}{arg1, arg2, arg3})
+	fake.removeFromFileGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromFileGroupCallCount() int {
+	fake.removeFromFileGroupMutex.RLock()
+	defer fake.removeFromFileGroupMutex.RUnlock()
+	return len(fake.removeFromFileGroupArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromFileGroupCalls(stub func(string, int, int) error) {
+	fake.removeFromFileGroupMutex.Lock()
+	defer fake.removeFromFileGroupMutex.Unlock()
+	fake.RemoveFromFileGroupStub = stub
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromFileGroupArgsForCall(i int) (string, int, int) {
+	fake.removeFromFileGroupMutex.RLock()
+	defer fake.removeFromFileGroupMutex.RUnlock()
+	argsForCall := fake.removeFromFileGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromFileGroupReturns(result1 error) {
+	fake.removeFromFileGroupMutex.Lock()
+	defer fake.removeFromFileGroupMutex.Unlock()
+	fake.RemoveFromFileGroupStub = nil
+	fake.removeFromFileGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromFileGroupReturnsOnCall(i int, result1 error) {
+	fake.removeFromFileGroupMutex.Lock()
+	defer fake.removeFromFileGroupMutex.Unlock()
+	fake.RemoveFromFileGroupStub = nil
+	if fake.removeFromFileGroupReturnsOnCall == nil {
+		fake.removeFromFileGroupReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeFromFileGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromFileGroupContext(arg1 context.Context, arg2 string, arg3 int, arg4 int) error {
+	fake.removeFromFileGroupContextMutex.Lock()
+	ret, specificReturn := fake.removeFromFileGroupContextReturnsOnCall[len(fake.removeFromFileGroupContextArgsForCall)]
+	fake.removeFromFileGroupContextArgsForCall = append(fake.removeFromFileGroupContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RemoveFromFileGroupContextStub
+	fakeReturns := fake.removeFromFileGroupContextReturns
+	fake.recordInvocation("RemoveFromFileGroupContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.removeFromFileGroupContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromFileGroupContextCallCount() int {
+	fake.removeFromFileGroupContextMutex.RLock()
+	defer fake.removeFromFileGroupContextMutex.RUnlock()
+	return len(fake.removeFromFileGroupContextArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromFileGroupContextCalls(stub func(context.Context, string, int, int) error) {
+	fake.removeFromFileGroupContextMutex.Lock()
+	defer fake.removeFromFileGroupContextMutex.Unlock()
+	fake.RemoveFromFileGroupContextStub = stub
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromFileGroupContextArgsForCall(i int) (context.Context, string, int, int) {
+	fake.removeFromFileGroupContextMutex.RLock()
+	defer fake.removeFromFileGroupContextMutex.RUnlock()
+	argsForCall := fake.removeFromFileGroupContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromFileGroupContextReturns(result1 error) {
+	fake.removeFromFileGroupContextMutex.Lock()
+	defer fake.removeFromFileGroupContextMutex.Unlock()
+	fake.RemoveFromFileGroupContextStub = nil
+	fake.removeFromFileGroupContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromFileGroupContextReturnsOnCall(i int, result1 error) {
+	fake.removeFromFileGroupContextMutex.Lock()
+	defer fake.removeFromFileGroupContextMutex.Unlock()
+	fake.RemoveFromFileGroupContextStub = nil
+	if fake.removeFromFileGroupContextReturnsOnCall == nil {
+		fake.removeFromFileGroupContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeFromFileGroupContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromRelease(arg1 string, arg2 int, arg3 int) error {
+	fake.removeFromReleaseMutex.Lock()
+	ret, specificReturn := fake.removeFromReleaseReturnsOnCall[len(fake.removeFromReleaseArgsForCall)]
+	fake.removeFromReleaseArgsForCall = append(fake.removeFromReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveFromReleaseStub
+	fakeReturns := fake.removeFromReleaseReturns
+	fake.recordInvocation("RemoveFromRelease", []interface{}{arg1, arg2, arg3})
+	fake.removeFromReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromReleaseCallCount() int {
+	fake.removeFromReleaseMutex.RLock()
+	defer fake.removeFromReleaseMutex.RUnlock()
+	return len(fake.removeFromReleaseArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromReleaseCalls(stub func(string, int, int) error) {
+	fake.removeFromReleaseMutex.Lock()
+	defer fake.removeFromReleaseMutex.Unlock()
+	fake.RemoveFromReleaseStub = stub
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromReleaseArgsForCall(i int) (string, int, int) {
+	fake.removeFromReleaseMutex.RLock()
+	defer fake.removeFromReleaseMutex.RUnlock()
+	argsForCall := fake.removeFromReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromReleaseReturns(result1 error) {
+	fake.removeFromReleaseMutex.Lock()
+	defer fake.removeFromReleaseMutex.Unlock()
+	fake.RemoveFromReleaseStub = nil
+	fake.removeFromReleaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromReleaseReturnsOnCall(i int, result1 error) {
+	fake.removeFromReleaseMutex.Lock()
+	defer fake.removeFromReleaseMutex.Unlock()
+	fake.RemoveFromReleaseStub = nil
+	if fake.removeFromReleaseReturnsOnCall == nil {
+		fake.removeFromReleaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeFromReleaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromReleaseContext(arg1 context.Context, arg2 string, arg3 int, arg4 int) error {
+	fake.removeFromReleaseContextMutex.Lock()
+	ret, specificReturn := fake.removeFromReleaseContextReturnsOnCall[len(fake.removeFromReleaseContextArgsForCall)]
+	fake.removeFromReleaseContextArgsForCall = append(fake.removeFromReleaseContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RemoveFromReleaseContextStub
+	fakeReturns := fake.removeFromReleaseContextReturns
+	fake.recordInvocation("RemoveFromReleaseContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.removeFromReleaseContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromReleaseContextCallCount() int {
+	fake.removeFromReleaseContextMutex.RLock()
+	defer fake.removeFromReleaseContextMutex.RUnlock()
+	return len(fake.removeFromReleaseContextArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromReleaseContextCalls(stub func(context.Context, string, int, int) error) {
+	fake.removeFromReleaseContextMutex.Lock()
+	defer fake.removeFromReleaseContextMutex.Unlock()
+	fake.RemoveFromReleaseContextStub = stub
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromReleaseContextArgsForCall(i int) (context.Context, string, int, int) {
+	fake.removeFromReleaseContextMutex.RLock()
+	defer fake.removeFromReleaseContextMutex.RUnlock()
+	argsForCall := fake.removeFromReleaseContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromReleaseContextReturns(result1 error) {
+	fake.removeFromReleaseContextMutex.Lock()
+	defer fake.removeFromReleaseContextMutex.Unlock()
+	fake.RemoveFromReleaseContextStub = nil
+	fake.removeFromReleaseContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) RemoveFromReleaseContextReturnsOnCall(i int, result1 error) {
+	fake.removeFromReleaseContextMutex.Lock()
+	defer fake.removeFromReleaseContextMutex.Unlock()
+	fake.RemoveFromReleaseContextStub = nil
+	if fake.removeFromReleaseContextReturnsOnCall == nil {
+		fake.removeFromReleaseContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeFromReleaseContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProductFilesAPI) Update(arg1 string, arg2 pivnet.ProductFile) (pivnet.ProductFile, error) {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 string
+		arg2 pivnet.ProductFile
+	}{arg1, arg2})
+	stub := fake.UpdateStub
+	fakeReturns := fake.updateReturns
+	fake.recordInvocation("Update", []interface{}{arg1, arg2})
+	fake.updateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductFilesAPI) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) UpdateCalls(stub func(string, pivnet.ProductFile) (pivnet.ProductFile, error)) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = stub
+}
+
+func (fake *FakeProductFilesAPI) UpdateArgsForCall(i int) (string, pivnet.ProductFile) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	argsForCall := fake.updateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeProductFilesAPI) UpdateReturns(result1 pivnet.ProductFile, result2 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) UpdateReturnsOnCall(i int, result1 pivnet.ProductFile, result2 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) UpdateContext(arg1 context.Context, arg2 string, arg3 pivnet.ProductFile) (pivnet.ProductFile, error) {
+	fake.updateContextMutex.Lock()
+	ret, specificReturn := fake.updateContextReturnsOnCall[len(fake.updateContextArgsForCall)]
+	fake.updateContextArgsForCall = append(fake.updateContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 pivnet.ProductFile
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateContextStub
+	fakeReturns := fake.updateContextReturns
+	fake.recordInvocation("UpdateContext", []interface{}{arg1, arg2, arg3})
+	fake.updateContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductFilesAPI) UpdateContextCallCount() int {
+	fake.updateContextMutex.RLock()
+	defer fake.updateContextMutex.RUnlock()
+	return len(fake.updateContextArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) UpdateContextCalls(stub func(context.Context, string, pivnet.ProductFile) (pivnet.ProductFile, error)) {
+	fake.updateContextMutex.Lock()
+	defer fake.updateContextMutex.Unlock()
+	fake.UpdateContextStub = stub
+}
+
+func (fake *FakeProductFilesAPI) UpdateContextArgsForCall(i int) (context.Context, string, pivnet.ProductFile) {
+	fake.updateContextMutex.RLock()
+	defer fake.updateContextMutex.RUnlock()
+	argsForCall := fake.updateContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeProductFilesAPI) UpdateContextReturns(result1 pivnet.ProductFile, result2 error) {
+	fake.updateContextMutex.Lock()
+	defer fake.updateContextMutex.Unlock()
+	fake.UpdateContextStub = nil
+	fake.updateContextReturns = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) UpdateContextReturnsOnCall(i int, result1 pivnet.ProductFile, result2 error) {
+	fake.updateContextMutex.Lock()
+	defer fake.updateContextMutex.Unlock()
+	fake.UpdateContextStub = nil
+	if fake.updateContextReturnsOnCall == nil {
+		fake.updateContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.updateContextReturnsOnCall[i] = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) Upload(arg1 pivnet.UploadProductFileConfig) (pivnet.ProductFile, error) {
+	fake.uploadMutex.Lock()
+	ret, specificReturn := fake.uploadReturnsOnCall[len(fake.uploadArgsForCall)]
+	fake.uploadArgsForCall = append(fake.uploadArgsForCall, struct {
+		arg1 pivnet.UploadProductFileConfig
+	}{arg1})
+	stub := fake.UploadStub
+	fakeReturns := fake.uploadReturns
+	fake.recordInvocation("Upload", []interface{}{arg1})
+	fake.uploadMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductFilesAPI) UploadCallCount() int {
+	fake.uploadMutex.RLock()
+	defer fake.uploadMutex.RUnlock()
+	return len(fake.uploadArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) UploadCalls(stub func(pivnet.UploadProductFileConfig) (pivnet.ProductFile, error)) {
+	fake.uploadMutex.Lock()
+	defer fake.uploadMutex.Unlock()
+	fake.UploadStub = stub
+}
+
+func (fake *FakeProductFilesAPI) UploadArgsForCall(i int) pivnet.UploadProductFileConfig {
+	fake.uploadMutex.RLock()
+	defer fake.uploadMutex.RUnlock()
+	argsForCall := fake.uploadArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeProductFilesAPI) UploadReturns(result1 pivnet.ProductFile, result2 error) {
+	fake.uploadMutex.Lock()
+	defer fake.uploadMutex.Unlock()
+	fake.UploadStub = nil
+	fake.uploadReturns = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) UploadReturnsOnCall(i int, result1 pivnet.ProductFile, result2 error) {
+	fake.uploadMutex.Lock()
+	defer fake.uploadMutex.Unlock()
+	fake.UploadStub = nil
+	if fake.uploadReturnsOnCall == nil {
+		fake.uploadReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.uploadReturnsOnCall[i] = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) UploadContext(arg1 context.Context, arg2 pivnet.UploadProductFileConfig) (pivnet.ProductFile, error) {
+	fake.uploadContextMutex.Lock()
+	ret, specificReturn := fake.uploadContextReturnsOnCall[len(fake.uploadContextArgsForCall)]
+	fake.uploadContextArgsForCall = append(fake.uploadContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 pivnet.UploadProductFileConfig
+	}{arg1, arg2})
+	stub := fake.UploadContextStub
+	fakeReturns := fake.uploadContextReturns
+	fake.recordInvocation("UploadContext", []interface{}{arg1, arg2})
+	fake.uploadContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductFilesAPI) UploadContextCallCount() int {
+	fake.uploadContextMutex.RLock()
+	defer fake.uploadContextMutex.RUnlock()
+	return len(fake.uploadContextArgsForCall)
+}
+
+func (fake *FakeProductFilesAPI) UploadContextCalls(stub func(context.Context, pivnet.UploadProductFileConfig) (pivnet.ProductFile, error)) {
+	fake.uploadContextMutex.Lock()
+	defer fake.uploadContextMutex.Unlock()
+	fake.UploadContextStub = stub
+}
+
+func (fake *FakeProductFilesAPI) UploadContextArgsForCall(i int) (context.Context, pivnet.UploadProductFileConfig) {
+	fake.uploadContextMutex.RLock()
+	defer fake.uploadContextMutex.RUnlock()
+	argsForCall := fake.uploadContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeProductFilesAPI) UploadContextReturns(result1 pivnet.ProductFile, result2 error) {
+	fake.uploadContextMutex.Lock()
+	defer fake.uploadContextMutex.Unlock()
+	fake.UploadContextStub = nil
+	fake.uploadContextReturns = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) UploadContextReturnsOnCall(i int, result1 pivnet.ProductFile, result2 error) {
+	fake.uploadContextMutex.Lock()
+	defer fake.uploadContextMutex.Unlock()
+	fake.UploadContextStub = nil
+	if fake.uploadContextReturnsOnCall == nil {
+		fake.uploadContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.ProductFile
+			result2 error
+		})
+	}
+	fake.uploadContextReturnsOnCall[i] = struct {
+		result1 pivnet.ProductFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductFilesAPI) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.addToFileGroupMutex.RLock()
+	defer fake.addToFileGroupMutex.RUnlock()
+	fake.addToFileGroupContextMutex.RLock()
+	defer fake.addToFileGroupContextMutex.RUnlock()
+	fake.addToReleaseMutex.RLock()
+	defer fake.addToReleaseMutex.RUnlock()
+	fake.addToReleaseContextMutex.RLock()
+	defer fake.addToReleaseContextMutex.RUnlock()
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	fake.downloadForReleaseMutex.RLock()
+	defer fake.downloadForReleaseMutex.RUnlock()
+	fake.downloadForReleaseContextMutex.RLock()
+	defer fake.downloadForReleaseContextMutex.RUnlock()
+	fake.downloadReleaseMutex.RLock()
+	defer fake.downloadReleaseMutex.RUnlock()
+	fake.downloadReleaseContextMutex.RLock()
+	defer fake.downloadReleaseContextMutex.RUnlock()
+	fake.downloadToWriterMutex.RLock()
+	defer fake.downloadToWriterMutex.RUnlock()
+	fake.downloadToWriterContextMutex.RLock()
+	defer fake.downloadToWriterContextMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	fake.getForReleaseMutex.RLock()
+	defer fake.getForReleaseMutex.RUnlock()
+	fake.getForReleaseContextMutex.RLock()
+	defer fake.getForReleaseContextMutex.RUnlock()
+	fake.iterateMutex.RLock()
+	defer fake.iterateMutex.RUnlock()
+	fake.iterateContextMutex.RLock()
+	defer fake.iterateContextMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.listAllMutex.RLock()
+	defer fake.listAllMutex.RUnlock()
+	fake.listAllContextMutex.RLock()
+	defer fake.listAllContextMutex.RUnlock()
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	fake.listForReleaseMutex.RLock()
+	defer fake.listForReleaseMutex.RUnlock()
+	fake.listForReleaseContextMutex.RLock()
+	defer fake.listForReleaseContextMutex.RUnlock()
+	fake.removeFromFileGroupMutex.RLock()
+	defer fake.removeFromFileGroupMutex.RUnlock()
+	fake.removeFromFileGroupContextMutex.RLock()
+	defer fake.removeFromFileGroupContextMutex.RUnlock()
+	fake.removeFromReleaseMutex.RLock()
+	defer fake.removeFromReleaseMutex.RUnlock()
+	fake.removeFromReleaseContextMutex.RLock()
+	defer fake.removeFromReleaseContextMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	fake.updateContextMutex.RLock()
+	defer fake.updateContextMutex.RUnlock()
+	fake.uploadMutex.RLock()
+	defer fake.uploadMutex.RUnlock()
+	fake.uploadContextMutex.RLock()
+	defer fake.uploadContextMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeProductFilesAPI) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pivnet.ProductFilesAPI = new(FakeProductFilesAPI)
@@ -0,0 +1,507 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package gopivnetfakes
+
+import (
+	"context"
+	"sync"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v9"
+)
+
+type FakeProductsAPI struct {
+	GetStub        func(string) (pivnet.Product, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+	}
+	getReturns struct {
+		result1 pivnet.Product
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 pivnet.Product
+		result2 error
+	}
+	GetContextStub        func(context.Context, string) (pivnet.Product, error)
+	getContextMutex       sync.RWMutex
+	getContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getContextReturns struct {
+		result1 pivnet.Product
+		result2 error
+	}
+	getContextReturnsOnCall map[int]struct {
+		result1 pivnet.Product
+		result2 error
+	}
+	ListStub        func() ([]pivnet.Product, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+	}
+	listReturns struct {
+		result1 []pivnet.Product
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []pivnet.Product
+		result2 error
+	}
+	ListContextStub        func(context.Context) ([]pivnet.Product, error)
+	listContextMutex       sync.RWMutex
+	listContextArgsForCall []struct {
+		arg1 context.Context
+	}
+	listContextReturns struct {
+		result1 []pivnet.Product
+		result2 error
+	}
+	listContextReturnsOnCall map[int]struct {
+		result1 []pivnet.Product
+		result2 error
+	}
+	SlugAliasStub        func(string) (pivnet.SlugAliasResponse, error)
+	slugAliasMutex       sync.RWMutex
+	slugAliasArgsForCall []struct {
+		arg1 string
+	}
+	slugAliasReturns struct {
+		result1 pivnet.SlugAliasResponse
+		result2 error
+	}
+	slugAliasReturnsOnCall map[int]struct {
+		result1 pivnet.SlugAliasResponse
+		result2 error
+	}
+	SlugAliasContextStub        func(context.Context, string) (pivnet.SlugAliasResponse, error)
+	slugAliasContextMutex       sync.RWMutex
+	slugAliasContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	slugAliasContextReturns struct {
+		result1 pivnet.SlugAliasResponse
+		result2 error
+	}
+	slugAliasContextReturnsOnCall map[int]struct {
+		result1 pivnet.SlugAliasResponse
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeProductsAPI) Get(arg1 string) (pivnet.Product, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1})
+	fake.getMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductsAPI) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeProductsAPI) GetCalls(stub func(string) (pivnet.Product, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeProductsAPI) GetArgsForCall(i int) string {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeProductsAPI) GetReturns(result1 pivnet.Product, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 pivnet.Product
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductsAPI) GetReturnsOnCall(i int, result1 pivnet.Product, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Product
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 pivnet.Product
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductsAPI) GetContext(arg1 context.Context, arg2 string) (pivnet.Product, error) {
+	fake.getContextMutex.Lock()
+	ret, specificReturn := fake.getContextReturnsOnCall[len(fake.getContextArgsForCall)]
+	fake.getContextArgsForCall = append(fake.getContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetContextStub
+	fakeReturns := fake.getContextReturns
+	fake.recordInvocation("GetContext", []interface{}{arg1, arg2})
+	fake.getContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductsAPI) GetContextCallCount() int {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	return len(fake.getContextArgsForCall)
+}
+
+func (fake *FakeProductsAPI) GetContextCalls(stub func(context.Context, string) (pivnet.Product, error)) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = stub
+}
+
+func (fake *FakeProductsAPI) GetContextArgsForCall(i int) (context.Context, string) {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	argsForCall := fake.getContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeProductsAPI) GetContextReturns(result1 pivnet.Product, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	fake.getContextReturns = struct {
+		result1 pivnet.Product
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductsAPI) GetContextReturnsOnCall(i int, result1 pivnet.Product, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	if fake.getContextReturnsOnCall == nil {
+		fake.getContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Product
+			result2 error
+		})
+	}
+	fake.getContextReturnsOnCall[i] = struct {
+		result1 pivnet.Product
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductsAPI) List() ([]pivnet.Product, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+	}{})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductsAPI) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeProductsAPI) ListCalls(stub func() ([]pivnet.Product, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeProductsAPI) ListReturns(result1 []pivnet.Product, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []pivnet.Product
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductsAPI) ListReturnsOnCall(i int, result1 []pivnet.Product, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.Product
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []pivnet.Product
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductsAPI) ListContext(arg1 context.Context) ([]pivnet.Product, error) {
+	fake.listContextMutex.Lock()
+	ret, specificReturn := fake.listContextReturnsOnCall[len(fake.listContextArgsForCall)]
+	fake.listContextArgsForCall = append(fake.listContextArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListContextStub
+	fakeReturns := fake.listContextReturns
+	fake.recordInvocation("ListContext", []interface{}{arg1})
+	fake.listContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductsAPI) ListContextCallCount() int {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	return len(fake.listContextArgsForCall)
+}
+
+func (fake *FakeProductsAPI) ListContextCalls(stub func(context.Context) ([]pivnet.Product, error)) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = stub
+}
+
+func (fake *FakeProductsAPI) ListContextArgsForCall(i int) context.Context {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	argsForCall := fake.listContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeProductsAPI) ListContextReturns(result1 []pivnet.Product, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	fake.listContextReturns = struct {
+		result1 []pivnet.Product
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductsAPI) ListContextReturnsOnCall(i int, result1 []pivnet.Product, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	if fake.listContextReturnsOnCall == nil {
+		fake.listContextReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.Product
+			result2 error
+		})
+	}
+	fake.listContextReturnsOnCall[i] = struct {
+		result1 []pivnet.Product
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductsAPI) SlugAlias(arg1 string) (pivnet.SlugAliasResponse, error) {
+	fake.slugAliasMutex.Lock()
+	ret, specificReturn := fake.slugAliasReturnsOnCall[len(fake.slugAliasArgsForCall)]
+	fake.slugAliasArgsForCall = append(fake.slugAliasArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SlugAliasStub
+	fakeReturns := fake.slugAliasReturns
+	fake.recordInvocation("SlugAlias", []interface{}{arg1})
+	fake.slugAliasMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductsAPI) SlugAliasCallCount() int {
+	fake.slugAliasMutex.RLock()
+	defer fake.slugAliasMutex.RUnlock()
+	return len(fake.slugAliasArgsForCall)
+}
+
+func (fake *FakeProductsAPI) SlugAliasCalls(stub func(string) (pivnet.SlugAliasResponse, error)) {
+	fake.slugAliasMutex.Lock()
+	defer fake.slugAliasMutex.Unlock()
+	fake.SlugAliasStub = stub
+}
+
+func (fake *FakeProductsAPI) SlugAliasArgsForCall(i int) string {
+	fake.slugAliasMutex.RLock()
+	defer fake.slugAliasMutex.RUnlock()
+	argsForCall := fake.slugAliasArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeProductsAPI) SlugAliasReturns(result1 pivnet.SlugAliasResponse, result2 error) {
+	fake.slugAliasMutex.Lock()
+	defer fake.slugAliasMutex.Unlock()
+	fake.SlugAliasStub = nil
+	fake.slugAliasReturns = struct {
+		result1 pivnet.SlugAliasResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductsAPI) SlugAliasReturnsOnCall(i int, result1 pivnet.SlugAliasResponse, result2 error) {
+	fake.slugAliasMutex.Lock()
+	defer fake.slugAliasMutex.Unlock()
+	fake.SlugAliasStub = nil
+	if fake.slugAliasReturnsOnCall == nil {
+		fake.slugAliasReturnsOnCall = make(map[int]struct {
+			result1 pivnet.SlugAliasResponse
+			result2 error
+		})
+	}
+	fake.slugAliasReturnsOnCall[i] = struct {
+		result1 pivnet.SlugAliasResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductsAPI) SlugAliasContext(arg1 context.Context, arg2 string) (pivnet.SlugAliasResponse, error) {
+	fake.slugAliasContextMutex.Lock()
+	ret, specificReturn := fake.slugAliasContextReturnsOnCall[len(fake.slugAliasContextArgsForCall)]
+	fake.slugAliasContextArgsForCall = append(fake.slugAliasContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.SlugAliasContextStub
+	fakeReturns := fake.slugAliasContextReturns
+	fake.recordInvocation("SlugAliasContext", []interface{}{arg1, arg2})
+	fake.slugAliasContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProductsAPI) SlugAliasContextCallCount() int {
+	fake.slugAliasContextMutex.RLock()
+	defer fake.slugAliasContextMutex.RUnlock()
+	return len(fake.slugAliasContextArgsForCall)
+}
+
+func (fake *FakeProductsAPI) SlugAliasContextCalls(stub func(context.Context, string) (pivnet.SlugAliasResponse, error)) {
+	fake.slugAliasContextMutex.Lock()
+	defer fake.slugAliasContextMutex.Unlock()
+	fake.SlugAliasContextStub = stub
+}
+
+func (fake *FakeProductsAPI) SlugAliasContextArgsForCall(i int) (context.Context, string) {
+	fake.slugAliasContextMutex.RLock()
+	defer fake.slugAliasContextMutex.RUnlock()
+	argsForCall := fake.slugAliasContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeProductsAPI) SlugAliasContextReturns(result1 pivnet.SlugAliasResponse, result2 error) {
+	fake.slugAliasContextMutex.Lock()
+	defer fake.slugAliasContextMutex.Unlock()
+	fake.SlugAliasContextStub = nil
+	fake.slugAliasContextReturns = struct {
+		result1 pivnet.SlugAliasResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductsAPI) SlugAliasContextReturnsOnCall(i int, result1 pivnet.SlugAliasResponse, result2 error) {
+	fake.slugAliasContextMutex.Lock()
+	defer fake.slugAliasContextMutex.Unlock()
+	fake.SlugAliasContextStub = nil
+	if fake.slugAliasContextReturnsOnCall == nil {
+		fake.slugAliasContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.SlugAliasResponse
+			result2 error
+		})
+	}
+	fake.slugAliasContextReturnsOnCall[i] = struct {
+		result1 pivnet.SlugAliasResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProductsAPI) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	fake.slugAliasMutex.RLock()
+	defer fake.slugAliasMutex.RUnlock()
+	fake.slugAliasContextMutex.RLock()
+	defer fake.slugAliasContextMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeProductsAPI) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pivnet.ProductsAPI = new(FakeProductsAPI)
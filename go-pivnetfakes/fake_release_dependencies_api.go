@@ -0,0 +1,518 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package gopivnetfakes
+
+import (
+	"context"
+	"sync"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v9"
+)
+
+type FakeReleaseDependenciesAPI struct {
+	AddStub        func(string, int, int) error
+	addMutex       sync.RWMutex
+	addArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	addReturns struct {
+		result1 error
+	}
+	addReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddContextStub        func(context.Context, string, int, int) error
+	addContextMutex       sync.RWMutex
+	addContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	addContextReturns struct {
+		result1 error
+	}
+	addContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ListStub        func(string, int) ([]pivnet.ReleaseDependency, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	listReturns struct {
+		result1 []pivnet.ReleaseDependency
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []pivnet.ReleaseDependency
+		result2 error
+	}
+	ListContextStub        func(context.Context, string, int) ([]pivnet.ReleaseDependency, error)
+	listContextMutex       sync.RWMutex
+	listContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	listContextReturns struct {
+		result1 []pivnet.ReleaseDependency
+		result2 error
+	}
+	listContextReturnsOnCall map[int]struct {
+		result1 []pivnet.ReleaseDependency
+		result2 error
+	}
+	RemoveStub        func(string, int, int) error
+	removeMutex       sync.RWMutex
+	removeArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	removeReturns struct {
+		result1 error
+	}
+	removeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveContextStub        func(context.Context, string, int, int) error
+	removeContextMutex       sync.RWMutex
+	removeContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	removeContextReturns struct {
+		result1 error
+	}
+	removeContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeReleaseDependenciesAPI) Add(arg1 string, arg2 int, arg3 int) error {
+	fake.addMutex.Lock()
+	ret, specificReturn := fake.addReturnsOnCall[len(fake.addArgsForCall)]
+	fake.addArgsForCall = append(fake.addArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.AddStub
+	fakeReturns := fake.addReturns
+	fake.recordInvocation("Add", []interface{}{arg1, arg2, arg3})
+	fake.addMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleaseDependenciesAPI) AddCallCount() int {
+	fake.addMutex.RLock()
+	defer fake.addMutex.RUnlock()
+	return len(fake.addArgsForCall)
+}
+
+func (fake *FakeReleaseDependenciesAPI) AddCalls(stub func(string, int, int) error) {
+	fake.addMutex.Lock()
+	defer fake.addMutex.Unlock()
+	fake.AddStub = stub
+}
+
+func (fake *FakeReleaseDependenciesAPI) AddArgsForCall(i int) (string, int, int) {
+	fake.addMutex.RLock()
+	defer fake.addMutex.RUnlock()
+	argsForCall := fake.addArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeReleaseDependenciesAPI) AddReturns(result1 error) {
+	fake.addMutex.Lock()
+	defer fake.addMutex.Unlock()
+	fake.AddStub = nil
+	fake.addReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseDependenciesAPI) AddReturnsOnCall(i int, result1 error) {
+	fake.addMutex.Lock()
+	defer fake.addMutex.Unlock()
+	fake.AddStub = nil
+	if fake.addReturnsOnCall == nil {
+		fake.addReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseDependenciesAPI) AddContext(arg1 context.Context, arg2 string, arg3 int, arg4 int) error {
+	fake.addContextMutex.Lock()
+	ret, specificReturn := fake.addContextReturnsOnCall[len(fake.addContextArgsForCall)]
+	fake.addContextArgsForCall = append(fake.addContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AddContextStub
+	fakeReturns := fake.addContextReturns
+	fake.recordInvocation("AddContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.addContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleaseDependenciesAPI) AddContextCallCount() int {
+	fake.addContextMutex.RLock()
+	defer fake.addContextMutex.RUnlock()
+	return len(fake.addContextArgsForCall)
+}
+
+func (fake *FakeReleaseDependenciesAPI) AddContextCalls(stub func(context.Context, string, int, int) error) {
+	fake.addContextMutex.Lock()
+	defer fake.addContextMutex.Unlock()
+	fake.AddContextStub = stub
+}
+
+func (fake *FakeReleaseDependenciesAPI) AddContextArgsForCall(i int) (context.Context, string, int, int) {
+	fake.addContextMutex.RLock()
+	defer fake.addContextMutex.RUnlock()
+	argsForCall := fake.addContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeReleaseDependenciesAPI) AddContextReturns(result1 error) {
+	fake.addContextMutex.Lock()
+	defer fake.addContextMutex.Unlock()
+	fake.AddContextStub = nil
+	fake.addContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseDependenciesAPI) AddContextReturnsOnCall(i int, result1 error) {
+	fake.addContextMutex.Lock()
+	defer fake.addContextMutex.Unlock()
+	fake.AddContextStub = nil
+	if fake.addContextReturnsOnCall == nil {
+		fake.addContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseDependenciesAPI) List(arg1 string, arg2 int) ([]pivnet.ReleaseDependency, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{arg1, arg2})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleaseDependenciesAPI) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeReleaseDependenciesAPI) ListCalls(stub func(string, int) ([]pivnet.ReleaseDependency, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeReleaseDependenciesAPI) ListArgsForCall(i int) (string, int) {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	argsForCall := fake.listArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReleaseDependenciesAPI) ListReturns(result1 []pivnet.ReleaseDependency, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []pivnet.ReleaseDependency
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleaseDependenciesAPI) ListReturnsOnCall(i int, result1 []pivnet.ReleaseDependency, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ReleaseDependency
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []pivnet.ReleaseDependency
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleaseDependenciesAPI) ListContext(arg1 context.Context, arg2 string, arg3 int) ([]pivnet.ReleaseDependency, error) {
+	fake.listContextMutex.Lock()
+	ret, specificReturn := fake.listContextReturnsOnCall[len(fake.listContextArgsForCall)]
+	fake.listContextArgsForCall = append(fake.listContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.ListContextStub
+	fakeReturns := fake.listContextReturns
+	fake.recordInvocation("ListContext", []interface{}{arg1, arg2, arg3})
+	fake.listContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleaseDependenciesAPI) ListContextCallCount() int {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	return len(fake.listContextArgsForCall)
+}
+
+func (fake *FakeReleaseDependenciesAPI) ListContextCalls(stub func(context.Context, string, int) ([]pivnet.ReleaseDependency, error)) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = stub
+}
+
+func (fake *FakeReleaseDependenciesAPI) ListContextArgsForCall(i int) (context.Context, string, int) {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	argsForCall := fake.listContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeReleaseDependenciesAPI) ListContextReturns(result1 []pivnet.ReleaseDependency, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	fake.listContextReturns = struct {
+		result1 []pivnet.ReleaseDependency
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleaseDependenciesAPI) ListContextReturnsOnCall(i int, result1 []pivnet.ReleaseDependency, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	if fake.listContextReturnsOnCall == nil {
+		fake.listContextReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ReleaseDependency
+			result2 error
+		})
+	}
+	fake.listContextReturnsOnCall[i] = struct {
+		result1 []pivnet.ReleaseDependency
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleaseDependenciesAPI) Remove(arg1 string, arg2 int, arg3 int) error {
+	fake.removeMutex.Lock()
+	ret, specificReturn := fake.removeReturnsOnCall[len(fake.removeArgsForCall)]
+	fake.removeArgsForCall = append(fake.removeArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveStub
+	fakeReturns := fake.removeReturns
+	fake.recordInvocation("Remove", []interface{}{arg1, arg2, arg3})
+	fake.removeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleaseDependenciesAPI) RemoveCallCount() int {
+	fake.removeMutex.RLock()
+	defer fake.removeMutex.RUnlock()
+	return len(fake.removeArgsForCall)
+}
+
+func (fake *FakeReleaseDependenciesAPI) RemoveCalls(stub func(string, int, int) error) {
+	fake.removeMutex.Lock()
+	defer fake.removeMutex.Unlock()
+	fake.RemoveStub = stub
+}
+
+func (fake *FakeReleaseDependenciesAPI) RemoveArgsForCall(i int) (string, int, int) {
+	fake.removeMutex.RLock()
+	defer fake.removeMutex.RUnlock()
+	argsForCall := fake.removeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeReleaseDependenciesAPI) RemoveReturns(result1 error) {
+	fake.removeMutex.Lock()
+	defer fake.removeMutex.Unlock()
+	fake.RemoveStub = nil
+	fake.removeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseDependenciesAPI) RemoveReturnsOnCall(i int, result1 error) {
+	fake.removeMutex.Lock()
+	defer fake.removeMutex.Unlock()
+	fake.RemoveStub = nil
+	if fake.removeReturnsOnCall == nil {
+		fake.removeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseDependenciesAPI) RemoveContext(arg1 context.Context, arg2 string, arg3 int, arg4 int) error {
+	fake.removeContextMutex.Lock()
+	ret, specificReturn := fake.removeContextReturnsOnCall[len(fake.removeContextArgsForCall)]
+	fake.removeContextArgsForCall = append(fake.removeContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RemoveContextStub
+	fakeReturns := fake.removeContextReturns
+	fake.recordInvocation("RemoveContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.removeContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleaseDependenciesAPI) RemoveContextCallCount() int {
+	fake.removeContextMutex.RLock()
+	defer fake.removeContextMutex.RUnlock()
+	return len(fake.removeContextArgsForCall)
+}
+
+func (fake *FakeReleaseDependenciesAPI) RemoveContextCalls(stub func(context.Context, string, int, int) error) {
+	fake.removeContextMutex.Lock()
+	defer fake.removeContextMutex.Unlock()
+	fake.RemoveContextStub = stub
+}
+
+func (fake *FakeReleaseDependenciesAPI) RemoveContextArgsForCall(i int) (context.Context, string, int, int) {
+	fake.removeContextMutex.RLock()
+	defer fake.removeContextMutex.RUnlock()
+	argsForCall := fake.removeContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeReleaseDependenciesAPI) RemoveContextReturns(result1 error) {
+	fake.removeContextMutex.Lock()
+	defer fake.removeContextMutex.Unlock()
+	fake.RemoveContextStub = nil
+	fake.removeContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseDependenciesAPI) RemoveContextReturnsOnCall(i int, result1 error) {
+	fake.removeContextMutex.Lock()
+	defer fake.removeContextMutex.Unlock()
+	fake.RemoveContextStub = nil
+	if fake.removeContextReturnsOnCall == nil {
+		fake.removeContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseDependenciesAPI) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.addMutex.RLock()
+	defer fake.addMutex.RUnlock()
+	fake.addContextMutex.RLock()
+	defer fake.addContextMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	fake.removeMutex.RLock()
+	defer fake.removeMutex.RUnlock()
+	fake.removeContextMutex.RLock()
+	defer fake.removeContextMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeReleaseDependenciesAPI) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pivnet.ReleaseDependenciesAPI = new(FakeReleaseDependenciesAPI)
@@ -0,0 +1,187 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package gopivnetfakes
+
+import (
+	"context"
+	"sync"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v9"
+)
+
+type FakeReleaseTypesAPI struct {
+	GetStub        func() ([]pivnet.ReleaseType, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+	}
+	getReturns struct {
+		result1 []pivnet.ReleaseType
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 []pivnet.ReleaseType
+		result2 error
+	}
+	GetContextStub        func(context.Context) ([]pivnet.ReleaseType, error)
+	getContextMutex       sync.RWMutex
+	getContextArgsForCall []struct {
+		arg1 context.Context
+	}
+	getContextReturns struct {
+		result1 []pivnet.ReleaseType
+		result2 error
+	}
+	getContextReturnsOnCall map[int]struct {
+		result1 []pivnet.ReleaseType
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeReleaseTypesAPI) Get() ([]pivnet.ReleaseType, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+	}{})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{})
+	fake.getMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleaseTypesAPI) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeReleaseTypesAPI) GetCalls(stub func() ([]pivnet.ReleaseType, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeReleaseTypesAPI) GetReturns(result1 []pivnet.ReleaseType, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 []pivnet.ReleaseType
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleaseTypesAPI) GetReturnsOnCall(i int, result1 []pivnet.ReleaseType, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ReleaseType
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 []pivnet.ReleaseType
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleaseTypesAPI) GetContext(arg1 context.Context) ([]pivnet.ReleaseType, error) {
+	fake.getContextMutex.Lock()
+	ret, specificReturn := fake.getContextReturnsOnCall[len(fake.getContextArgsForCall)]
+	fake.getContextArgsForCall = append(fake.getContextArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetContextStub
+	fakeReturns := fake.getContextReturns
+	fake.recordInvocation("GetContext", []interface{}{arg1})
+	fake.getContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleaseTypesAPI) GetContextCallCount() int {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	return len(fake.getContextArgsForCall)
+}
+
+func (fake *FakeReleaseTypesAPI) GetContextCalls(stub func(context.Context) ([]pivnet.ReleaseType, error)) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = stub
+}
+
+func (fake *FakeReleaseTypesAPI) GetContextArgsForCall(i int) context.Context {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	argsForCall := fake.getContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeReleaseTypesAPI) GetContextReturns(result1 []pivnet.ReleaseType, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	fake.getContextReturns = struct {
+		result1 []pivnet.ReleaseType
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleaseTypesAPI) GetContextReturnsOnCall(i int, result1 []pivnet.ReleaseType, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	if fake.getContextReturnsOnCall == nil {
+		fake.getContextReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ReleaseType
+			result2 error
+		})
+	}
+	fake.getContextReturnsOnCall[i] = struct {
+		result1 []pivnet.ReleaseType
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleaseTypesAPI) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeReleaseTypesAPI) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pivnet.ReleaseTypesAPI = new(FakeReleaseTypesAPI)
@@ -0,0 +1,518 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package gopivnetfakes
+
+import (
+	"context"
+	"sync"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v9"
+)
+
+type FakeReleaseUpgradePathsAPI struct {
+	AddStub        func(string, int, int) error
+	addMutex       sync.RWMutex
+	addArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	addReturns struct {
+		result1 error
+	}
+	addReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddContextStub        func(context.Context, string, int, int) error
+	addContextMutex       sync.RWMutex
+	addContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	addContextReturns struct {
+		result1 error
+	}
+	addContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetStub        func(string, int) ([]pivnet.ReleaseUpgradePath, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	getReturns struct {
+		result1 []pivnet.ReleaseUpgradePath
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 []pivnet.ReleaseUpgradePath
+		result2 error
+	}
+	GetContextStub        func(context.Context, string, int) ([]pivnet.ReleaseUpgradePath, error)
+	getContextMutex       sync.RWMutex
+	getContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	getContextReturns struct {
+		result1 []pivnet.ReleaseUpgradePath
+		result2 error
+	}
+	getContextReturnsOnCall map[int]struct {
+		result1 []pivnet.ReleaseUpgradePath
+		result2 error
+	}
+	RemoveStub        func(string, int, int) error
+	removeMutex       sync.RWMutex
+	removeArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	removeReturns struct {
+		result1 error
+	}
+	removeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveContextStub        func(context.Context, string, int, int) error
+	removeContextMutex       sync.RWMutex
+	removeContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	removeContextReturns struct {
+		result1 error
+	}
+	removeContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) Add(arg1 string, arg2 int, arg3 int) error {
+	fake.addMutex.Lock()
+	ret, specificReturn := fake.addReturnsOnCall[len(fake.addArgsForCall)]
+	fake.addArgsForCall = append(fake.addArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.AddStub
+	fakeReturns := fake.addReturns
+	fake.recordInvocation("Add", []interface{}{arg1, arg2, arg3})
+	fake.addMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) AddCallCount() int {
+	fake.addMutex.RLock()
+	defer fake.addMutex.RUnlock()
+	return len(fake.addArgsForCall)
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) AddCalls(stub func(string, int, int) error) {
+	fake.addMutex.Lock()
+	defer fake.addMutex.Unlock()
+	fake.AddStub = stub
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) AddArgsForCall(i int) (string, int, int) {
+	fake.addMutex.RLock()
+	defer fake.addMutex.RUnlock()
+	argsForCall := fake.addArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) AddReturns(result1 error) {
+	fake.addMutex.Lock()
+	defer fake.addMutex.Unlock()
+	fake.AddStub = nil
+	fake.addReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) AddReturnsOnCall(i int, result1 error) {
+	fake.addMutex.Lock()
+	defer fake.addMutex.Unlock()
+	fake.AddStub = nil
+	if fake.addReturnsOnCall == nil {
+		fake.addReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) AddContext(arg1 context.Context, arg2 string, arg3 int, arg4 int) error {
+	fake.addContextMutex.Lock()
+	ret, specificReturn := fake.addContextReturnsOnCall[len(fake.addContextArgsForCall)]
+	fake.addContextArgsForCall = append(fake.addContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AddContextStub
+	fakeReturns := fake.addContextReturns
+	fake.recordInvocation("AddContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.addContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) AddContextCallCount() int {
+	fake.addContextMutex.RLock()
+	defer fake.addContextMutex.RUnlock()
+	return len(fake.addContextArgsForCall)
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) AddContextCalls(stub func(context.Context, string, int, int) error) {
+	fake.addContextMutex.Lock()
+	defer fake.addContextMutex.Unlock()
+	fake.AddContextStub = stub
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) AddContextArgsForCall(i int) (context.Context, string, int, int) {
+	fake.addContextMutex.RLock()
+	defer fake.addContextMutex.RUnlock()
+	argsForCall := fake.addContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) AddContextReturns(result1 error) {
+	fake.addContextMutex.Lock()
+	defer fake.addContextMutex.Unlock()
+	fake.AddContextStub = nil
+	fake.addContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) AddContextReturnsOnCall(i int, result1 error) {
+	fake.addContextMutex.Lock()
+	defer fake.addContextMutex.Unlock()
+	fake.AddContextStub = nil
+	if fake.addContextReturnsOnCall == nil {
+		fake.addContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) Get(arg1 string, arg2 int) ([]pivnet.ReleaseUpgradePath, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1, arg2})
+	fake.getMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) GetCalls(stub func(string, int) ([]pivnet.ReleaseUpgradePath, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) GetArgsForCall(i int) (string, int) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) GetReturns(result1 []pivnet.ReleaseUpgradePath, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 []pivnet.ReleaseUpgradePath
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) GetReturnsOnCall(i int, result1 []pivnet.ReleaseUpgradePath, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ReleaseUpgradePath
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 []pivnet.ReleaseUpgradePath
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) GetContext(arg1 context.Context, arg2 string, arg3 int) ([]pivnet.ReleaseUpgradePath, error) {
+	fake.getContextMutex.Lock()
+	ret, specificReturn := fake.getContextReturnsOnCall[len(fake.getContextArgsForCall)]
+	fake.getContextArgsForCall = append(fake.getContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GetContextStub
+	fakeReturns := fake.getContextReturns
+	fake.recordInvocation("GetContext", []interface{}{arg1, arg2, arg3})
+	fake.getContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) GetContextCallCount() int {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	return len(fake.getContextArgsForCall)
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) GetContextCalls(stub func(context.Context, string, int) ([]pivnet.ReleaseUpgradePath, error)) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = stub
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) GetContextArgsForCall(i int) (context.Context, string, int) {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	argsForCall := fake.getContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) GetContextReturns(result1 []pivnet.ReleaseUpgradePath, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	fake.getContextReturns = struct {
+		result1 []pivnet.ReleaseUpgradePath
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) GetContextReturnsOnCall(i int, result1 []pivnet.ReleaseUpgradePath, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	if fake.getContextReturnsOnCall == nil {
+		fake.getContextReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.ReleaseUpgradePath
+			result2 error
+		})
+	}
+	fake.getContextReturnsOnCall[i] = struct {
+		result1 []pivnet.ReleaseUpgradePath
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) Remove(arg1 string, arg2 int, arg3 int) error {
+	fake.removeMutex.Lock()
+	ret, specificReturn := fake.removeReturnsOnCall[len(fake.removeArgsForCall)]
+	fake.removeArgsForCall = append(fake.removeArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveStub
+	fakeReturns := fake.removeReturns
+	fake.recordInvocation("Remove", []interface{}{arg1, arg2, arg3})
+	fake.removeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) RemoveCallCount() int {
+	fake.removeMutex.RLock()
+	defer fake.removeMutex.RUnlock()
+	return len(fake.removeArgsForCall)
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) RemoveCalls(stub func(string, int, int) error) {
+	fake.removeMutex.Lock()
+	defer fake.removeMutex.Unlock()
+	fake.RemoveStub = stub
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) RemoveArgsForCall(i int) (string, int, int) {
+	fake.removeMutex.RLock()
+	defer fake.removeMutex.RUnlock()
+	argsForCall := fake.removeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) RemoveReturns(result1 error) {
+	fake.removeMutex.Lock()
+	defer fake.removeMutex.Unlock()
+	fake.RemoveStub = nil
+	fake.removeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) RemoveReturnsOnCall(i int, result1 error) {
+	fake.removeMutex.Lock()
+	defer fake.removeMutex.Unlock()
+	fake.RemoveStub = nil
+	if fake.removeReturnsOnCall == nil {
+		fake.removeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) RemoveContext(arg1 context.Context, arg2 string, arg3 int, arg4 int) error {
+	fake.removeContextMutex.Lock()
+	ret, specificReturn := fake.removeContextReturnsOnCall[len(fake.removeContextArgsForCall)]
+	fake.removeContextArgsForCall = append(fake.removeContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RemoveContextStub
+	fakeReturns := fake.removeContextReturns
+	fake.recordInvocation("RemoveContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.removeContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) RemoveContextCallCount() int {
+	fake.removeContextMutex.RLock()
+	defer fake.removeContextMutex.RUnlock()
+	return len(fake.removeContextArgsForCall)
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) RemoveContextCalls(stub func(context.Context, string, int, int) error) {
+	fake.removeContextMutex.Lock()
+	defer fake.removeContextMutex.Unlock()
+	fake.RemoveContextStub = stub
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) RemoveContextArgsForCall(i int) (context.Context, string, int, int) {
+	fake.removeContextMutex.RLock()
+	defer fake.removeContextMutex.RUnlock()
+	argsForCall := fake.removeContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) RemoveContextReturns(result1 error) {
+	fake.removeContextMutex.Lock()
+	defer fake.removeContextMutex.Unlock()
+	fake.RemoveContextStub = nil
+	fake.removeContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) RemoveContextReturnsOnCall(i int, result1 error) {
+	fake.removeContextMutex.Lock()
+	defer fake.removeContextMutex.Unlock()
+	fake.RemoveContextStub = nil
+	if fake.removeContextReturnsOnCall == nil {
+		fake.removeContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.addMutex.RLock()
+	defer fake.addMutex.RUnlock()
+	fake.addContextMutex.RLock()
+	defer fake.addContextMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	fake.removeMutex.RLock()
+	defer fake.removeMutex.RUnlock()
+	fake.removeContextMutex.RLock()
+	defer fake.removeContextMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeReleaseUpgradePathsAPI) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pivnet.ReleaseUpgradePathsAPI = new(FakeReleaseUpgradePathsAPI)
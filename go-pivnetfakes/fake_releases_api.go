@@ -0,0 +1,2003 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package gopivnetfakes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v9"
+)
+
+type FakeReleasesAPI struct {
+	CloneStub        func(string, int, string, pivnet.CloneReleaseOptions) (pivnet.CloneReleaseReport, error)
+	cloneMutex       sync.RWMutex
+	cloneArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 string
+		arg4 pivnet.CloneReleaseOptions
+	}
+	cloneReturns struct {
+		result1 pivnet.CloneReleaseReport
+		result2 error
+	}
+	cloneReturnsOnCall map[int]struct {
+		result1 pivnet.CloneReleaseReport
+		result2 error
+	}
+	CloneContextStub        func(context.Context, string, int, string, pivnet.CloneReleaseOptions) (pivnet.CloneReleaseReport, error)
+	cloneContextMutex       sync.RWMutex
+	cloneContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 string
+		arg5 pivnet.CloneReleaseOptions
+	}
+	cloneContextReturns struct {
+		result1 pivnet.CloneReleaseReport
+		result2 error
+	}
+	cloneContextReturnsOnCall map[int]struct {
+		result1 pivnet.CloneReleaseReport
+		result2 error
+	}
+	CreateStub        func(pivnet.CreateReleaseConfig) (pivnet.Release, error)
+	createMutex       sync.RWMutex
+	createArgsForCall []struct {
+		arg1 pivnet.CreateReleaseConfig
+	}
+	createReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	createReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	CreateContextStub        func(context.Context, pivnet.CreateReleaseConfig) (pivnet.Release, error)
+	createContextMutex       sync.RWMutex
+	createContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 pivnet.CreateReleaseConfig
+	}
+	createContextReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	createContextReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	DeleteStub        func(string, pivnet.Release) error
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 string
+		arg2 pivnet.Release
+	}
+	deleteReturns struct {
+		result1 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteContextStub        func(context.Context, string, pivnet.Release) error
+	deleteContextMutex       sync.RWMutex
+	deleteContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 pivnet.Release
+	}
+	deleteContextReturns struct {
+		result1 error
+	}
+	deleteContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetStub        func(string, int) (pivnet.Release, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	getReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	GetByVersionStub        func(string, string) (pivnet.Release, error)
+	getByVersionMutex       sync.RWMutex
+	getByVersionArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getByVersionReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	getByVersionReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	GetByVersionContextStub        func(context.Context, string, string) (pivnet.Release, error)
+	getByVersionContextMutex       sync.RWMutex
+	getByVersionContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	getByVersionContextReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	getByVersionContextReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	GetContextStub        func(context.Context, string, int) (pivnet.Release, error)
+	getContextMutex       sync.RWMutex
+	getContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	getContextReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	getContextReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	IterateStub        func(string, pivnet.ListOptions) *pivnet.ReleaseIterator
+	iterateMutex       sync.RWMutex
+	iterateArgsForCall []struct {
+		arg1 string
+		arg2 pivnet.ListOptions
+	}
+	iterateReturns struct {
+		result1 *pivnet.ReleaseIterator
+	}
+	iterateReturnsOnCall map[int]struct {
+		result1 *pivnet.ReleaseIterator
+	}
+	IterateContextStub        func(context.Context, string, pivnet.ListOptions) *pivnet.ReleaseIterator
+	iterateContextMutex       sync.RWMutex
+	iterateContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 pivnet.ListOptions
+	}
+	iterateContextReturns struct {
+		result1 *pivnet.ReleaseIterator
+	}
+	iterateContextReturnsOnCall map[int]struct {
+		result1 *pivnet.ReleaseIterator
+	}
+	LatestMatchingStub        func(string, string) (pivnet.Release, error)
+	latestMatchingMutex       sync.RWMutex
+	latestMatchingArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	latestMatchingReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	latestMatchingReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	LatestMatchingContextStub        func(context.Context, string, string) (pivnet.Release, error)
+	latestMatchingContextMutex       sync.RWMutex
+	latestMatchingContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	latestMatchingContextReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	latestMatchingContextReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	ListStub        func(string, ...pivnet.QueryParameter) ([]pivnet.Release, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		arg1 string
+		arg2 []pivnet.QueryParameter
+	}
+	listReturns struct {
+		result1 []pivnet.Release
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []pivnet.Release
+		result2 error
+	}
+	ListAllStub        func(string, pivnet.ListOptions) ([]pivnet.Release, error)
+	listAllMutex       sync.RWMutex
+	listAllArgsForCall []struct {
+		arg1 string
+		arg2 pivnet.ListOptions
+	}
+	listAllReturns struct {
+		result1 []pivnet.Release
+		result2 error
+	}
+	listAllReturnsOnCall map[int]struct {
+		result1 []pivnet.Release
+		result2 error
+	}
+	ListAllContextStub        func(context.Context, string, pivnet.ListOptions) ([]pivnet.Release, error)
+	listAllContextMutex       sync.RWMutex
+	listAllContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 pivnet.ListOptions
+	}
+	listAllContextReturns struct {
+		result1 []pivnet.Release
+		result2 error
+	}
+	listAllContextReturnsOnCall map[int]struct {
+		result1 []pivnet.Release
+		result2 error
+	}
+	ListContextStub        func(context.Context, string, ...pivnet.QueryParameter) ([]pivnet.Release, error)
+	listContextMutex       sync.RWMutex
+	listContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []pivnet.QueryParameter
+	}
+	listContextReturns struct {
+		result1 []pivnet.Release
+		result2 error
+	}
+	listContextReturnsOnCall map[int]struct {
+		result1 []pivnet.Release
+		result2 error
+	}
+	ListReachingEndOfSupportStub        func(string, time.Time, time.Time) ([]pivnet.Release, error)
+	listReachingEndOfSupportMutex       sync.RWMutex
+	listReachingEndOfSupportArgsForCall []struct {
+		arg1 string
+		arg2 time.Time
+		arg3 time.Time
+	}
+	listReachingEndOfSupportReturns struct {
+		result1 []pivnet.Release
+		result2 error
+	}
+	listReachingEndOfSupportReturnsOnCall map[int]struct {
+		result1 []pivnet.Release
+		result2 error
+	}
+	ListReachingEndOfSupportContextStub        func(context.Context, string, time.Time, time.Time) ([]pivnet.Release, error)
+	listReachingEndOfSupportContextMutex       sync.RWMutex
+	listReachingEndOfSupportContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+		arg4 time.Time
+	}
+	listReachingEndOfSupportContextReturns struct {
+		result1 []pivnet.Release
+		result2 error
+	}
+	listReachingEndOfSupportContextReturnsOnCall map[int]struct {
+		result1 []pivnet.Release
+		result2 error
+	}
+	PromoteStub        func(string, int, pivnet.Availability, pivnet.PromoteReleaseOptions) (pivnet.Release, error)
+	promoteMutex       sync.RWMutex
+	promoteArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 pivnet.Availability
+		arg4 pivnet.PromoteReleaseOptions
+	}
+	promoteReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	promoteReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	PromoteContextStub        func(context.Context, string, int, pivnet.Availability, pivnet.PromoteReleaseOptions) (pivnet.Release, error)
+	promoteContextMutex       sync.RWMutex
+	promoteContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 pivnet.Availability
+		arg5 pivnet.PromoteReleaseOptions
+	}
+	promoteContextReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	promoteContextReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	UpdateStub        func(string, pivnet.Release) (pivnet.Release, error)
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 string
+		arg2 pivnet.Release
+	}
+	updateReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	UpdateContextStub        func(context.Context, string, pivnet.Release) (pivnet.Release, error)
+	updateContextMutex       sync.RWMutex
+	updateContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 pivnet.Release
+	}
+	updateContextReturns struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	updateContextReturnsOnCall map[int]struct {
+		result1 pivnet.Release
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeReleasesAPI) Clone(arg1 string, arg2 int, arg3 string, arg4 pivnet.CloneReleaseOptions) (pivnet.CloneReleaseReport, error) {
+	fake.cloneMutex.Lock()
+	ret, specificReturn := fake.cloneReturnsOnCall[len(fake.cloneArgsForCall)]
+	fake.cloneArgsForCall = append(fake.cloneArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 string
+		arg4 pivnet.CloneReleaseOptions
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CloneStub
+	fakeReturns := fake.cloneReturns
+	fake.recordInvocation("Clone", []interface{}{arg1, arg2, arg3, arg4})
+	fake.cloneMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleasesAPI) CloneCallCount() int {
+	fake.cloneMutex.RLock()
+	defer fake.cloneMutex.RUnlock()
+	return len(fake.cloneArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) CloneCalls(stub func(string, int, string, pivnet.CloneReleaseOptions) (pivnet.CloneReleaseReport, error)) {
+	fake.cloneMutex.Lock()
+	defer fake.cloneMutex.Unlock()
+	fake.CloneStub = stub
+}
+
+func (fake *FakeReleasesAPI) CloneArgsForCall(i int) (string, int, string, pivnet.CloneReleaseOptions) {
+	fake.cloneMutex.RLock()
+	defer fake.cloneMutex.RUnlock()
+	argsForCall := fake.cloneArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeReleasesAPI) CloneReturns(result1 pivnet.CloneReleaseReport, result2 error) {
+	fake.cloneMutex.Lock()
+	defer fake.cloneMutex.Unlock()
+	fake.CloneStub = nil
+	fake.cloneReturns = struct {
+		result1 pivnet.CloneReleaseReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) CloneReturnsOnCall(i int, result1 pivnet.CloneReleaseReport, result2 error) {
+	fake.cloneMutex.Lock()
+	defer fake.cloneMutex.Unlock()
+	fake.CloneStub = nil
+	if fake.cloneReturnsOnCall == nil {
+		fake.cloneReturnsOnCall = make(map[int]struct {
+			result1 pivnet.CloneReleaseReport
+			result2 error
+		})
+	}
+	fake.cloneReturnsOnCall[i] = struct {
+		result1 pivnet.CloneReleaseReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) CloneContext(arg1 context.Context, arg2 string, arg3 int, arg4 string, arg5 pivnet.CloneReleaseOptions) (pivnet.CloneReleaseReport, error) {
+	fake.cloneContextMutex.Lock()
+	ret, specificReturn := fake.cloneContextReturnsOnCall[len(fake.cloneContextArgsForCall)]
+	fake.cloneContextArgsForCall = append(fake.cloneContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 string
+		arg5 pivnet.CloneReleaseOptions
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.CloneContextStub
+	fakeReturns := fake.cloneContextReturns
+	fake.recordInvocation("CloneContext", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.cloneContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleasesAPI) CloneContextCallCount() int {
+	fake.cloneContextMutex.RLock()
+	defer fake.cloneContextMutex.RUnlock()
+	return len(fake.cloneContextArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) CloneContextCalls(stub func(context.Context, string, int, string, pivnet.CloneReleaseOptions) (pivnet.CloneReleaseReport, error)) {
+	fake.cloneContextMutex.Lock()
+	defer fake.cloneContextMutex.Unlock()
+	fake.CloneContextStub = stub
+}
+
+func (fake *FakeReleasesAPI) CloneContextArgsForCall(i int) (context.Context, string, int, string, pivnet.CloneReleaseOptions) {
+	fake.cloneContextMutex.RLock()
+	defer fake.cloneContextMutex.RUnlock()
+	argsForCall := fake.cloneContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeReleasesAPI) CloneContextReturns(result1 pivnet.CloneReleaseReport, result2 error) {
+	fake.cloneContextMutex.Lock()
+	defer fake.cloneContextMutex.Unlock()
+	fake.CloneContextStub = nil
+	fake.cloneContextReturns = struct {
+		result1 pivnet.CloneReleaseReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) CloneContextReturnsOnCall(i int, result1 pivnet.CloneReleaseReport, result2 error) {
+	fake.cloneContextMutex.Lock()
+	defer fake.cloneContextMutex.Unlock()
+	fake.CloneContextStub = nil
+	if fake.cloneContextReturnsOnCall == nil {
+		fake.cloneContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.CloneReleaseReport
+			result2 error
+		})
+	}
+	fake.cloneContextReturnsOnCall[i] = struct {
+		result1 pivnet.CloneReleaseReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) Create(arg1 pivnet.CreateReleaseConfig) (pivnet.Release, error) {
+	fake.createMutex.Lock()
+	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
+	fake.createArgsForCall = append(fake.createArgsForCall, struct {
+		arg1 pivnet.CreateReleaseConfig
+	}{arg1})
+	stub := fake.CreateStub
+	fakeReturns := fake.createReturns
+	fake.recordInvocation("Create", []interface{}{arg1})
+	fake.createMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleasesAPI) CreateCallCount() int {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return len(fake.createArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) CreateCalls(stub func(pivnet.CreateReleaseConfig) (pivnet.Release, error)) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = stub
+}
+
+func (fake *FakeReleasesAPI) CreateArgsForCall(i int) pivnet.CreateReleaseConfig {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	argsForCall := fake.createArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeReleasesAPI) CreateReturns(result1 pivnet.Release, result2 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	fake.createReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) CreateReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) CreateContext(arg1 context.Context, arg2 pivnet.CreateReleaseConfig) (pivnet.Release, error) {
+	fake.createContextMutex.Lock()
+	ret, specificReturn := fake.createContextReturnsOnCall[len(fake.createContextArgsForCall)]
+	fake.createContextArgsForCall = append(fake.createContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 pivnet.CreateReleaseConfig
+	}{arg1, arg2})
+	stub := fake.CreateContextStub
+	fakeReturns := fake.createContextReturns
+	fake.recordInvocation("CreateContext", []interface{}{arg1, arg2})
+	fake.createContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleasesAPI) CreateContextCallCount() int {
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	return len(fake.createContextArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) CreateContextCalls(stub func(context.Context, pivnet.CreateReleaseConfig) (pivnet.Release, error)) {
+	fake.createContextMutex.Lock()
+	defer fake.createContextMutex.Unlock()
+	fake.CreateContextStub = stub
+}
+
+func (fake *FakeReleasesAPI) CreateContextArgsForCall(i int) (context.Context, pivnet.CreateReleaseConfig) {
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	argsForCall := fake.createContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReleasesAPI) CreateContextReturns(result1 pivnet.Release, result2 error) {
+	fake.createContextMutex.Lock()
+	defer fake.createContextMutex.Unlock()
+	fake.CreateContextStub = nil
+	fake.createContextReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) CreateContextReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.createContextMutex.Lock()
+	defer fake.createContextMutex.Unlock()
+	fake.CreateContextStub = nil
+	if fake.createContextReturnsOnCall == nil {
+		fake.createContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.createContextReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) Delete(arg1 string, arg2 pivnet.Release) error {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 string
+		arg2 pivnet.Release
+	}{arg1, arg2})
+	stub := fake.DeleteStub
+	fakeReturns := fake.deleteReturns
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
+	fake.deleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleasesAPI) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) DeleteCalls(stub func(string, pivnet.Release) error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = stub
+}
+
+func (fake *FakeReleasesAPI) DeleteArgsForCall(i int) (string, pivnet.Release) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	argsForCall := fake.deleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReleasesAPI) DeleteReturns(result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleasesAPI) DeleteReturnsOnCall(i int, result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleasesAPI) DeleteContext(arg1 context.Context, arg2 string, arg3 pivnet.Release) error {
+	fake.deleteContextMutex.Lock()
+	ret, specificReturn := fake.deleteContextReturnsOnCall[len(fake.deleteContextArgsForCall)]
+	fake.deleteContextArgsForCall = append(fake.deleteContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 pivnet.Release
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteContextStub
+	fakeReturns := fake.deleteContextReturns
+	fake.recordInvocation("DeleteContext", []interface{}{arg1, arg2, arg3})
+	fake.deleteContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleasesAPI) DeleteContextCallCount() int {
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	return len(fake.deleteContextArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) DeleteContextCalls(stub func(context.Context, string, pivnet.Release) error) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = stub
+}
+
+func (fake *FakeReleasesAPI) DeleteContextArgsForCall(i int) (context.Context, string, pivnet.Release) {
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	argsForCall := fake.deleteContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeReleasesAPI) DeleteContextReturns(result1 error) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = nil
+	fake.deleteContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleasesAPI) DeleteContextReturnsOnCall(i int, result1 error) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = nil
+	if fake.deleteContextReturnsOnCall == nil {
+		fake.deleteContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleasesAPI) Get(arg1 string, arg2 int) (pivnet.Release, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1, arg2})
+	fake.getMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleasesAPI) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) GetCalls(stub func(string, int) (pivnet.Release, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeReleasesAPI) GetArgsForCall(i int) (string, int) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReleasesAPI) GetReturns(result1 pivnet.Release, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) GetReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) GetByVersion(arg1 string, arg2 string) (pivnet.Release, error) {
+	fake.getByVersionMutex.Lock()
+	ret, specificReturn := fake.getByVersionReturnsOnCall[len(fake.getByVersionArgsForCall)]
+	fake.getByVersionArgsForCall = append(fake.getByVersionArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetByVersionStub
+	fakeReturns := fake.getByVersionReturns
+	fake.recordInvocation("GetByVersion", []interface{}{arg1, arg2})
+	fake.getByVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleasesAPI) GetByVersionCallCount() int {
+	fake.getByVersionMutex.RLock()
+	defer fake.getByVersionMutex.RUnlock()
+	return len(fake.getByVersionArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) GetByVersionCalls(stub func(string, string) (pivnet.Release, error)) {
+	fake.getByVersionMutex.Lock()
+	defer fake.getByVersionMutex.Unlock()
+	fake.GetByVersionStub = stub
+}
+
+func (fake *FakeReleasesAPI) GetByVersionArgsForCall(i int) (string, string) {
+	fake.getByVersionMutex.RLock()
+	defer fake.getByVersionMutex.RUnlock()
+	argsForCall := fake.getByVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReleasesAPI) GetByVersionReturns(result1 pivnet.Release, result2 error) {
+	fake.getByVersionMutex.Lock()
+	defer fake.getByVersionMutex.Unlock()
+	fake.GetByVersionStub = nil
+	fake.getByVersionReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) GetByVersionReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.getByVersionMutex.Lock()
+	defer fake.getByVersionMutex.Unlock()
+	fake.GetByVersionStub = nil
+	if fake.getByVersionReturnsOnCall == nil {
+		fake.getByVersionReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.getByVersionReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) GetByVersionContext(arg1 context.Context, arg2 string, arg3 string) (pivnet.Release, error) {
+	fake.getByVersionContextMutex.Lock()
+	ret, specificReturn := fake.getByVersionContextReturnsOnCall[len(fake.getByVersionContextArgsForCall)]
+	fake.getByVersionContextArgsForCall = append(fake.getByVersionContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetByVersionContextStub
+	fakeReturns := fake.getByVersionContextReturns
+	fake.recordInvocation("GetByVersionContext", []interface{}{arg1, arg2, arg3})
+	fake.getByVersionContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleasesAPI) GetByVersionContextCallCount() int {
+	fake.getByVersionContextMutex.RLock()
+	defer fake.getByVersionContextMutex.RUnlock()
+	return len(fake.getByVersionContextArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) GetByVersionContextCalls(stub func(context.Context, string, string) (pivnet.Release, error)) {
+	fake.getByVersionContextMutex.Lock()
+	defer fake.getByVersionContextMutex.Unlock()
+	fake.GetByVersionContextStub = stub
+}
+
+func (fake *FakeReleasesAPI) GetByVersionContextArgsForCall(i int) (context.Context, string, string) {
+	fake.getByVersionContextMutex.RLock()
+	defer fake.getByVersionContextMutex.RUnlock()
+	argsForCall := fake.getByVersionContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeReleasesAPI) GetByVersionContextReturns(result1 pivnet.Release, result2 error) {
+	fake.getByVersionContextMutex.Lock()
+	defer fake.getByVersionContextMutex.Unlock()
+	fake.GetByVersionContextStub = nil
+	fake.getByVersionContextReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) GetByVersionContextReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.getByVersionContextMutex.Lock()
+	defer fake.getByVersionContextMutex.Unlock()
+	fake.GetByVersionContextStub = nil
+	if fake.getByVersionContextReturnsOnCall == nil {
+		fake.getByVersionContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.getByVersionContextReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) GetContext(arg1 context.Context, arg2 string, arg3 int) (pivnet.Release, error) {
+	fake.getContextMutex.Lock()
+	ret, specificReturn := fake.getContextReturnsOnCall[len(fake.getContextArgsForCall)]
+	fake.getContextArgsForCall = append(fake.getContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GetContextStub
+	fakeReturns := fake.getContextReturns
+	fake.recordInvocation("GetContext", []interface{}{arg1, arg2, arg3})
+	fake.getContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleasesAPI) GetContextCallCount() int {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	return len(fake.getContextArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) GetContextCalls(stub func(context.Context, string, int) (pivnet.Release, error)) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = stub
+}
+
+func (fake *FakeReleasesAPI) GetContextArgsForCall(i int) (context.Context, string, int) {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	argsForCall := fake.getContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeReleasesAPI) GetContextReturns(result1 pivnet.Release, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	fake.getContextReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) GetContextReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	if fake.getContextReturnsOnCall == nil {
+		fake.getContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.getContextReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) Iterate(arg1 string, arg2 pivnet.ListOptions) *pivnet.ReleaseIterator {
+	fake.iterateMutex.Lock()
+	ret, specificReturn := fake.iterateReturnsOnCall[len(fake.iterateArgsForCall)]
+	fake.iterateArgsForCall = append(fake.iterateArgsForCall, struct {
+		arg1 string
+		arg2 pivnet.ListOptions
+	}{arg1, arg2})
+	stub := fake.IterateStub
+	fakeReturns := fake.iterateReturns
+	fake.recordInvocation("Iterate", []interface{}{arg1, arg2})
+	fake.iterateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleasesAPI) IterateCallCount() int {
+	fake.iterateMutex.RLock()
+	defer fake.iterateMutex.RUnlock()
+	return len(fake.iterateArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) IterateCalls(stub func(string, pivnet.ListOptions) *pivnet.ReleaseIterator) {
+	fake.iterateMutex.Lock()
+	defer fake.iterateMutex.Unlock()
+	fake.IterateStub = stub
+}
+
+func (fake *FakeReleasesAPI) IterateArgsForCall(i int) (string, pivnet.ListOptions) {
+	fake.iterateMutex.RLock()
+	defer fake.iterateMutex.RUnlock()
+	argsForCall := fake.iterateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReleasesAPI) IterateReturns(result1 *pivnet.ReleaseIterator) {
+	fake.iterateMutex.Lock()
+	defer fake.iterateMutex.Unlock()
+	fake.IterateStub = nil
+	fake.iterateReturns = struct {
+		result1 *pivnet.ReleaseIterator
+	}{result1}
+}
+
+func (fake *FakeReleasesAPI) IterateReturnsOnCall(i int, result1 *pivnet.ReleaseIterator) {
+	fake.iterateMutex.Lock()
+	defer fake.iterateMutex.Unlock()
+	fake.IterateStub = nil
+	if fake.iterateReturnsOnCall == nil {
+		fake.iterateReturnsOnCall = make(map[int]struct {
+			result1 *pivnet.ReleaseIterator
+		})
+	}
+	fake.iterateReturnsOnCall[i] = struct {
+		result1 *pivnet.ReleaseIterator
+	}{result1}
+}
+
+func (fake *FakeReleasesAPI) IterateContext(arg1 context.Context, arg2 string, arg3 pivnet.ListOptions) *pivnet.ReleaseIterator {
+	fake.iterateContextMutex.Lock()
+	ret, specificReturn := fake.iterateContextReturnsOnCall[len(fake.iterateContextArgsForCall)]
+	fake.iterateContextArgsForCall = append(fake.iterateContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 pivnet.ListOptions
+	}{arg1, arg2, arg3})
+	stub := fake.IterateContextStub
+	fakeReturns := fake.iterateContextReturns
+	fake.recordInvocation("IterateContext", []interface{}{arg1, arg2, arg3})
+	fake.iterateContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleasesAPI) IterateContextCallCount() int {
+	fake.iterateContextMutex.RLock()
+	defer fake.iterateContextMutex.RUnlock()
+	return len(fake.iterateContextArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) IterateContextCalls(stub func(context.Context, string, pivnet.ListOptions) *pivnet.ReleaseIterator) {
+	fake.iterateContextMutex.Lock()
+	defer fake.iterateContextMutex.Unlock()
+	fake.IterateContextStub = stub
+}
+
+func (fake *FakeReleasesAPI) IterateContextArgsForCall(i int) (context.Context, string, pivnet.ListOptions) {
+	fake.iterateContextMutex.RLock()
+	defer fake.iterateContextMutex.RUnlock()
+	argsForCall := fake.iterateContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeReleasesAPI) IterateContextReturns(result1 *pivnet.ReleaseIterator) {
+	fake.iterateContextMutex.Lock()
+	defer fake.iterateContextMutex.Unlock()
+	fake.IterateContextStub = nil
+	fake.iterateContextReturns = struct {
+		result1 *pivnet.ReleaseIterator
+	}{result1}
+}
+
+func (fake *FakeReleasesAPI) IterateContextReturnsOnCall(i int, result1 *pivnet.ReleaseIterator) {
+	fake.iterateContextMutex.Lock()
+	defer fake.iterateContextMutex.Unlock()
+	fake.IterateContextStub = nil
+	if fake.iterateContextReturnsOnCall == nil {
+		fake.iterateContextReturnsOnCall = make(map[int]struct {
+			result1 *pivnet.ReleaseIterator
+		})
+	}
+	fake.iterateContextReturnsOnCall[i] = struct {
+		result1 *pivnet.ReleaseIterator
+	}{result1}
+}
+
+func (fake *FakeReleasesAPI) LatestMatching(arg1 string, arg2 string) (pivnet.Release, error) {
+	fake.latestMatchingMutex.Lock()
+	ret, specificReturn := fake.latestMatchingReturnsOnCall[len(fake.latestMatchingArgsForCall)]
+	fake.latestMatchingArgsForCall = append(fake.latestMatchingArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.LatestMatchingStub
+	fakeReturns := fake.latestMatchingReturns
+	fake.recordInvocation("LatestMatching", []interface{}{arg1, arg2})
+	fake.latestMatchingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleasesAPI) LatestMatchingCallCount() int {
+	fake.latestMatchingMutex.RLock()
+	defer fake.latestMatchingMutex.RUnlock()
+	return len(fake.latestMatchingArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) LatestMatchingCalls(stub func(string, string) (pivnet.Release, error)) {
+	fake.latestMatchingMutex.Lock()
+	defer fake.latestMatchingMutex.Unlock()
+	fake.LatestMatchingStub = stub
+}
+
+func (fake *FakeReleasesAPI) LatestMatchingArgsForCall(i int) (string, string) {
+	fake.latestMatchingMutex.RLock()
+	defer fake.latestMatchingMutex.RUnlock()
+	argsForCall := fake.latestMatchingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReleasesAPI) LatestMatchingReturns(result1 pivnet.Release, result2 error) {
+	fake.latestMatchingMutex.Lock()
+	defer fake.latestMatchingMutex.Unlock()
+	fake.LatestMatchingStub = nil
+	fake.latestMatchingReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) LatestMatchingReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.latestMatchingMutex.Lock()
+	defer fake.latestMatchingMutex.Unlock()
+	fake.LatestMatchingStub = nil
+	if fake.latestMatchingReturnsOnCall == nil {
+		fake.latestMatchingReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.latestMatchingReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) LatestMatchingContext(arg1 context.Context, arg2 string, arg3 string) (pivnet.Release, error) {
+	fake.latestMatchingContextMutex.Lock()
+	ret, specificReturn := fake.latestMatchingContextReturnsOnCall[len(fake.latestMatchingContextArgsForCall)]
+	fake.latestMatchingContextArgsForCall = append(fake.latestMatchingContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.LatestMatchingContextStub
+	fakeReturns := fake.latestMatchingContextReturns
+	fake.recordInvocation("LatestMatchingContext", []interface{}{arg1, arg2, arg3})
+	fake.latestMatchingContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleasesAPI) LatestMatchingContextCallCount() int {
+	fake.latestMatchingContextMutex.RLock()
+	defer fake.latestMatchingContextMutex.RUnlock()
+	return len(fake.latestMatchingContextArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) LatestMatchingContextCalls(stub func(context.Context, string, string) (pivnet.Release, error)) {
+	fake.latestMatchingContextMutex.Lock()
+	defer fake.latestMatchingContextMutex.Unlock()
+	fake.LatestMatchingContextStub = stub
+}
+
+func (fake *FakeReleasesAPI) LatestMatchingContextArgsForCall(i int) (context.Context, string, string) {
+	fake.latestMatchingContextMutex.RLock()
+	defer fake.latestMatchingContextMutex.RUnlock()
+	argsForCall := fake.latestMatchingContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeReleasesAPI) LatestMatchingContextReturns(result1 pivnet.Release, result2 error) {
+	fake.latestMatchingContextMutex.Lock()
+	defer fake.latestMatchingContextMutex.Unlock()
+	fake.LatestMatchingContextStub = nil
+	fake.latestMatchingContextReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) LatestMatchingContextReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.latestMatchingContextMutex.Lock()
+	defer fake.latestMatchingContextMutex.Unlock()
+	fake.LatestMatchingContextStub = nil
+	if fake.latestMatchingContextReturnsOnCall == nil {
+		fake.latestMatchingContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.latestMatchingContextReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) List(arg1 string, arg2 ...pivnet.QueryParameter) ([]pivnet.Release, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 string
+		arg2 []pivnet.QueryParameter
+	}{arg1, arg2})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{arg1, arg2})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleasesAPI) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) ListCalls(stub func(string, ...pivnet.QueryParameter) ([]pivnet.Release, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeReleasesAPI) ListArgsForCall(i int) (string, []pivnet.QueryParameter) {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	argsForCall := fake.listArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReleasesAPI) ListReturns(result1 []pivnet.Release, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) ListReturnsOnCall(i int, result1 []pivnet.Release, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.Release
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) ListAll(arg1 string, arg2 pivnet.ListOptions) ([]pivnet.Release, error) {
+	fake.listAllMutex.Lock()
+	ret, specificReturn := fake.listAllReturnsOnCall[len(fake.listAllArgsForCall)]
+	fake.listAllArgsForCall = append(fake.listAllArgsForCall, struct {
+		arg1 string
+		arg2 pivnet.ListOptions
+	}{arg1, arg2})
+	stub := fake.ListAllStub
+	fakeReturns := fake.listAllReturns
+	fake.recordInvocation("ListAll", []interface{}{arg1, arg2})
+	fake.listAllMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleasesAPI) ListAllCallCount() int {
+	fake.listAllMutex.RLock()
+	defer fake.listAllMutex.RUnlock()
+	return len(fake.listAllArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) ListAllCalls(stub func(string, pivnet.ListOptions) ([]pivnet.Release, error)) {
+	fake.listAllMutex.Lock()
+	defer fake.listAllMutex.Unlock()
+	fake.ListAllStub = stub
+}
+
+func (fake *FakeReleasesAPI) ListAllArgsForCall(i int) (string, pivnet.ListOptions) {
+	fake.listAllMutex.RLock()
+	defer fake.listAllMutex.RUnlock()
+	argsForCall := fake.listAllArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReleasesAPI) ListAllReturns(result1 []pivnet.Release, result2 error) {
+	fake.listAllMutex.Lock()
+	defer fake.listAllMutex.Unlock()
+	fake.ListAllStub = nil
+	fake.listAllReturns = struct {
+		result1 []pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) ListAllReturnsOnCall(i int, result1 []pivnet.Release, result2 error) {
+	fake.listAllMutex.Lock()
+	defer fake.listAllMutex.Unlock()
+	fake.ListAllStub = nil
+	if fake.listAllReturnsOnCall == nil {
+		fake.listAllReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.Release
+			result2 error
+		})
+	}
+	fake.listAllReturnsOnCall[i] = struct {
+		result1 []pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) ListAllContext(arg1 context.Context, arg2 string, arg3 pivnet.ListOptions) ([]pivnet.Release, error) {
+	fake.listAllContextMutex.Lock()
+	ret, specificReturn := fake.listAllContextReturnsOnCall[len(fake.listAllContextArgsForCall)]
+	fake.listAllContextArgsForCall = append(fake.listAllContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 pivnet.ListOptions
+	}{arg1, arg2, arg3})
+	stub := fake.ListAllContextStub
+	fakeReturns := fake.listAllContextReturns
+	fake.recordInvocation("ListAllContext", []interface{}{arg1, arg2, arg3})
+	fake.listAllContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleasesAPI) ListAllContextCallCount() int {
+	fake.listAllContextMutex.RLock()
+	defer fake.listAllContextMutex.RUnlock()
+	return len(fake.listAllContextArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) ListAllContextCalls(stub func(context.Context, string, pivnet.ListOptions) ([]pivnet.Release, error)) {
+	fake.listAllContextMutex.Lock()
+	defer fake.listAllContextMutex.Unlock()
+	fake.ListAllContextStub = stub
+}
+
+func (fake *FakeReleasesAPI) ListAllContextArgsForCall(i int) (context.Context, string, pivnet.ListOptions) {
+	fake.listAllContextMutex.RLock()
+	defer fake.listAllContextMutex.RUnlock()
+	argsForCall := fake.listAllContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeReleasesAPI) ListAllContextReturns(result1 []pivnet.Release, result2 error) {
+	fake.listAllContextMutex.Lock()
+	defer fake.listAllContextMutex.Unlock()
+	fake.ListAllContextStub = nil
+	fake.listAllContextReturns = struct {
+		result1 []pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) ListAllContextReturnsOnCall(i int, result1 []pivnet.Release, result2 error) {
+	fake.listAllContextMutex.Lock()
+	defer fake.listAllContextMutex.Unlock()
+	fake.ListAllContextStub = nil
+	if fake.listAllContextReturnsOnCall == nil {
+		fake.listAllContextReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.Release
+			result2 error
+		})
+	}
+	fake.listAllContextReturnsOnCall[i] = struct {
+		result1 []pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) ListContext(arg1 context.Context, arg2 string, arg3 ...pivnet.QueryParameter) ([]pivnet.Release, error) {
+	fake.listContextMutex.Lock()
+	ret, specificReturn := fake.listContextReturnsOnCall[len(fake.listContextArgsForCall)]
+	fake.listContextArgsForCall = append(fake.listContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []pivnet.QueryParameter
+	}{arg1, arg2, arg3})
+	stub := fake.ListContextStub
+	fakeReturns := fake.listContextReturns
+	fake.recordInvocation("ListContext", []interface{}{arg1, arg2, arg3})
+	fake.listContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleasesAPI) ListContextCallCount() int {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	return len(fake.listContextArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) ListContextCalls(stub func(context.Context, string, ...pivnet.QueryParameter) ([]pivnet.Release, error)) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = stub
+}
+
+func (fake *FakeReleasesAPI) ListContextArgsForCall(i int) (context.Context, string, []pivnet.QueryParameter) {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	argsForCall := fake.listContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeReleasesAPI) ListContextReturns(result1 []pivnet.Release, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	fake.listContextReturns = struct {
+		result1 []pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) ListContextReturnsOnCall(i int, result1 []pivnet.Release, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	if fake.listContextReturnsOnCall == nil {
+		fake.listContextReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.Release
+			result2 error
+		})
+	}
+	fake.listContextReturnsOnCall[i] = struct {
+		result1 []pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) ListReachingEndOfSupport(arg1 string, arg2 time.Time, arg3 time.Time) ([]pivnet.Release, error) {
+	fake.listReachingEndOfSupportMutex.Lock()
+	ret, specificReturn := fake.listReachingEndOfSupportReturnsOnCall[len(fake.listReachingEndOfSupportArgsForCall)]
+	fake.listReachingEndOfSupportArgsForCall = append(fake.listReachingEndOfSupportArgsForCall, struct {
+		arg1 string
+		arg2 time.Time
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.ListReachingEndOfSupportStub
+	fakeReturns := fake.listReachingEndOfSupportReturns
+	fake.recordInvocation("ListReachingEndOfSupport", []interface{}{arg1, arg2, arg3})
+	fake.listReachingEndOfSupportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleasesAPI) ListReachingEndOfSupportCallCount() int {
+	fake.listReachingEndOfSupportMutex.RLock()
+	defer fake.listReachingEndOfSupportMutex.RUnlock()
+	return len(fake.listReachingEndOfSupportArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) ListReachingEndOfSupportCalls(stub func(string, time.Time, time.Time) ([]pivnet.Release, error)) {
+	fake.listReachingEndOfSupportMutex.Lock()
+	defer fake.listReachingEndOfSupportMutex.Unlock()
+	fake.ListReachingEndOfSupportStub = stub
+}
+
+func (fake *FakeReleasesAPI) ListReachingEndOfSupportArgsForCall(i int) (string, time.Time, time.Time) {
+	fake.listReachingEndOfSupportMutex.RLock()
+	defer fake.listReachingEndOfSupportMutex.RUnlock()
+	argsForCall := fake.listReachingEndOfSupportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeReleasesAPI) ListReachingEndOfSupportReturns(result1 []pivnet.Release, result2 error) {
+	fake.listReachingEndOfSupportMutex.Lock()
+	defer fake.listReachingEndOfSupportMutex.Unlock()
+	fake.ListReachingEndOfSupportStub = nil
+	fake.listReachingEndOfSupportReturns = struct {
+		result1 []pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) ListReachingEndOfSupportReturnsOnCall(i int, result1 []pivnet.Release, result2 error) {
+	fake.listReachingEndOfSupportMutex.Lock()
+	defer fake.listReachingEndOfSupportMutex.Unlock()
+	fake.ListReachingEndOfSupportStub = nil
+	if fake.listReachingEndOfSupportReturnsOnCall == nil {
+		fake.listReachingEndOfSupportReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.Release
+			result2 error
+		})
+	}
+	fake.listReachingEndOfSupportReturnsOnCall[i] = struct {
+		result1 []pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) ListReachingEndOfSupportContext(arg1 context.Context, arg2 string, arg3 time.Time, arg4 time.Time) ([]pivnet.Release, error) {
+	fake.listReachingEndOfSupportContextMutex.Lock()
+	ret, specificReturn := fake.listReachingEndOfSupportContextReturnsOnCall[len(fake.listReachingEndOfSupportContextArgsForCall)]
+	fake.listReachingEndOfSupportContextArgsForCall = append(fake.listReachingEndOfSupportContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListReachingEndOfSupportContextStub
+	fakeReturns := fake.listReachingEndOfSupportContextReturns
+	fake.recordInvocation("ListReachingEndOfSupportContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listReachingEndOfSupportContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleasesAPI) ListReachingEndOfSupportContextCallCount() int {
+	fake.listReachingEndOfSupportContextMutex.RLock()
+	defer fake.listReachingEndOfSupportContextMutex.RUnlock()
+	return len(fake.listReachingEndOfSupportContextArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) ListReachingEndOfSupportContextCalls(stub func(context.Context, string, time.Time, time.Time) ([]pivnet.Release, error)) {
+	fake.listReachingEndOfSupportContextMutex.Lock()
+	defer fake.listReachingEndOfSupportContextMutex.Unlock()
+	fake.ListReachingEndOfSupportContextStub = stub
+}
+
+func (fake *FakeReleasesAPI) ListReachingEndOfSupportContextArgsForCall(i int) (context.Context, string, time.Time, time.Time) {
+	fake.listReachingEndOfSupportContextMutex.RLock()
+	defer fake.listReachingEndOfSupportContextMutex.RUnlock()
+	argsForCall := fake.listReachingEndOfSupportContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeReleasesAPI) ListReachingEndOfSupportContextReturns(result1 []pivnet.Release, result2 error) {
+	fake.listReachingEndOfSupportContextMutex.Lock()
+	defer fake.listReachingEndOfSupportContextMutex.Unlock()
+	fake.ListReachingEndOfSupportContextStub = nil
+	fake.listReachingEndOfSupportContextReturns = struct {
+		result1 []pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) ListReachingEndOfSupportContextReturnsOnCall(i int, result1 []pivnet.Release, result2 error) {
+	fake.listReachingEndOfSupportContextMutex.Lock()
+	defer fake.listReachingEndOfSupportContextMutex.Unlock()
+	fake.ListReachingEndOfSupportContextStub = nil
+	if fake.listReachingEndOfSupportContextReturnsOnCall == nil {
+		fake.listReachingEndOfSupportContextReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.Release
+			result2 error
+		})
+	}
+	fake.listReachingEndOfSupportContextReturnsOnCall[i] = struct {
+		result1 []pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) Promote(arg1 string, arg2 int, arg3 pivnet.Availability, arg4 pivnet.PromoteReleaseOptions) (pivnet.Release, error) {
+	fake.promoteMutex.Lock()
+	ret, specificReturn := fake.promoteReturnsOnCall[len(fake.promoteArgsForCall)]
+	fake.promoteArgsForCall = append(fake.promoteArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 pivnet.Availability
+		arg4 pivnet.PromoteReleaseOptions
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PromoteStub
+	fakeReturns := fake.promoteReturns
+	fake.recordInvocation("Promote", []interface{}{arg1, arg2, arg3, arg4})
+	fake.promoteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleasesAPI) PromoteCallCount() int {
+	fake.promoteMutex.RLock()
+	defer fake.promoteMutex.RUnlock()
+	return len(fake.promoteArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) PromoteCalls(stub func(string, int, pivnet.Availability, pivnet.PromoteReleaseOptions) (pivnet.Release, error)) {
+	fake.promoteMutex.Lock()
+	defer fake.promoteMutex.Unlock()
+	fake.PromoteStub = stub
+}
+
+func (fake *FakeReleasesAPI) PromoteArgsForCall(i int) (string, int, pivnet.Availability, pivnet.PromoteReleaseOptions) {
+	fake.promoteMutex.RLock()
+	defer fake.promoteMutex.RUnlock()
+	argsForCall := fake.promoteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeReleasesAPI) PromoteReturns(result1 pivnet.Release, result2 error) {
+	fake.promoteMutex.Lock()
+	defer fake.promoteMutex.Unlock()
+	fake.PromoteStub = nil
+	fake.promoteReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) PromoteReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.promoteMutex.Lock()
+	defer fake.promoteMutex.Unlock()
+	fake.PromoteStub = nil
+	if fake.promoteReturnsOnCall == nil {
+		fake.promoteReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.promoteReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) PromoteContext(arg1 context.Context, arg2 string, arg3 int, arg4 pivnet.Availability, arg5 pivnet.PromoteReleaseOptions) (pivnet.Release, error) {
+	fake.promoteContextMutex.Lock()
+	ret, specificReturn := fake.promoteContextReturnsOnCall[len(fake.promoteContextArgsForCall)]
+	fake.promoteContextArgsForCall = append(fake.promoteContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 pivnet.Availability
+		arg5 pivnet.PromoteReleaseOptions
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.PromoteContextStub
+	fakeReturns := fake.promoteContextReturns
+	fake.recordInvocation("PromoteContext", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.promoteContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleasesAPI) PromoteContextCallCount() int {
+	fake.promoteContextMutex.RLock()
+	defer fake.promoteContextMutex.RUnlock()
+	return len(fake.promoteContextArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) PromoteContextCalls(stub func(context.Context, string, int, pivnet.Availability, pivnet.PromoteReleaseOptions) (pivnet.Release, error)) {
+	fake.promoteContextMutex.Lock()
+	defer fake.promoteContextMutex.Unlock()
+	fake.PromoteContextStub = stub
+}
+
+func (fake *FakeReleasesAPI) PromoteContextArgsForCall(i int) (context.Context, string, int, pivnet.Availability, pivnet.PromoteReleaseOptions) {
+	fake.promoteContextMutex.RLock()
+	defer fake.promoteContextMutex.RUnlock()
+	argsForCall := fake.promoteContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeReleasesAPI) PromoteContextReturns(result1 pivnet.Release, result2 error) {
+	fake.promoteContextMutex.Lock()
+	defer fake.promoteContextMutex.Unlock()
+	fake.PromoteContextStub = nil
+	fake.promoteContextReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) PromoteContextReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.promoteContextMutex.Lock()
+	defer fake.promoteContextMutex.Unlock()
+	fake.PromoteContextStub = nil
+	if fake.promoteContextReturnsOnCall == nil {
+		fake.promoteContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.promoteContextReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) Update(arg1 string, arg2 pivnet.Release) (pivnet.Release, error) {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 string
+		arg2 pivnet.Release
+	}{arg1, arg2})
+	stub := fake.UpdateStub
+	fakeReturns := fake.updateReturns
+	fake.recordInvocation("Update", []interface{}{arg1, arg2})
+	fake.updateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleasesAPI) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) UpdateCalls(stub func(string, pivnet.Release) (pivnet.Release, error)) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = stub
+}
+
+func (fake *FakeReleasesAPI) UpdateArgsForCall(i int) (string, pivnet.Release) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	argsForCall := fake.updateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReleasesAPI) UpdateReturns(result1 pivnet.Release, result2 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) UpdateReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) UpdateContext(arg1 context.Context, arg2 string, arg3 pivnet.Release) (pivnet.Release, error) {
+	fake.updateContextMutex.Lock()
+	ret, specificReturn := fake.updateContextReturnsOnCall[len(fake.updateContextArgsForCall)]
+	fake.updateContextArgsForCall = append(fake.updateContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 pivnet.Release
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateContextStub
+	fakeReturns := fake.updateContextReturns
+	fake.recordInvocation("UpdateContext", []interface{}{arg1, arg2, arg3})
+	fake.updateContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleasesAPI) UpdateContextCallCount() int {
+	fake.updateContextMutex.RLock()
+	defer fake.updateContextMutex.RUnlock()
+	return len(fake.updateContextArgsForCall)
+}
+
+func (fake *FakeReleasesAPI) UpdateContextCalls(stub func(context.Context, string, pivnet.Release) (pivnet.Release, error)) {
+	fake.updateContextMutex.Lock()
+	defer fake.updateContextMutex.Unlock()
+	fake.UpdateContextStub = stub
+}
+
+func (fake *FakeReleasesAPI) UpdateContextArgsForCall(i int) (context.Context, string, pivnet.Release) {
+	fake.updateContextMutex.RLock()
+	defer fake.updateContextMutex.RUnlock()
+	argsForCall := fake.updateContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeReleasesAPI) UpdateContextReturns(result1 pivnet.Release, result2 error) {
+	fake.updateContextMutex.Lock()
+	defer fake.updateContextMutex.Unlock()
+	fake.UpdateContextStub = nil
+	fake.updateContextReturns = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) UpdateContextReturnsOnCall(i int, result1 pivnet.Release, result2 error) {
+	fake.updateContextMutex.Lock()
+	defer fake.updateContextMutex.Unlock()
+	fake.UpdateContextStub = nil
+	if fake.updateContextReturnsOnCall == nil {
+		fake.updateContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.Release
+			result2 error
+		})
+	}
+	fake.updateContextReturnsOnCall[i] = struct {
+		result1 pivnet.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleasesAPI) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cloneMutex.RLock()
+	defer fake.cloneMutex.RUnlock()
+	fake.cloneContextMutex.RLock()
+	defer fake.cloneContextMutex.RUnlock()
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.getByVersionMutex.RLock()
+	defer fake.getByVersionMutex.RUnlock()
+	fake.getByVersionContextMutex.RLock()
+	defer fake.getByVersionContextMutex.RUnlock()
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	fake.iterateMutex.RLock()
+	defer fake.iterateMutex.RUnlock()
+	fake.iterateContextMutex.RLock()
+	defer fake.iterateContextMutex.RUnlock()
+	fake.latestMatchingMutex.RLock()
+	defer fake.latestMatchingMutex.RUnlock()
+	fake.latestMatchingContextMutex.RLock()
+	defer fake.latestMatchingContextMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.listAllMutex.RLock()
+	defer fake.listAllMutex.RUnlock()
+	fake.listAllContextMutex.RLock()
+	defer fake.listAllContextMutex.RUnlock()
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	fake.listReachingEndOfSupportMutex.RLock()
+	defer fake.listReachingEndOfSupportMutex.RUnlock()
+	fake.listReachingEndOfSupportContextMutex.RLock()
+	defer fake.listReachingEndOfSupportContextMutex.RUnlock()
+	fake.promoteMutex.RLock()
+	defer fake.promoteMutex.RUnlock()
+	fake.promoteContextMutex.RLock()
+	defer fake.promoteContextMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	fake.updateContextMutex.RLock()
+	defer fake.updateContextMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeReleasesAPI) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pivnet.ReleasesAPI = new(FakeReleasesAPI)
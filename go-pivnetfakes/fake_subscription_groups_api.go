@@ -0,0 +1,679 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package gopivnetfakes
+
+import (
+	"context"
+	"sync"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v9"
+)
+
+type FakeSubscriptionGroupsAPI struct {
+	AddMemberStub        func(int, string, string) (pivnet.SubscriptionGroup, error)
+	addMemberMutex       sync.RWMutex
+	addMemberArgsForCall []struct {
+		arg1 int
+		arg2 string
+		arg3 string
+	}
+	addMemberReturns struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}
+	addMemberReturnsOnCall map[int]struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}
+	AddMemberContextStub        func(context.Context, int, string, string) (pivnet.SubscriptionGroup, error)
+	addMemberContextMutex       sync.RWMutex
+	addMemberContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+		arg4 string
+	}
+	addMemberContextReturns struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}
+	addMemberContextReturnsOnCall map[int]struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}
+	GetStub        func(int) (pivnet.SubscriptionGroup, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 int
+	}
+	getReturns struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}
+	GetContextStub        func(context.Context, int) (pivnet.SubscriptionGroup, error)
+	getContextMutex       sync.RWMutex
+	getContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	getContextReturns struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}
+	getContextReturnsOnCall map[int]struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}
+	ListStub        func() ([]pivnet.SubscriptionGroup, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+	}
+	listReturns struct {
+		result1 []pivnet.SubscriptionGroup
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []pivnet.SubscriptionGroup
+		result2 error
+	}
+	ListContextStub        func(context.Context) ([]pivnet.SubscriptionGroup, error)
+	listContextMutex       sync.RWMutex
+	listContextArgsForCall []struct {
+		arg1 context.Context
+	}
+	listContextReturns struct {
+		result1 []pivnet.SubscriptionGroup
+		result2 error
+	}
+	listContextReturnsOnCall map[int]struct {
+		result1 []pivnet.SubscriptionGroup
+		result2 error
+	}
+	RemoveMemberStub        func(int, string) (pivnet.SubscriptionGroup, error)
+	removeMemberMutex       sync.RWMutex
+	removeMemberArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	removeMemberReturns struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}
+	removeMemberReturnsOnCall map[int]struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}
+	RemoveMemberContextStub        func(context.Context, int, string) (pivnet.SubscriptionGroup, error)
+	removeMemberContextMutex       sync.RWMutex
+	removeMemberContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+	}
+	removeMemberContextReturns struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}
+	removeMemberContextReturnsOnCall map[int]struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSubscriptionGroupsAPI) AddMember(arg1 int, arg2 string, arg3 string) (pivnet.SubscriptionGroup, error) {
+	fake.addMemberMutex.Lock()
+	ret, specificReturn := fake.addMemberReturnsOnCall[len(fake.addMemberArgsForCall)]
+	fake.addMemberArgsForCall = append(fake.addMemberArgsForCall, struct {
+		arg1 int
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.AddMemberStub
+	fakeReturns := fake.addMemberReturns
+	fake.recordInvocation("AddMember", []interface{}{arg1, arg2, arg3})
+	fake.addMemberMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSubscriptionGroupsAPI) AddMemberCallCount() int {
+	fake.addMemberMutex.RLock()
+	defer fake.addMemberMutex.RUnlock()
+	return len(fake.addMemberArgsForCall)
+}
+
+func (fake *FakeSubscriptionGroupsAPI) AddMemberCalls(stub func(int, string, string) (pivnet.SubscriptionGroup, error)) {
+	fake.addMemberMutex.Lock()
+	defer fake.addMemberMutex.Unlock()
+	fake.AddMemberStub = stub
+}
+
+func (fake *FakeSubscriptionGroupsAPI) AddMemberArgsForCall(i int) (int, string, string) {
+	fake.addMemberMutex.RLock()
+	defer fake.addMemberMutex.RUnlock()
+	argsForCall := fake.addMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSubscriptionGroupsAPI) AddMemberReturns(result1 pivnet.SubscriptionGroup, result2 error) {
+	fake.addMemberMutex.Lock()
+	defer fake.addMemberMutex.Unlock()
+	fake.AddMemberStub = nil
+	fake.addMemberReturns = struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionGroupsAPI) AddMemberReturnsOnCall(i int, result1 pivnet.SubscriptionGroup, result2 error) {
+	fake.addMemberMutex.Lock()
+	defer fake.addMemberMutex.Unlock()
+	fake.AddMemberStub = nil
+	if fake.addMemberReturnsOnCall == nil {
+		fake.addMemberReturnsOnCall = make(map[int]struct {
+			result1 pivnet.SubscriptionGroup
+			result2 error
+		})
+	}
+	fake.addMemberReturnsOnCall[i] = struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionGroupsAPI) AddMemberContext(arg1 context.Context, arg2 int, arg3 string, arg4 string) (pivnet.SubscriptionGroup, error) {
+	fake.addMemberContextMutex.Lock()
+	ret, specificReturn := fake.addMemberContextReturnsOnCall[len(fake.addMemberContextArgsForCall)]
+	fake.addMemberContextArgsForCall = append(fake.addMemberContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AddMemberContextStub
+	fakeReturns := fake.addMemberContextReturns
+	fake.recordInvocation("AddMemberContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.addMemberContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSubscriptionGroupsAPI) AddMemberContextCallCount() int {
+	fake.addMemberContextMutex.RLock()
+	defer fake.addMemberContextMutex.RUnlock()
+	return len(fake.addMemberContextArgsForCall)
+}
+
+func (fake *FakeSubscriptionGroupsAPI) AddMemberContextCalls(stub func(context.Context, int, string, string) (pivnet.SubscriptionGroup, error)) {
+	fake.addMemberContextMutex.Lock()
+	defer fake.addMemberContextMutex.Unlock()
+	fake.AddMemberContextStub = stub
+}
+
+func (fake *FakeSubscriptionGroupsAPI) AddMemberContextArgsForCall(i int) (context.Context, int, string, string) {
+	fake.addMemberContextMutex.RLock()
+	defer fake.addMemberContextMutex.RUnlock()
+	argsForCall := fake.addMemberContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSubscriptionGroupsAPI) AddMemberContextReturns(result1 pivnet.SubscriptionGroup, result2 error) {
+	fake.addMemberContextMutex.Lock()
+	defer fake.addMemberContextMutex.Unlock()
+	fake.AddMemberContextStub = nil
+	fake.addMemberContextReturns = struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionGroupsAPI) AddMemberContextReturnsOnCall(i int, result1 pivnet.SubscriptionGroup, result2 error) {
+	fake.addMemberContextMutex.Lock()
+	defer fake.addMemberContextMutex.Unlock()
+	fake.AddMemberContextStub = nil
+	if fake.addMemberContextReturnsOnCall == nil {
+		fake.addMemberContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.SubscriptionGroup
+			result2 error
+		})
+	}
+	fake.addMemberContextReturnsOnCall[i] = struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionGroupsAPI) Get(arg1 int) (pivnet.SubscriptionGroup, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1})
+	fake.getMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSubscriptionGroupsAPI) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeSubscriptionGroupsAPI) GetCalls(stub func(int) (pivnet.SubscriptionGroup, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeSubscriptionGroupsAPI) GetArgsForCall(i int) int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSubscriptionGroupsAPI) GetReturns(result1 pivnet.SubscriptionGroup, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionGroupsAPI) GetReturnsOnCall(i int, result1 pivnet.SubscriptionGroup, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 pivnet.SubscriptionGroup
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionGroupsAPI) GetContext(arg1 context.Context, arg2 int) (pivnet.SubscriptionGroup, error) {
+	fake.getContextMutex.Lock()
+	ret, specificReturn := fake.getContextReturnsOnCall[len(fake.getContextArgsForCall)]
+	fake.getContextArgsForCall = append(fake.getContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetContextStub
+	fakeReturns := fake.getContextReturns
+	fake.recordInvocation("GetContext", []interface{}{arg1, arg2})
+	fake.getContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSubscriptionGroupsAPI) GetContextCallCount() int {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	return len(fake.getContextArgsForCall)
+}
+
+func (fake *FakeSubscriptionGroupsAPI) GetContextCalls(stub func(context.Context, int) (pivnet.SubscriptionGroup, error)) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = stub
+}
+
+func (fake *FakeSubscriptionGroupsAPI) GetContextArgsForCall(i int) (context.Context, int) {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	argsForCall := fake.getContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSubscriptionGroupsAPI) GetContextReturns(result1 pivnet.SubscriptionGroup, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	fake.getContextReturns = struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionGroupsAPI) GetContextReturnsOnCall(i int, result1 pivnet.SubscriptionGroup, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	if fake.getContextReturnsOnCall == nil {
+		fake.getContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.SubscriptionGroup
+			result2 error
+		})
+	}
+	fake.getContextReturnsOnCall[i] = struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionGroupsAPI) List() ([]pivnet.SubscriptionGroup, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+	}{})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSubscriptionGroupsAPI) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeSubscriptionGroupsAPI) ListCalls(stub func() ([]pivnet.SubscriptionGroup, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeSubscriptionGroupsAPI) ListReturns(result1 []pivnet.SubscriptionGroup, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []pivnet.SubscriptionGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionGroupsAPI) ListReturnsOnCall(i int, result1 []pivnet.SubscriptionGroup, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.SubscriptionGroup
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []pivnet.SubscriptionGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionGroupsAPI) ListContext(arg1 context.Context) ([]pivnet.SubscriptionGroup, error) {
+	fake.listContextMutex.Lock()
+	ret, specificReturn := fake.listContextReturnsOnCall[len(fake.listContextArgsForCall)]
+	fake.listContextArgsForCall = append(fake.listContextArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListContextStub
+	fakeReturns := fake.listContextReturns
+	fake.recordInvocation("ListContext", []interface{}{arg1})
+	fake.listContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSubscriptionGroupsAPI) ListContextCallCount() int {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	return len(fake.listContextArgsForCall)
+}
+
+func (fake *FakeSubscriptionGroupsAPI) ListContextCalls(stub func(context.Context) ([]pivnet.SubscriptionGroup, error)) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = stub
+}
+
+func (fake *FakeSubscriptionGroupsAPI) ListContextArgsForCall(i int) context.Context {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	argsForCall := fake.listContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSubscriptionGroupsAPI) ListContextReturns(result1 []pivnet.SubscriptionGroup, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	fake.listContextReturns = struct {
+		result1 []pivnet.SubscriptionGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionGroupsAPI) ListContextReturnsOnCall(i int, result1 []pivnet.SubscriptionGroup, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	if fake.listContextReturnsOnCall == nil {
+		fake.listContextReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.SubscriptionGroup
+			result2 error
+		})
+	}
+	fake.listContextReturnsOnCall[i] = struct {
+		result1 []pivnet.SubscriptionGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionGroupsAPI) RemoveMember(arg1 int, arg2 string) (pivnet.SubscriptionGroup, error) {
+	fake.removeMemberMutex.Lock()
+	ret, specificReturn := fake.removeMemberReturnsOnCall[len(fake.removeMemberArgsForCall)]
+	fake.removeMemberArgsForCall = append(fake.removeMemberArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RemoveMemberStub
+	fakeReturns := fake.removeMemberReturns
+	fake.recordInvocation("RemoveMember", []interface{}{arg1, arg2})
+	fake.removeMemberMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSubscriptionGroupsAPI) RemoveMemberCallCount() int {
+	fake.removeMemberMutex.RLock()
+	defer fake.removeMemberMutex.RUnlock()
+	return len(fake.removeMemberArgsForCall)
+}
+
+func (fake *FakeSubscriptionGroupsAPI) RemoveMemberCalls(stub func(int, string) (pivnet.SubscriptionGroup, error)) {
+	fake.removeMemberMutex.Lock()
+	defer fake.removeMemberMutex.Unlock()
+	fake.RemoveMemberStub = stub
+}
+
+func (fake *FakeSubscriptionGroupsAPI) RemoveMemberArgsForCall(i int) (int, string) {
+	fake.removeMemberMutex.RLock()
+	defer fake.removeMemberMutex.RUnlock()
+	argsForCall := fake.removeMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSubscriptionGroupsAPI) RemoveMemberReturns(result1 pivnet.SubscriptionGroup, result2 error) {
+	fake.removeMemberMutex.Lock()
+	defer fake.removeMemberMutex.Unlock()
+	fake.RemoveMemberStub = nil
+	fake.removeMemberReturns = struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionGroupsAPI) RemoveMemberReturnsOnCall(i int, result1 pivnet.SubscriptionGroup, result2 error) {
+	fake.removeMemberMutex.Lock()
+	defer fake.removeMemberMutex.Unlock()
+	fake.RemoveMemberStub = nil
+	if fake.removeMemberReturnsOnCall == nil {
+		fake.removeMemberReturnsOnCall = make(map[int]struct {
+			result1 pivnet.SubscriptionGroup
+			result2 error
+		})
+	}
+	fake.removeMemberReturnsOnCall[i] = struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionGroupsAPI) RemoveMemberContext(arg1 context.Context, arg2 int, arg3 string) (pivnet.SubscriptionGroup, error) {
+	fake.removeMemberContextMutex.Lock()
+	ret, specificReturn := fake.removeMemberContextReturnsOnCall[len(fake.removeMemberContextArgsForCall)]
+	fake.removeMemberContextArgsForCall = append(fake.removeMemberContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveMemberContextStub
+	fakeReturns := fake.removeMemberContextReturns
+	fake.recordInvocation("RemoveMemberContext", []interface{}{arg1, arg2, arg3})
+	fake.removeMemberContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSubscriptionGroupsAPI) RemoveMemberContextCallCount() int {
+	fake.removeMemberContextMutex.RLock()
+	defer fake.removeMemberContextMutex.RUnlock()
+	return len(fake.removeMemberContextArgsForCall)
+}
+
+func (fake *FakeSubscriptionGroupsAPI) RemoveMemberContextCalls(stub func(context.Context, int, string) (pivnet.SubscriptionGroup, error)) {
+	fake.removeMemberContextMutex.Lock()
+	defer fake.removeMemberContextMutex.Unlock()
+	fake.RemoveMemberContextStub = stub
+}
+
+func (fake *FakeSubscriptionGroupsAPI) RemoveMemberContextArgsForCall(i int) (context.Context, int, string) {
+	fake.removeMemberContextMutex.RLock()
+	defer fake.removeMemberContextMutex.RUnlock()
+	argsForCall := fake.removeMemberContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSubscriptionGroupsAPI) RemoveMemberContextReturns(result1 pivnet.SubscriptionGroup, result2 error) {
+	fake.removeMemberContextMutex.Lock()
+	defer fake.removeMemberContextMutex.Unlock()
+	fake.RemoveMemberContextStub = nil
+	fake.removeMemberContextReturns = struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionGroupsAPI) RemoveMemberContextReturnsOnCall(i int, result1 pivnet.SubscriptionGroup, result2 error) {
+	fake.removeMemberContextMutex.Lock()
+	defer fake.removeMemberContextMutex.Unlock()
+	fake.RemoveMemberContextStub = nil
+	if fake.removeMemberContextReturnsOnCall == nil {
+		fake.removeMemberContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.SubscriptionGroup
+			result2 error
+		})
+	}
+	fake.removeMemberContextReturnsOnCall[i] = struct {
+		result1 pivnet.SubscriptionGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSubscriptionGroupsAPI) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.addMemberMutex.RLock()
+	defer fake.addMemberMutex.RUnlock()
+	fake.addMemberContextMutex.RLock()
+	defer fake.addMemberContextMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	fake.removeMemberMutex.RLock()
+	defer fake.removeMemberMutex.RUnlock()
+	fake.removeMemberContextMutex.RLock()
+	defer fake.removeMemberContextMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSubscriptionGroupsAPI) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pivnet.SubscriptionGroupsAPI = new(FakeSubscriptionGroupsAPI)
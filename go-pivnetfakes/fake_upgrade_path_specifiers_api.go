@@ -0,0 +1,696 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package gopivnetfakes
+
+import (
+	"context"
+	"sync"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v9"
+)
+
+type FakeUpgradePathSpecifiersAPI struct {
+	CreateStub        func(string, int, string) (pivnet.UpgradePathSpecifier, error)
+	createMutex       sync.RWMutex
+	createArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 string
+	}
+	createReturns struct {
+		result1 pivnet.UpgradePathSpecifier
+		result2 error
+	}
+	createReturnsOnCall map[int]struct {
+		result1 pivnet.UpgradePathSpecifier
+		result2 error
+	}
+	CreateContextStub        func(context.Context, string, int, string) (pivnet.UpgradePathSpecifier, error)
+	createContextMutex       sync.RWMutex
+	createContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 string
+	}
+	createContextReturns struct {
+		result1 pivnet.UpgradePathSpecifier
+		result2 error
+	}
+	createContextReturnsOnCall map[int]struct {
+		result1 pivnet.UpgradePathSpecifier
+		result2 error
+	}
+	DeleteStub        func(string, int, int) error
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	deleteReturns struct {
+		result1 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteContextStub        func(context.Context, string, int, int) error
+	deleteContextMutex       sync.RWMutex
+	deleteContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	deleteContextReturns struct {
+		result1 error
+	}
+	deleteContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetStub        func(string, int, int) (pivnet.UpgradePathSpecifier, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	getReturns struct {
+		result1 pivnet.UpgradePathSpecifier
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 pivnet.UpgradePathSpecifier
+		result2 error
+	}
+	GetContextStub        func(context.Context, string, int, int) (pivnet.UpgradePathSpecifier, error)
+	getContextMutex       sync.RWMutex
+	getContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	getContextReturns struct {
+		result1 pivnet.UpgradePathSpecifier
+		result2 error
+	}
+	getContextReturnsOnCall map[int]struct {
+		result1 pivnet.UpgradePathSpecifier
+		result2 error
+	}
+	ListStub        func(string, int) ([]pivnet.UpgradePathSpecifier, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	listReturns struct {
+		result1 []pivnet.UpgradePathSpecifier
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []pivnet.UpgradePathSpecifier
+		result2 error
+	}
+	ListContextStub        func(context.Context, string, int) ([]pivnet.UpgradePathSpecifier, error)
+	listContextMutex       sync.RWMutex
+	listContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	listContextReturns struct {
+		result1 []pivnet.UpgradePathSpecifier
+		result2 error
+	}
+	listContextReturnsOnCall map[int]struct {
+		result1 []pivnet.UpgradePathSpecifier
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) Create(arg1 string, arg2 int, arg3 string) (pivnet.UpgradePathSpecifier, error) {
+	fake.createMutex.Lock()
+	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
+	fake.createArgsForCall = append(fake.createArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CreateStub
+	fakeReturns := fake.createReturns
+	fake.recordInvocation("Create", []interface{}{arg1, arg2, arg3})
+	fake.createMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) CreateCallCount() int {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return len(fake.createArgsForCall)
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) CreateCalls(stub func(string, int, string) (pivnet.UpgradePathSpecifier, error)) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = stub
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) CreateArgsForCall(i int) (string, int, string) {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	argsForCall := fake.createArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) CreateReturns(result1 pivnet.UpgradePathSpecifier, result2 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	fake.createReturns = struct {
+		result1 pivnet.UpgradePathSpecifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) CreateReturnsOnCall(i int, result1 pivnet.UpgradePathSpecifier, result2 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 pivnet.UpgradePathSpecifier
+			result2 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 pivnet.UpgradePathSpecifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) CreateContext(arg1 context.Context, arg2 string, arg3 int, arg4 string) (pivnet.UpgradePathSpecifier, error) {
+	fake.createContextMutex.Lock()
+	ret, specificReturn := fake.createContextReturnsOnCall[len(fake.createContextArgsForCall)]
+	fake.createContextArgsForCall = append(fake.createContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateContextStub
+	fakeReturns := fake.createContextReturns
+	fake.recordInvocation("CreateContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) CreateContextCallCount() int {
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	return len(fake.createContextArgsForCall)
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) CreateContextCalls(stub func(context.Context, string, int, string) (pivnet.UpgradePathSpecifier, error)) {
+	fake.createContextMutex.Lock()
+	defer fake.createContextMutex.Unlock()
+	fake.CreateContextStub = stub
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) CreateContextArgsForCall(i int) (context.Context, string, int, string) {
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	argsForCall := fake.createContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) CreateContextReturns(result1 pivnet.UpgradePathSpecifier, result2 error) {
+	fake.createContextMutex.Lock()
+	defer fake.createContextMutex.Unlock()
+	fake.CreateContextStub = nil
+	fake.createContextReturns = struct {
+		result1 pivnet.UpgradePathSpecifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) CreateContextReturnsOnCall(i int, result1 pivnet.UpgradePathSpecifier, result2 error) {
+	fake.createContextMutex.Lock()
+	defer fake.createContextMutex.Unlock()
+	fake.CreateContextStub = nil
+	if fake.createContextReturnsOnCall == nil {
+		fake.createContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.UpgradePathSpecifier
+			result2 error
+		})
+	}
+	fake.createContextReturnsOnCall[i] = struct {
+		result1 pivnet.UpgradePathSpecifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) Delete(arg1 string, arg2 int, arg3 int) error {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteStub
+	fakeReturns := fake.deleteReturns
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2, arg3})
+	fake.deleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) DeleteCalls(stub func(string, int, int) error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = stub
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) DeleteArgsForCall(i int) (string, int, int) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	argsForCall := fake.deleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) DeleteReturns(result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) DeleteReturnsOnCall(i int, result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) DeleteContext(arg1 context.Context, arg2 string, arg3 int, arg4 int) error {
+	fake.deleteContextMutex.Lock()
+	ret, specificReturn := fake.deleteContextReturnsOnCall[len(fake.deleteContextArgsForCall)]
+	fake.deleteContextArgsForCall = append(fake.deleteContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DeleteContextStub
+	fakeReturns := fake.deleteContextReturns
+	fake.recordInvocation("DeleteContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.deleteContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) DeleteContextCallCount() int {
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	return len(fake.deleteContextArgsForCall)
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) DeleteContextCalls(stub func(context.Context, string, int, int) error) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = stub
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) DeleteContextArgsForCall(i int) (context.Context, string, int, int) {
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	argsForCall := fake.deleteContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) DeleteContextReturns(result1 error) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = nil
+	fake.deleteContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) DeleteContextReturnsOnCall(i int, result1 error) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = nil
+	if fake.deleteContextReturnsOnCall == nil {
+		fake.deleteContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) Get(arg1 string, arg2 int, arg3 int) (pivnet.UpgradePathSpecifier, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1, arg2, arg3})
+	fake.getMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) GetCalls(stub func(string, int, int) (pivnet.UpgradePathSpecifier, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) GetArgsForCall(i int) (string, int, int) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) GetReturns(result1 pivnet.UpgradePathSpecifier, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 pivnet.UpgradePathSpecifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) GetReturnsOnCall(i int, result1 pivnet.UpgradePathSpecifier, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 pivnet.UpgradePathSpecifier
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 pivnet.UpgradePathSpecifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) GetContext(arg1 context.Context, arg2 string, arg3 int, arg4 int) (pivnet.UpgradePathSpecifier, error) {
+	fake.getContextMutex.Lock()
+	ret, specificReturn := fake.getContextReturnsOnCall[len(fake.getContextArgsForCall)]
+	fake.getContextArgsForCall = append(fake.getContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetContextStub
+	fakeReturns := fake.getContextReturns
+	fake.recordInvocation("GetContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) GetContextCallCount() int {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	return len(fake.getContextArgsForCall)
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) GetContextCalls(stub func(context.Context, string, int, int) (pivnet.UpgradePathSpecifier, error)) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = stub
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) GetContextArgsForCall(i int) (context.Context, string, int, int) {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	argsForCall := fake.getContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) GetContextReturns(result1 pivnet.UpgradePathSpecifier, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	fake.getContextReturns = struct {
+		result1 pivnet.UpgradePathSpecifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) GetContextReturnsOnCall(i int, result1 pivnet.UpgradePathSpecifier, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	if fake.getContextReturnsOnCall == nil {
+		fake.getContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.UpgradePathSpecifier
+			result2 error
+		})
+	}
+	fake.getContextReturnsOnCall[i] = struct {
+		result1 pivnet.UpgradePathSpecifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) List(arg1 string, arg2 int) ([]pivnet.UpgradePathSpecifier, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{arg1, arg2})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) ListCalls(stub func(string, int) ([]pivnet.UpgradePathSpecifier, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) ListArgsForCall(i int) (string, int) {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	argsForCall := fake.listArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) ListReturns(result1 []pivnet.UpgradePathSpecifier, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []pivnet.UpgradePathSpecifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) ListReturnsOnCall(i int, result1 []pivnet.UpgradePathSpecifier, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.UpgradePathSpecifier
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []pivnet.UpgradePathSpecifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) ListContext(arg1 context.Context, arg2 string, arg3 int) ([]pivnet.UpgradePathSpecifier, error) {
+	fake.listContextMutex.Lock()
+	ret, specificReturn := fake.listContextReturnsOnCall[len(fake.listContextArgsForCall)]
+	fake.listContextArgsForCall = append(fake.listContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.ListContextStub
+	fakeReturns := fake.listContextReturns
+	fake.recordInvocation("ListContext", []interface{}{arg1, arg2, arg3})
+	fake.listContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) ListContextCallCount() int {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	return len(fake.listContextArgsForCall)
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) ListContextCalls(stub func(context.Context, string, int) ([]pivnet.UpgradePathSpecifier, error)) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = stub
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) ListContextArgsForCall(i int) (context.Context, string, int) {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	argsForCall := fake.listContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) ListContextReturns(result1 []pivnet.UpgradePathSpecifier, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	fake.listContextReturns = struct {
+		result1 []pivnet.UpgradePathSpecifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) ListContextReturnsOnCall(i int, result1 []pivnet.UpgradePathSpecifier, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	if fake.listContextReturnsOnCall == nil {
+		fake.listContextReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.UpgradePathSpecifier
+			result2 error
+		})
+	}
+	fake.listContextReturnsOnCall[i] = struct {
+		result1 []pivnet.UpgradePathSpecifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeUpgradePathSpecifiersAPI) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pivnet.UpgradePathSpecifiersAPI = new(FakeUpgradePathSpecifiersAPI)
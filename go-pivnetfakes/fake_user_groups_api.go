@@ -0,0 +1,1647 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package gopivnetfakes
+
+import (
+	"context"
+	"sync"
+
+	pivnet "github.com/pivotal-cf/go-pivnet/v9"
+)
+
+type FakeUserGroupsAPI struct {
+	AddMemberToGroupStub        func(int, string, bool) (pivnet.UserGroup, error)
+	addMemberToGroupMutex       sync.RWMutex
+	addMemberToGroupArgsForCall []struct {
+		arg1 int
+		arg2 string
+		arg3 bool
+	}
+	addMemberToGroupReturns struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}
+	addMemberToGroupReturnsOnCall map[int]struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}
+	AddMemberToGroupContextStub        func(context.Context, int, string, bool) (pivnet.UserGroup, error)
+	addMemberToGroupContextMutex       sync.RWMutex
+	addMemberToGroupContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+		arg4 bool
+	}
+	addMemberToGroupContextReturns struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}
+	addMemberToGroupContextReturnsOnCall map[int]struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}
+	AddToReleaseStub        func(string, int, int) error
+	addToReleaseMutex       sync.RWMutex
+	addToReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	addToReleaseReturns struct {
+		result1 error
+	}
+	addToReleaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddToReleaseContextStub        func(context.Context, string, int, int) error
+	addToReleaseContextMutex       sync.RWMutex
+	addToReleaseContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	addToReleaseContextReturns struct {
+		result1 error
+	}
+	addToReleaseContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateStub        func(string, string, []string) (pivnet.UserGroup, error)
+	createMutex       sync.RWMutex
+	createArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}
+	createReturns struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}
+	createReturnsOnCall map[int]struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}
+	CreateContextStub        func(context.Context, string, string, []string) (pivnet.UserGroup, error)
+	createContextMutex       sync.RWMutex
+	createContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []string
+	}
+	createContextReturns struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}
+	createContextReturnsOnCall map[int]struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}
+	DeleteStub        func(int) error
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 int
+	}
+	deleteReturns struct {
+		result1 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteContextStub        func(context.Context, int) error
+	deleteContextMutex       sync.RWMutex
+	deleteContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	deleteContextReturns struct {
+		result1 error
+	}
+	deleteContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetStub        func(int) (pivnet.UserGroup, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 int
+	}
+	getReturns struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}
+	GetContextStub        func(context.Context, int) (pivnet.UserGroup, error)
+	getContextMutex       sync.RWMutex
+	getContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	getContextReturns struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}
+	getContextReturnsOnCall map[int]struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}
+	ListStub        func() ([]pivnet.UserGroup, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+	}
+	listReturns struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}
+	ListContextStub        func(context.Context) ([]pivnet.UserGroup, error)
+	listContextMutex       sync.RWMutex
+	listContextArgsForCall []struct {
+		arg1 context.Context
+	}
+	listContextReturns struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}
+	listContextReturnsOnCall map[int]struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}
+	ListForReleaseStub        func(string, int) ([]pivnet.UserGroup, error)
+	listForReleaseMutex       sync.RWMutex
+	listForReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	listForReleaseReturns struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}
+	listForReleaseReturnsOnCall map[int]struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}
+	ListForReleaseContextStub        func(context.Context, string, int) ([]pivnet.UserGroup, error)
+	listForReleaseContextMutex       sync.RWMutex
+	listForReleaseContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	listForReleaseContextReturns struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}
+	listForReleaseContextReturnsOnCall map[int]struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}
+	RemoveFromReleaseStub        func(string, int, int) error
+	removeFromReleaseMutex       sync.RWMutex
+	removeFromReleaseArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	removeFromReleaseReturns struct {
+		result1 error
+	}
+	removeFromReleaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveFromReleaseContextStub        func(context.Context, string, int, int) error
+	removeFromReleaseContextMutex       sync.RWMutex
+	removeFromReleaseContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	removeFromReleaseContextReturns struct {
+		result1 error
+	}
+	removeFromReleaseContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveMemberFromGroupStub        func(int, string) (pivnet.UserGroup, error)
+	removeMemberFromGroupMutex       sync.RWMutex
+	removeMemberFromGroupArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	removeMemberFromGroupReturns struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}
+	removeMemberFromGroupReturnsOnCall map[int]struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}
+	RemoveMemberFromGroupContextStub        func(context.Context, int, string) (pivnet.UserGroup, error)
+	removeMemberFromGroupContextMutex       sync.RWMutex
+	removeMemberFromGroupContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+	}
+	removeMemberFromGroupContextReturns struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}
+	removeMemberFromGroupContextReturnsOnCall map[int]struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}
+	UpdateStub        func(pivnet.UserGroup) (pivnet.UserGroup, error)
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 pivnet.UserGroup
+	}
+	updateReturns struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}
+	UpdateContextStub        func(context.Context, pivnet.UserGroup) (pivnet.UserGroup, error)
+	updateContextMutex       sync.RWMutex
+	updateContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 pivnet.UserGroup
+	}
+	updateContextReturns struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}
+	updateContextReturnsOnCall map[int]struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUserGroupsAPI) AddMemberToGroup(arg1 int, arg2 string, arg3 bool) (pivnet.UserGroup, error) {
+	fake.addMemberToGroupMutex.Lock()
+	ret, specificReturn := fake.addMemberToGroupReturnsOnCall[len(fake.addMemberToGroupArgsForCall)]
+	fake.addMemberToGroupArgsForCall = append(fake.addMemberToGroupArgsForCall, struct {
+		arg1 int
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.AddMemberToGroupStub
+	fakeReturns := fake.addMemberToGroupReturns
+	fake.recordInvocation("AddMemberToGroup", []interface{}{arg1, arg2, arg3})
+	fake.addMemberToGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserGroupsAPI) AddMemberToGroupCallCount() int {
+	fake.addMemberToGroupMutex.RLock()
+	defer fake.addMemberToGroupMutex.RUnlock()
+	return len(fake.addMemberToGroupArgsForCall)
+}
+
+func (fake *FakeUserGroupsAPI) AddMemberToGroupCalls(stub func(int, string, bool) (pivnet.UserGroup, error)) {
+	fake.addMemberToGroupMutex.Lock()
+	defer fake.addMemberToGroupMutex.Unlock()
+	fake.AddMemberToGroupStub = stub
+}
+
+func (fake *FakeUserGroupsAPI) AddMemberToGroupArgsForCall(i int) (int, string, bool) {
+	fake.addMemberToGroupMutex.RLock()
+	defer fake.addMemberToGroupMutex.RUnlock()
+	argsForCall := fake.addMemberToGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserGroupsAPI) AddMemberToGroupReturns(result1 pivnet.UserGroup, result2 error) {
+	fake.addMemberToGroupMutex.Lock()
+	defer fake.addMemberToGroupMutex.Unlock()
+	fake.AddMemberToGroupStub = nil
+	fake.addMemberToGroupReturns = struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) AddMemberToGroupReturnsOnCall(i int, result1 pivnet.UserGroup, result2 error) {
+	fake.addMemberToGroupMutex.Lock()
+	defer fake.addMemberToGroupMutex.Unlock()
+	fake.AddMemberToGroupStub = nil
+	if fake.addMemberToGroupReturnsOnCall == nil {
+		fake.addMemberToGroupReturnsOnCall = make(map[int]struct {
+			result1 pivnet.UserGroup
+			result2 error
+		})
+	}
+	fake.addMemberToGroupReturnsOnCall[i] = struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) AddMemberToGroupContext(arg1 context.Context, arg2 int, arg3 string, arg4 bool) (pivnet.UserGroup, error) {
+	fake.addMemberToGroupContextMutex.Lock()
+	ret, specificReturn := fake.addMemberToGroupContextReturnsOnCall[len(fake.addMemberToGroupContextArgsForCall)]
+	fake.addMemberToGroupContextArgsForCall = append(fake.addMemberToGroupContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+		arg4 bool
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AddMemberToGroupContextStub
+	fakeReturns := fake.addMemberToGroupContextReturns
+	fake.recordInvocation("AddMemberToGroupContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.addMemberToGroupContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserGroupsAPI) AddMemberToGroupContextCallCount() int {
+	fake.addMemberToGroupContextMutex.RLock()
+	defer fake.addMemberToGroupContextMutex.RUnlock()
+	return len(fake.addMemberToGroupContextArgsForCall)
+}
+
+func (fake *FakeUserGroupsAPI) AddMemberToGroupContextCalls(stub func(context.Context, int, string, bool) (pivnet.UserGroup, error)) {
+	fake.addMemberToGroupContextMutex.Lock()
+	defer fake.addMemberToGroupContextMutex.Unlock()
+	fake.AddMemberToGroupContextStub = stub
+}
+
+func (fake *FakeUserGroupsAPI) AddMemberToGroupContextArgsForCall(i int) (context.Context, int, string, bool) {
+	fake.addMemberToGroupContextMutex.RLock()
+	defer fake.addMemberToGroupContextMutex.RUnlock()
+	argsForCall := fake.addMemberToGroupContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeUserGroupsAPI) AddMemberToGroupContextReturns(result1 pivnet.UserGroup, result2 error) {
+	fake.addMemberToGroupContextMutex.Lock()
+	defer fake.addMemberToGroupContextMutex.Unlock()
+	fake.AddMemberToGroupContextStub = nil
+	fake.addMemberToGroupContextReturns = struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) AddMemberToGroupContextReturnsOnCall(i int, result1 pivnet.UserGroup, result2 error) {
+	fake.addMemberToGroupContextMutex.Lock()
+	defer fake.addMemberToGroupContextMutex.Unlock()
+	fake.AddMemberToGroupContextStub = nil
+	if fake.addMemberToGroupContextReturnsOnCall == nil {
+		fake.addMemberToGroupContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.UserGroup
+			result2 error
+		})
+	}
+	fake.addMemberToGroupContextReturnsOnCall[i] = struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) AddToRelease(arg1 string, arg2 int, arg3 int) error {
+	fake.addToReleaseMutex.Lock()
+	ret, specificReturn := fake.addToReleaseReturnsOnCall[len(fake.addToReleaseArgsForCall)]
+	fake.addToReleaseArgsForCall = append(fake.addToReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.AddToReleaseStub
+	fakeReturns := fake.addToReleaseReturns
+	fake.recordInvocation("AddToRelease", []interface{}{arg1, arg2, arg3})
+	fake.addToReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserGroupsAPI) AddToReleaseCallCount() int {
+	fake.addToReleaseMutex.RLock()
+	defer fake.addToReleaseMutex.RUnlock()
+	return len(fake.addToReleaseArgsForCall)
+}
+
+func (fake *FakeUserGroupsAPI) AddToReleaseCalls(stub func(string, int, int) error) {
+	fake.addToReleaseMutex.Lock()
+	defer fake.addToReleaseMutex.Unlock()
+	fake.AddToReleaseStub = stub
+}
+
+func (fake *FakeUserGroupsAPI) AddToReleaseArgsForCall(i int) (string, int, int) {
+	fake.addToReleaseMutex.RLock()
+	defer fake.addToReleaseMutex.RUnlock()
+	argsForCall := fake.addToReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserGroupsAPI) AddToReleaseReturns(result1 error) {
+	fake.addToReleaseMutex.Lock()
+	defer fake.addToReleaseMutex.Unlock()
+	fake.AddToReleaseStub = nil
+	fake.addToReleaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserGroupsAPI) AddToReleaseReturnsOnCall(i int, result1 error) {
+	fake.addToReleaseMutex.Lock()
+	defer fake.addToReleaseMutex.Unlock()
+	fake.AddToReleaseStub = nil
+	if fake.addToReleaseReturnsOnCall == nil {
+		fake.addToReleaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addToReleaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserGroupsAPI) AddToReleaseContext(arg1 context.Context, arg2 string, arg3 int, arg4 int) error {
+	fake.addToReleaseContextMutex.Lock()
+	ret, specificReturn := fake.addToReleaseContextReturnsOnCall[len(fake.addToReleaseContextArgsForCall)]
+	fake.addToReleaseContextArgsForCall = append(fake.addToReleaseContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AddToReleaseContextStub
+	fakeReturns := fake.addToReleaseContextReturns
+	fake.recordInvocation("AddToReleaseContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.addToReleaseContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserGroupsAPI) AddToReleaseContextCallCount() int {
+	fake.addToReleaseContextMutex.RLock()
+	defer fake.addToReleaseContextMutex.RUnlock()
+	return len(fake.addToReleaseContextArgsForCall)
+}
+
+func (fake *FakeUserGroupsAPI) AddToReleaseContextCalls(stub func(context.Context, string, int, int) error) {
+	fake.addToReleaseContextMutex.Lock()
+	defer fake.addToReleaseContextMutex.Unlock()
+	fake.AddToReleaseContextStub = stub
+}
+
+func (fake *FakeUserGroupsAPI) AddToReleaseContextArgsForCall(i int) (context.Context, string, int, int) {
+	fake.addToReleaseContextMutex.RLock()
+	defer fake.addToReleaseContextMutex.RUnlock()
+	argsForCall := fake.addToReleaseContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeUserGroupsAPI) AddToReleaseContextReturns(result1 error) {
+	fake.addToReleaseContextMutex.Lock()
+	defer fake.addToReleaseContextMutex.Unlock()
+	fake.AddToReleaseContextStub = nil
+	fake.addToReleaseContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserGroupsAPI) AddToReleaseContextReturnsOnCall(i int, result1 error) {
+	fake.addToReleaseContextMutex.Lock()
+	defer fake.addToReleaseContextMutex.Unlock()
+	fake.AddToReleaseContextStub = nil
+	if fake.addToReleaseContextReturnsOnCall == nil {
+		fake.addToReleaseContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addToReleaseContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserGroupsAPI) Create(arg1 string, arg2 string, arg3 []string) (pivnet.UserGroup, error) {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.createMutex.Lock()
+	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
+	fake.createArgsForCall = append(fake.createArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	stub := fake.CreateStub
+	fakeReturns := fake.createReturns
+	fake.recordInvocation("Create", []interface{}{arg1, arg2, arg3Copy})
+	fake.createMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserGroupsAPI) CreateCallCount() int {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return len(fake.createArgsForCall)
+}
+
+func (fake *FakeUserGroupsAPI) CreateCalls(stub func(string, string, []string) (pivnet.UserGroup, error)) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = stub
+}
+
+func (fake *FakeUserGroupsAPI) CreateArgsForCall(i int) (string, string, []string) {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	argsForCall := fake.createArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserGroupsAPI) CreateReturns(result1 pivnet.UserGroup, result2 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	fake.createReturns = struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) CreateReturnsOnCall(i int, result1 pivnet.UserGroup, result2 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 pivnet.UserGroup
+			result2 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) CreateContext(arg1 context.Context, arg2 string, arg3 string, arg4 []string) (pivnet.UserGroup, error) {
+	var arg4Copy []string
+	if arg4 != nil {
+		arg4Copy = make([]string, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.createContextMutex.Lock()
+	ret, specificReturn := fake.createContextReturnsOnCall[len(fake.createContextArgsForCall)]
+	fake.createContextArgsForCall = append(fake.createContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []string
+	}{arg1, arg2, arg3, arg4Copy})
+	stub := fake.CreateContextStub
+	fakeReturns := fake.createContextReturns
+	fake.recordInvocation("CreateContext", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.createContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserGroupsAPI) CreateContextCallCount() int {
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	return len(fake.createContextArgsForCall)
+}
+
+func (fake *FakeUserGroupsAPI) CreateContextCalls(stub func(context.Context, string, string, []string) (pivnet.UserGroup, error)) {
+	fake.createContextMutex.Lock()
+	defer fake.createContextMutex.Unlock()
+	fake.CreateContextStub = stub
+}
+
+func (fake *FakeUserGroupsAPI) CreateContextArgsForCall(i int) (context.Context, string, string, []string) {
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	argsForCall := fake.createContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeUserGroupsAPI) CreateContextReturns(result1 pivnet.UserGroup, result2 error) {
+	fake.createContextMutex.Lock()
+	defer fake.createContextMutex.Unlock()
+	fake.CreateContextStub = nil
+	fake.createContextReturns = struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) CreateContextReturnsOnCall(i int, result1 pivnet.UserGroup, result2 error) {
+	fake.createContextMutex.Lock()
+	defer fake.createContextMutex.Unlock()
+	fake.CreateContextStub = nil
+	if fake.createContextReturnsOnCall == nil {
+		fake.createContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.UserGroup
+			result2 error
+		})
+	}
+	fake.createContextReturnsOnCall[i] = struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) Delete(arg1 int) error {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.DeleteStub
+	fakeReturns := fake.deleteReturns
+	fake.recordInvocation("Delete", []interface{}{arg1})
+	fake.deleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserGroupsAPI) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeUserGroupsAPI) DeleteCalls(stub func(int) error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = stub
+}
+
+func (fake *FakeUserGroupsAPI) DeleteArgsForCall(i int) int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	argsForCall := fake.deleteArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUserGroupsAPI) DeleteReturns(result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserGroupsAPI) DeleteReturnsOnCall(i int, result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserGroupsAPI) DeleteContext(arg1 context.Context, arg2 int) error {
+	fake.deleteContextMutex.Lock()
+	ret, specificReturn := fake.deleteContextReturnsOnCall[len(fake.deleteContextArgsForCall)]
+	fake.deleteContextArgsForCall = append(fake.deleteContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.DeleteContextStub
+	fakeReturns := fake.deleteContextReturns
+	fake.recordInvocation("DeleteContext", []interface{}{arg1, arg2})
+	fake.deleteContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserGroupsAPI) DeleteContextCallCount() int {
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	return len(fake.deleteContextArgsForCall)
+}
+
+func (fake *FakeUserGroupsAPI) DeleteContextCalls(stub func(context.Context, int) error) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = stub
+}
+
+func (fake *FakeUserGroupsAPI) DeleteContextArgsForCall(i int) (context.Context, int) {
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	argsForCall := fake.deleteContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserGroupsAPI) DeleteContextReturns(result1 error) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = nil
+	fake.deleteContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserGroupsAPI) DeleteContextReturnsOnCall(i int, result1 error) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = nil
+	if fake.deleteContextReturnsOnCall == nil {
+		fake.deleteContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserGroupsAPI) Get(arg1 int) (pivnet.UserGroup, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1})
+	fake.getMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserGroupsAPI) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeUserGroupsAPI) GetCalls(stub func(int) (pivnet.UserGroup, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeUserGroupsAPI) GetArgsForCall(i int) int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUserGroupsAPI) GetReturns(result1 pivnet.UserGroup, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) GetReturnsOnCall(i int, result1 pivnet.UserGroup, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 pivnet.UserGroup
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) GetContext(arg1 context.Context, arg2 int) (pivnet.UserGroup, error) {
+	fake.getContextMutex.Lock()
+	ret, specificReturn := fake.getContextReturnsOnCall[len(fake.getContextArgsForCall)]
+	fake.getContextArgsForCall = append(fake.getContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetContextStub
+	fakeReturns := fake.getContextReturns
+	fake.recordInvocation("GetContext", []interface{}{arg1, arg2})
+	fake.getContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserGroupsAPI) GetContextCallCount() int {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	return len(fake.getContextArgsForCall)
+}
+
+func (fake *FakeUserGroupsAPI) GetContextCalls(stub func(context.Context, int) (pivnet.UserGroup, error)) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = stub
+}
+
+func (fake *FakeUserGroupsAPI) GetContextArgsForCall(i int) (context.Context, int) {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	argsForCall := fake.getContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserGroupsAPI) GetContextReturns(result1 pivnet.UserGroup, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	fake.getContextReturns = struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) GetContextReturnsOnCall(i int, result1 pivnet.UserGroup, result2 error) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	if fake.getContextReturnsOnCall == nil {
+		fake.getContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.UserGroup
+			result2 error
+		})
+	}
+	fake.getContextReturnsOnCall[i] = struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) List() ([]pivnet.UserGroup, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+	}{})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserGroupsAPI) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeUserGroupsAPI) ListCalls(stub func() ([]pivnet.UserGroup, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeUserGroupsAPI) ListReturns(result1 []pivnet.UserGroup, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) ListReturnsOnCall(i int, result1 []pivnet.UserGroup, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.UserGroup
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) ListContext(arg1 context.Context) ([]pivnet.UserGroup, error) {
+	fake.listContextMutex.Lock()
+	ret, specificReturn := fake.listContextReturnsOnCall[len(fake.listContextArgsForCall)]
+	fake.listContextArgsForCall = append(fake.listContextArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListContextStub
+	fakeReturns := fake.listContextReturns
+	fake.recordInvocation("ListContext", []interface{}{arg1})
+	fake.listContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserGroupsAPI) ListContextCallCount() int {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	return len(fake.listContextArgsForCall)
+}
+
+func (fake *FakeUserGroupsAPI) ListContextCalls(stub func(context.Context) ([]pivnet.UserGroup, error)) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = stub
+}
+
+func (fake *FakeUserGroupsAPI) ListContextArgsForCall(i int) context.Context {
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	argsForCall := fake.listContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUserGroupsAPI) ListContextReturns(result1 []pivnet.UserGroup, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	fake.listContextReturns = struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) ListContextReturnsOnCall(i int, result1 []pivnet.UserGroup, result2 error) {
+	fake.listContextMutex.Lock()
+	defer fake.listContextMutex.Unlock()
+	fake.ListContextStub = nil
+	if fake.listContextReturnsOnCall == nil {
+		fake.listContextReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.UserGroup
+			result2 error
+		})
+	}
+	fake.listContextReturnsOnCall[i] = struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) ListForRelease(arg1 string, arg2 int) ([]pivnet.UserGroup, error) {
+	fake.listForReleaseMutex.Lock()
+	ret, specificReturn := fake.listForReleaseReturnsOnCall[len(fake.listForReleaseArgsForCall)]
+	fake.listForReleaseArgsForCall = append(fake.listForReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ListForReleaseStub
+	fakeReturns := fake.listForReleaseReturns
+	fake.recordInvocation("ListForRelease", []interface{}{arg1, arg2})
+	fake.listForReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserGroupsAPI) ListForReleaseCallCount() int {
+	fake.listForReleaseMutex.RLock()
+	defer fake.listForReleaseMutex.RUnlock()
+	return len(fake.listForReleaseArgsForCall)
+}
+
+func (fake *FakeUserGroupsAPI) ListForReleaseCalls(stub func(string, int) ([]pivnet.UserGroup, error)) {
+	fake.listForReleaseMutex.Lock()
+	defer fake.listForReleaseMutex.Unlock()
+	fake.ListForReleaseStub = stub
+}
+
+func (fake *FakeUserGroupsAPI) ListForReleaseArgsForCall(i int) (string, int) {
+	fake.listForReleaseMutex.RLock()
+	defer fake.listForReleaseMutex.RUnlock()
+	argsForCall := fake.listForReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserGroupsAPI) ListForReleaseReturns(result1 []pivnet.UserGroup, result2 error) {
+	fake.listForReleaseMutex.Lock()
+	defer fake.listForReleaseMutex.Unlock()
+	fake.ListForReleaseStub = nil
+	fake.listForReleaseReturns = struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) ListForReleaseReturnsOnCall(i int, result1 []pivnet.UserGroup, result2 error) {
+	fake.listForReleaseMutex.Lock()
+	defer fake.listForReleaseMutex.Unlock()
+	fake.ListForReleaseStub = nil
+	if fake.listForReleaseReturnsOnCall == nil {
+		fake.listForReleaseReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.UserGroup
+			result2 error
+		})
+	}
+	fake.listForReleaseReturnsOnCall[i] = struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) ListForReleaseContext(arg1 context.Context, arg2 string, arg3 int) ([]pivnet.UserGroup, error) {
+	fake.listForReleaseContextMutex.Lock()
+	ret, specificReturn := fake.listForReleaseContextReturnsOnCall[len(fake.listForReleaseContextArgsForCall)]
+	fake.listForReleaseContextArgsForCall = append(fake.listForReleaseContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.ListForReleaseContextStub
+	fakeReturns := fake.listForReleaseContextReturns
+	fake.recordInvocation("ListForReleaseContext", []interface{}{arg1, arg2, arg3})
+	fake.listForReleaseContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserGroupsAPI) ListForReleaseContextCallCount() int {
+	fake.listForReleaseContextMutex.RLock()
+	defer fake.listForReleaseContextMutex.RUnlock()
+	return len(fake.listForReleaseContextArgsForCall)
+}
+
+func (fake *FakeUserGroupsAPI) ListForReleaseContextCalls(stub func(context.Context, string, int) ([]pivnet.UserGroup, error)) {
+	fake.listForReleaseContextMutex.Lock()
+	defer fake.listForReleaseContextMutex.Unlock()
+	fake.ListForReleaseContextStub = stub
+}
+
+func (fake *FakeUserGroupsAPI) ListForReleaseContextArgsForCall(i int) (context.Context, string, int) {
+	fake.listForReleaseContextMutex.RLock()
+	defer fake.listForReleaseContextMutex.RUnlock()
+	argsForCall := fake.listForReleaseContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserGroupsAPI) ListForReleaseContextReturns(result1 []pivnet.UserGroup, result2 error) {
+	fake.listForReleaseContextMutex.Lock()
+	defer fake.listForReleaseContextMutex.Unlock()
+	fake.ListForReleaseContextStub = nil
+	fake.listForReleaseContextReturns = struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) ListForReleaseContextReturnsOnCall(i int, result1 []pivnet.UserGroup, result2 error) {
+	fake.listForReleaseContextMutex.Lock()
+	defer fake.listForReleaseContextMutex.Unlock()
+	fake.ListForReleaseContextStub = nil
+	if fake.listForReleaseContextReturnsOnCall == nil {
+		fake.listForReleaseContextReturnsOnCall = make(map[int]struct {
+			result1 []pivnet.UserGroup
+			result2 error
+		})
+	}
+	fake.listForReleaseContextReturnsOnCall[i] = struct {
+		result1 []pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) RemoveFromRelease(arg1 string, arg2 int, arg3 int) error {
+	fake.removeFromReleaseMutex.Lock()
+	ret, specificReturn := fake.removeFromReleaseReturnsOnCall[len(fake.removeFromReleaseArgsForCall)]
+	fake.removeFromReleaseArgsForCall = append(fake.removeFromReleaseArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveFromReleaseStub
+	fakeReturns := fake.removeFromReleaseReturns
+	fake.recordInvocation("RemoveFromRelease", []interface{}{arg1, arg2, arg3})
+	fake.removeFromReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserGroupsAPI) RemoveFromReleaseCallCount() int {
+	fake.removeFromReleaseMutex.RLock()
+	defer fake.removeFromReleaseMutex.RUnlock()
+	return len(fake.removeFromReleaseArgsForCall)
+}
+
+func (fake *FakeUserGroupsAPI) RemoveFromReleaseCalls(stub func(string, int, int) error) {
+	fake.removeFromReleaseMutex.Lock()
+	defer fake.removeFromReleaseMutex.Unlock()
+	fake.RemoveFromReleaseStub = stub
+}
+
+func (fake *FakeUserGroupsAPI) RemoveFromReleaseArgsForCall(i int) (string, int, int) {
+	fake.removeFromReleaseMutex.RLock()
+	defer fake.removeFromReleaseMutex.RUnlock()
+	argsForCall := fake.removeFromReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserGroupsAPI) RemoveFromReleaseReturns(result1 error) {
+	fake.removeFromReleaseMutex.Lock()
+	defer fake.removeFromReleaseMutex.Unlock()
+	fake.RemoveFromReleaseStub = nil
+	fake.removeFromReleaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserGroupsAPI) RemoveFromReleaseReturnsOnCall(i int, result1 error) {
+	fake.removeFromReleaseMutex.Lock()
+	defer fake.removeFromReleaseMutex.Unlock()
+	fake.RemoveFromReleaseStub = nil
+	if fake.removeFromReleaseReturnsOnCall == nil {
+		fake.removeFromReleaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeFromReleaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserGroupsAPI) RemoveFromReleaseContext(arg1 context.Context, arg2 string, arg3 int, arg4 int) error {
+	fake.removeFromReleaseContextMutex.Lock()
+	ret, specificReturn := fake.removeFromReleaseContextReturnsOnCall[len(fake.removeFromReleaseContextArgsForCall)]
+	fake.removeFromReleaseContextArgsForCall = append(fake.removeFromReleaseContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RemoveFromReleaseContextStub
+	fakeReturns := fake.removeFromReleaseContextReturns
+	fake.recordInvocation("RemoveFromReleaseContext", []interface{}{arg1, arg2, arg3, arg4})
+	fake.removeFromReleaseContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserGroupsAPI) RemoveFromReleaseContextCallCount() int {
+	fake.removeFromReleaseContextMutex.RLock()
+	defer fake.removeFromReleaseContextMutex.RUnlock()
+	return len(fake.removeFromReleaseContextArgsForCall)
+}
+
+func (fake *FakeUserGroupsAPI) RemoveFromReleaseContextCalls(stub func(context.Context, string, int, int) error) {
+	fake.removeFromReleaseContextMutex.Lock()
+	defer fake.removeFromReleaseContextMutex.Unlock()
+	fake.RemoveFromReleaseContextStub = stub
+}
+
+func (fake *FakeUserGroupsAPI) RemoveFromReleaseContextArgsForCall(i int) (context.Context, string, int, int) {
+	fake.removeFromReleaseContextMutex.RLock()
+	defer fake.removeFromReleaseContextMutex.RUnlock()
+	argsForCall := fake.removeFromReleaseContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeUserGroupsAPI) RemoveFromReleaseContextReturns(result1 error) {
+	fake.removeFromReleaseContextMutex.Lock()
+	defer fake.removeFromReleaseContextMutex.Unlock()
+	fake.RemoveFromReleaseContextStub = nil
+	fake.removeFromReleaseContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserGroupsAPI) RemoveFromReleaseContextReturnsOnCall(i int, result1 error) {
+	fake.removeFromReleaseContextMutex.Lock()
+	defer fake.removeFromReleaseContextMutex.Unlock()
+	fake.RemoveFromReleaseContextStub = nil
+	if fake.removeFromReleaseContextReturnsOnCall == nil {
+		fake.removeFromReleaseContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeFromReleaseContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserGroupsAPI) RemoveMemberFromGroup(arg1 int, arg2 string) (pivnet.UserGroup, error) {
+	fake.removeMemberFromGroupMutex.Lock()
+	ret, specificReturn := fake.removeMemberFromGroupReturnsOnCall[len(fake.removeMemberFromGroupArgsForCall)]
+	fake.removeMemberFromGroupArgsForCall = append(fake.removeMemberFromGroupArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RemoveMemberFromGroupStub
+	fakeReturns := fake.removeMemberFromGroupReturns
+	fake.recordInvocation("RemoveMemberFromGroup", []interface{}{arg1, arg2})
+	fake.removeMemberFromGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserGroupsAPI) RemoveMemberFromGroupCallCount() int {
+	fake.removeMemberFromGroupMutex.RLock()
+	defer fake.removeMemberFromGroupMutex.RUnlock()
+	return len(fake.removeMemberFromGroupArgsForCall)
+}
+
+func (fake *FakeUserGroupsAPI) RemoveMemberFromGroupCalls(stub func(int, string) (pivnet.UserGroup, error)) {
+	fake.removeMemberFromGroupMutex.Lock()
+	defer fake.removeMemberFromGroupMutex.Unlock()
+	fake.RemoveMemberFromGroupStub = stub
+}
+
+func (fake *FakeUserGroupsAPI) RemoveMemberFromGroupArgsForCall(i int) (int, string) {
+	fake.removeMemberFromGroupMutex.RLock()
+	defer fake.removeMemberFromGroupMutex.RUnlock()
+	argsForCall := fake.removeMemberFromGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserGroupsAPI) RemoveMemberFromGroupReturns(result1 pivnet.UserGroup, result2 error) {
+	fake.removeMemberFromGroupMutex.Lock()
+	defer fake.removeMemberFromGroupMutex.Unlock()
+	fake.RemoveMemberFromGroupStub = nil
+	fake.removeMemberFromGroupReturns = struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) RemoveMemberFromGroupReturnsOnCall(i int, result1 pivnet.UserGroup, result2 error) {
+	fake.removeMemberFromGroupMutex.Lock()
+	defer fake.removeMemberFromGroupMutex.Unlock()
+	fake.RemoveMemberFromGroupStub = nil
+	if fake.removeMemberFromGroupReturnsOnCall == nil {
+		fake.removeMemberFromGroupReturnsOnCall = make(map[int]struct {
+			result1 pivnet.UserGroup
+			result2 error
+		})
+	}
+	fake.removeMemberFromGroupReturnsOnCall[i] = struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) RemoveMemberFromGroupContext(arg1 context.Context, arg2 int, arg3 string) (pivnet.UserGroup, error) {
+	fake.removeMemberFromGroupContextMutex.Lock()
+	ret, specificReturn := fake.removeMemberFromGroupContextReturnsOnCall[len(fake.removeMemberFromGroupContextArgsForCall)]
+	fake.removeMemberFromGroupContextArgsForCall = append(fake.removeMemberFromGroupContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveMemberFromGroupContextStub
+	fakeReturns := fake.removeMemberFromGroupContextReturns
+	fake.recordInvocation("RemoveMemberFromGroupContext", []interface{}{arg1, arg2, arg3})
+	fake.removeMemberFromGroupContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserGroupsAPI) RemoveMemberFromGroupContextCallCount() int {
+	fake.removeMemberFromGroupContextMutex.RLock()
+	defer fake.removeMemberFromGroupContextMutex.RUnlock()
+	return len(fake.removeMemberFromGroupContextArgsForCall)
+}
+
+func (fake *FakeUserGroupsAPI) RemoveMemberFromGroupContextCalls(stub func(context.Context, int, string) (pivnet.UserGroup, error)) {
+	fake.removeMemberFromGroupContextMutex.Lock()
+	defer fake.removeMemberFromGroupContextMutex.Unlock()
+	fake.RemoveMemberFromGroupContextStub = stub
+}
+
+func (fake *FakeUserGroupsAPI) RemoveMemberFromGroupContextArgsForCall(i int) (context.Context, int, string) {
+	fake.removeMemberFromGroupContextMutex.RLock()
+	defer fake.removeMemberFromGroupContextMutex.RUnlock()
+	argsForCall := fake.removeMemberFromGroupContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserGroupsAPI) RemoveMemberFromGroupContextReturns(result1 pivnet.UserGroup, result2 error) {
+	fake.removeMemberFromGroupContextMutex.Lock()
+	defer fake.removeMemberFromGroupContextMutex.Unlock()
+	fake.RemoveMemberFromGroupContextStub = nil
+	fake.removeMemberFromGroupContextReturns = struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) RemoveMemberFromGroupContextReturnsOnCall(i int, result1 pivnet.UserGroup, result2 error) {
+	fake.removeMemberFromGroupContextMutex.Lock()
+	defer fake.removeMemberFromGroupContextMutex.Unlock()
+	fake.RemoveMemberFromGroupContextStub = nil
+	if fake.removeMemberFromGroupContextReturnsOnCall == nil {
+		fake.removeMemberFromGroupContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.UserGroup
+			result2 error
+		})
+	}
+	fake.removeMemberFromGroupContextReturnsOnCall[i] = struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) Update(arg1 pivnet.UserGroup) (pivnet.UserGroup, error) {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 pivnet.UserGroup
+	}{arg1})
+	stub := fake.UpdateStub
+	fakeReturns := fake.updateReturns
+	fake.recordInvocation("Update", []interface{}{arg1})
+	fake.updateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserGroupsAPI) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeUserGroupsAPI) UpdateCalls(stub func(pivnet.UserGroup) (pivnet.UserGroup, error)) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = stub
+}
+
+func (fake *FakeUserGroupsAPI) UpdateArgsForCall(i int) pivnet.UserGroup {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	argsForCall := fake.updateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUserGroupsAPI) UpdateReturns(result1 pivnet.UserGroup, result2 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) UpdateReturnsOnCall(i int, result1 pivnet.UserGroup, result2 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 pivnet.UserGroup
+			result2 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) UpdateContext(arg1 context.Context, arg2 pivnet.UserGroup) (pivnet.UserGroup, error) {
+	fake.updateContextMutex.Lock()
+	ret, specificReturn := fake.updateContextReturnsOnCall[len(fake.updateContextArgsForCall)]
+	fake.updateContextArgsForCall = append(fake.updateContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 pivnet.UserGroup
+	}{arg1, arg2})
+	stub := fake.UpdateContextStub
+	fakeReturns := fake.updateContextReturns
+	fake.recordInvocation("UpdateContext", []interface{}{arg1, arg2})
+	fake.updateContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserGroupsAPI) UpdateContextCallCount() int {
+	fake.updateContextMutex.RLock()
+	defer fake.updateContextMutex.RUnlock()
+	return len(fake.updateContextArgsForCall)
+}
+
+func (fake *FakeUserGroupsAPI) UpdateContextCalls(stub func(context.Context, pivnet.UserGroup) (pivnet.UserGroup, error)) {
+	fake.updateContextMutex.Lock()
+	defer fake.updateContextMutex.Unlock()
+	fake.UpdateContextStub = stub
+}
+
+func (fake *FakeUserGroupsAPI) UpdateContextArgsForCall(i int) (context.Context, pivnet.UserGroup) {
+	fake.updateContextMutex.RLock()
+	defer fake.updateContextMutex.RUnlock()
+	argsForCall := fake.updateContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserGroupsAPI) UpdateContextReturns(result1 pivnet.UserGroup, result2 error) {
+	fake.updateContextMutex.Lock()
+	defer fake.updateContextMutex.Unlock()
+	fake.UpdateContextStub = nil
+	fake.updateContextReturns = struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) UpdateContextReturnsOnCall(i int, result1 pivnet.UserGroup, result2 error) {
+	fake.updateContextMutex.Lock()
+	defer fake.updateContextMutex.Unlock()
+	fake.UpdateContextStub = nil
+	if fake.updateContextReturnsOnCall == nil {
+		fake.updateContextReturnsOnCall = make(map[int]struct {
+			result1 pivnet.UserGroup
+			result2 error
+		})
+	}
+	fake.updateContextReturnsOnCall[i] = struct {
+		result1 pivnet.UserGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsAPI) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.addMemberToGroupMutex.RLock()
+	defer fake.addMemberToGroupMutex.RUnlock()
+	fake.addMemberToGroupContextMutex.RLock()
+	defer fake.addMemberToGroupContextMutex.RUnlock()
+	fake.addToReleaseMutex.RLock()
+	defer fake.addToReleaseMutex.RUnlock()
+	fake.addToReleaseContextMutex.RLock()
+	defer fake.addToReleaseContextMutex.RUnlock()
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.listContextMutex.RLock()
+	defer fake.listContextMutex.RUnlock()
+	fake.listForReleaseMutex.RLock()
+	defer fake.listForReleaseMutex.RUnlock()
+	fake.listForReleaseContextMutex.RLock()
+	defer fake.listForReleaseContextMutex.RUnlock()
+	fake.removeFromReleaseMutex.RLock()
+	defer fake.removeFromReleaseMutex.RUnlock()
+	fake.removeFromReleaseContextMutex.RLock()
+	defer fake.removeFromReleaseContextMutex.RUnlock()
+	fake.removeMemberFromGroupMutex.RLock()
+	defer fake.removeMemberFromGroupMutex.RUnlock()
+	fake.removeMemberFromGroupContextMutex.RLock()
+	defer fake.removeMemberFromGroupContextMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	fake.updateContextMutex.RLock()
+	defer fake.updateContextMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeUserGroupsAPI) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pivnet.UserGroupsAPI = new(FakeUserGroupsAPI)
@@ -5,4 +5,18 @@ type Links struct {
 	Download       map[string]string `json:"download,omitempty" yaml:"download,omitempty"`
 	ProductFiles   map[string]string `json:"product_files,omitempty" yaml:"product_files,omitempty"`
 	EULAAcceptance map[string]string `json:"eula_acceptance,omitempty" yaml:"eula_acceptance,omitempty"`
+	Self           map[string]string `json:"self,omitempty" yaml:"self,omitempty"`
+	First          map[string]string `json:"first,omitempty" yaml:"first,omitempty"`
+	Previous       map[string]string `json:"prev,omitempty" yaml:"prev,omitempty"`
+	Next           map[string]string `json:"next,omitempty" yaml:"next,omitempty"`
+	Last           map[string]string `json:"last,omitempty" yaml:"last,omitempty"`
+}
+
+// NextPage returns the link to the next page of a paginated response, or an
+// empty string on the last page.
+func (l *Links) NextPage() string {
+	if l == nil {
+		return ""
+	}
+	return l.Next["href"]
 }
@@ -0,0 +1,85 @@
+package pivnet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+const perPageParam = "per_page"
+
+// ListOptions controls how a paginated listing is requested.
+type ListOptions struct {
+	// PerPage is the page size requested from Pivnet. Zero leaves the page
+	// size up to the server.
+	PerPage int
+
+	// Params are additional query parameters sent with the first page
+	// request. Later pages are requested using the next links returned by
+	// Pivnet, which carry the parameters forward.
+	Params []QueryParameter
+}
+
+func (o ListOptions) queryParameters() []QueryParameter {
+	params := append([]QueryParameter{}, o.Params...)
+	if o.PerPage > 0 {
+		params = append(params, QueryParameter{Key: perPageParam, Value: strconv.Itoa(o.PerPage)})
+	}
+	return params
+}
+
+type paginatedResponse interface {
+	nextPage() string
+}
+
+// pager requests the pages of a paginated endpoint one at a time, following
+// the next links returned by Pivnet.
+type pager struct {
+	ctx      context.Context
+	client   Client
+	endpoint string
+	params   []QueryParameter
+	done     bool
+	err      error
+}
+
+func newPager(ctx context.Context, client Client, endpoint string, opts ListOptions) *pager {
+	return &pager{
+		ctx:      ctx,
+		client:   client,
+		endpoint: endpoint,
+		params:   opts.queryParameters(),
+	}
+}
+
+// next decodes the next page into response. It returns false once the last
+// page has been read or a request fails.
+func (p *pager) next(response paginatedResponse) bool {
+	if p.done || p.err != nil {
+		return false
+	}
+
+	resp, err := p.client.MakeRequestWithParamsContext(p.ctx, "GET", p.endpoint, http.StatusOK, p.params, nil)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	defer resp.Body.Close()
+
+	err = json.NewDecoder(resp.Body).Decode(response)
+	if err != nil {
+		p.err = err
+		return false
+	}
+
+	next := response.nextPage()
+	if next == "" || next == p.endpoint {
+		p.done = true
+	} else {
+		p.endpoint = next
+		p.params = nil
+	}
+
+	return true
+}
@@ -1,12 +1,13 @@
 package pivnet
 
 import (
+	"bytes"
+	"context"
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
-	"log"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/pivotal-cf/go-pivnet/v9/download"
 	"github.com/pivotal-cf/go-pivnet/v9/logger"
+	"github.com/pivotal-cf/go-pivnet/v9/upload"
 )
 
 const (
@@ -29,10 +31,12 @@ type Client struct {
 	userAgent     string
 	logger        logger.Logger
 	usingUAAToken bool
+	retryPolicy   RetryPolicy
 
 	HTTP *http.Client
 
 	downloader download.Client
+	uploader   upload.Client
 
 	Auth                  *AuthService
 	EULA                  *EULAsService
@@ -58,6 +62,7 @@ type AccessTokenOrLegacyToken struct {
 	skipSSLValidation bool
 	userAgent         string
 	proxyAuthConfig   ProxyAuthConfig
+	cache             *accessTokenCache
 }
 
 type QueryParameter struct {
@@ -65,15 +70,35 @@ type QueryParameter struct {
 	Value string
 }
 
+const legacyAPITokenLength = 20
+
+// AccessToken returns the legacy API token as is, or exchanges the UAA
+// refresh token for an access token. Access tokens are cached and reused
+// until shortly before they expire. A failed exchange is reported as an
+// ErrTokenExchange.
 func (o AccessTokenOrLegacyToken) AccessToken() (string, error) {
-	const legacyAPITokenLength = 20
+	return o.AccessTokenContext(context.Background())
+}
+
+// AccessTokenContext is AccessToken, giving up on the token exchange, or on
+// waiting for another caller's exchange, when ctx is done.
+func (o AccessTokenOrLegacyToken) AccessTokenContext(ctx context.Context) (string, error) {
 	if len(o.refreshToken) > legacyAPITokenLength {
-		baseURL := fmt.Sprintf("%s%s", o.host, apiVersion)
-		tokenFetcher := NewTokenFetcher(baseURL, o.refreshToken, o.skipSSLValidation, o.userAgent, o.proxyAuthConfig)
+		fetch := func() (string, error) {
+			baseURL := fmt.Sprintf("%s%s", o.host, apiVersion)
+			tokenFetcher := NewTokenFetcher(baseURL, o.refreshToken, o.skipSSLValidation, o.userAgent, o.proxyAuthConfig)
+
+			return tokenFetcher.GetTokenContext(ctx)
+		}
 
-		accessToken, err := tokenFetcher.GetToken()
+		var accessToken string
+		var err error
+		if o.cache != nil {
+			accessToken, err = o.cache.getContext(ctx, fetch)
+		} else {
+			accessToken, err = fetch()
+		}
 		if err != nil {
-			log.Panicf("Exiting with error: %s", err)
 			return "", err
 		}
 		return accessToken, nil
@@ -82,8 +107,20 @@ func (o AccessTokenOrLegacyToken) AccessToken() (string, error) {
 	}
 }
 
+// InvalidateAccessToken discards the cached access token if it is the one
+// given, so that the next call to AccessToken exchanges the refresh token
+// again. It reports whether a new token can be fetched, which is never the
+// case for legacy API tokens.
+func (o AccessTokenOrLegacyToken) InvalidateAccessToken(accessToken string) bool {
+	if len(o.refreshToken) <= legacyAPITokenLength || o.cache == nil {
+		return false
+	}
+
+	o.cache.invalidate(accessToken)
+	return true
+}
+
 func AuthorizationHeader(accessToken string) (string, error) {
-	const legacyAPITokenLength = 20
 	if len(accessToken) > legacyAPITokenLength {
 		return fmt.Sprintf("Bearer %s", accessToken), nil
 	} else {
@@ -105,6 +142,37 @@ type ClientConfig struct {
 	UserAgent         string
 	SkipSSLValidation bool
 	ProxyAuthConfig   ProxyAuthConfig // Proxy authentication configuration (optional)
+	RetryPolicy       RetryPolicy     // Retry policy for API requests (optional, no retries by default)
+	RedactFields      []string        // Extra header, JSON field and query parameter names to scrub from logs (optional)
+	ResumeDownloads   bool            // Keep per-range progress so interrupted downloads can be resumed (optional)
+
+	// What to do with a downloaded file that fails checksum verification (optional, kept when ResumeDownloads is set and deleted otherwise by default)
+	ChecksumMismatchAction download.ChecksumMismatchAction
+
+	// Retry limits for failing download ranges (optional, download.DefaultRetryPolicy by default)
+	DownloadRetryPolicy download.RetryPolicy
+
+	// Receives download progress instead of the terminal progress bar (optional)
+	ProgressReporter download.ProgressReporter
+
+	// Number of ranges downloaded at once (optional, 10 by default)
+	ConcurrentDownloads int
+
+	// How files are split into ranges (optional, ConcurrentDownloads equal ranges by default)
+	RangeStrategy download.RangeStrategy
+
+	// Bandwidth limits for downloads (optional, unlimited by default). Keep
+	// the Throttle to change its limits while downloads are running.
+	DownloadThrottle *download.Throttle
+
+	// S3-compatible endpoint to upload product files to instead of AWS (optional)
+	S3Endpoint string
+
+	// Size of each part of a multipart upload (optional, 16MiB by default)
+	UploadPartSize int64
+
+	// Number of parts uploaded at once (optional, 4 by default)
+	ConcurrentUploads int
 }
 
 //go:generate counterfeiter . AccessTokenService
@@ -112,6 +180,21 @@ type AccessTokenService interface {
 	AccessToken() (string, error)
 }
 
+// AccessTokenContextService is implemented by AccessTokenServices that can
+// stop fetching a token when the context of the request it is for is done.
+// The client uses it in place of AccessToken when it is implemented.
+type AccessTokenContextService interface {
+	AccessTokenContext(ctx context.Context) (string, error)
+}
+
+// AccessTokenInvalidator is implemented by AccessTokenServices that cache
+// access tokens. When Pivnet rejects a token with a 401 the client calls
+// InvalidateAccessToken and, if it returns true, retries the request once
+// with a freshly fetched token.
+type AccessTokenInvalidator interface {
+	InvalidateAccessToken(accessToken string) bool
+}
+
 func NewAccessTokenOrLegacyToken(token string, host string, skipSSLValidation bool, userAgentOptional ...string) AccessTokenOrLegacyToken {
 	var userAgent = ""
 	if len(userAgentOptional) > 0 {
@@ -123,6 +206,7 @@ func NewAccessTokenOrLegacyToken(token string, host string, skipSSLValidation bo
 		skipSSLValidation: skipSSLValidation,
 		userAgent:         userAgent,
 		proxyAuthConfig:   ProxyAuthConfig{},
+		cache:             newAccessTokenCache(),
 	}
 }
 
@@ -138,6 +222,7 @@ func NewAccessTokenOrLegacyTokenWithProxy(token string, host string, skipSSLVali
 		skipSSLValidation: skipSSLValidation,
 		userAgent:         userAgent,
 		proxyAuthConfig:   proxyAuthConfig,
+		cache:             newAccessTokenCache(),
 	}
 }
 
@@ -203,6 +288,7 @@ func NewClient(
 	config ClientConfig,
 	lgr logger.Logger,
 ) Client {
+	lgr = newRedactingLogger(lgr, config.RedactFields)
 	baseURL := fmt.Sprintf("%s%s", config.Host, apiVersion)
 
 	baseTransport := &http.Transport{
@@ -222,21 +308,15 @@ func NewClient(
 		Transport: baseTransport,
 	}
 
-	ranger := download.NewRanger(concurrentDownloads)
-	downloader := download.Client{
-		HTTPClient: downloadClient,
-		Ranger:     ranger,
-		Logger:     lgr,
-		Timeout:    30 * time.Second,
-	}
-
 	client := Client{
-		baseURL:    baseURL,
-		token:      token,
-		userAgent:  config.UserAgent,
-		logger:     lgr,
-		downloader: downloader,
-		HTTP:       httpClient,
+		baseURL:     baseURL,
+		token:       token,
+		userAgent:   config.UserAgent,
+		logger:      lgr,
+		retryPolicy: config.RetryPolicy,
+		downloader:  newDownloader(config, downloadClient, lgr),
+		uploader:    newUploader(config, downloadClient, lgr),
+		HTTP:        httpClient,
 	}
 
 	initializeClientServices(&client, lgr)
@@ -244,12 +324,61 @@ func NewClient(
 	return client
 }
 
+func downloadConcurrency(config ClientConfig) int {
+	if config.ConcurrentDownloads > 0 {
+		return config.ConcurrentDownloads
+	}
+	return concurrentDownloads
+}
+
+func rangeStrategy(config ClientConfig) download.RangeStrategy {
+	if config.RangeStrategy != nil {
+		return config.RangeStrategy
+	}
+	return download.NewRanger(downloadConcurrency(config))
+}
+
+func newDownloader(config ClientConfig, httpClient *http.Client, lgr logger.Logger) download.Client {
+	return download.Client{
+		HTTPClient: httpClient,
+		Ranger:     rangeStrategy(config),
+		Logger:     lgr,
+		Timeout:    30 * time.Second,
+		Resume:     config.ResumeDownloads,
+
+		ChecksumMismatchAction: config.ChecksumMismatchAction,
+		RetryPolicy:            downloadRetryPolicy(config),
+		Progress:               config.ProgressReporter,
+		Concurrency:            downloadConcurrency(config),
+		Throttle:               config.DownloadThrottle,
+	}
+}
+
+func newUploader(config ClientConfig, httpClient *http.Client, lgr logger.Logger) upload.Client {
+	return upload.Client{
+		HTTPClient:  httpClient,
+		Logger:      lgr,
+		Endpoint:    config.S3Endpoint,
+		PartSize:    config.UploadPartSize,
+		Concurrency: config.ConcurrentUploads,
+	}
+}
+
+func downloadRetryPolicy(config ClientConfig) download.RetryPolicy {
+	if config.DownloadRetryPolicy == (download.RetryPolicy{}) {
+		return download.DefaultRetryPolicy
+	}
+	return config.DownloadRetryPolicy
+}
+
 // NewClientWithProxy creates a new Pivnet client with optional proxy authentication support
 func NewClientWithProxy(
 	token AccessTokenService,
 	config ClientConfig,
 	lgr logger.Logger,
 ) (Client, error) {
+	lgr = newRedactingLogger(lgr, config.RedactFields)
+
 	var transport http.RoundTripper
 	var err error
 
@@ -269,24 +398,23 @@ func NewClientWithProxy(
 		}
 	}
 
+	downloadClient := &http.Client{
+		Timeout:   0,
+		Transport: transport,
+	}
+
 	client := Client{
-		baseURL:   fmt.Sprintf("%s%s", config.Host, apiVersion),
-		token:     token,
-		userAgent: config.UserAgent,
-		logger:    lgr,
+		baseURL:     fmt.Sprintf("%s%s", config.Host, apiVersion),
+		token:       token,
+		userAgent:   config.UserAgent,
+		logger:      lgr,
+		retryPolicy: config.RetryPolicy,
 		HTTP: &http.Client{
 			Timeout:   10 * time.Minute,
 			Transport: transport,
 		},
-		downloader: download.Client{
-			HTTPClient: &http.Client{
-				Timeout:   0,
-				Transport: transport,
-			},
-			Ranger:  download.NewRanger(concurrentDownloads),
-			Logger:  lgr,
-			Timeout: 30 * time.Second,
-		},
+		downloader: newDownloader(config, downloadClient, lgr),
+		uploader:   newUploader(config, downloadClient, lgr),
 	}
 
 	initializeClientServices(&client, lgr)
@@ -298,6 +426,17 @@ func (c Client) CreateRequest(
 	requestType string,
 	endpoint string,
 	body io.Reader,
+) (*http.Request, error) {
+	return c.CreateRequestContext(context.Background(), requestType, endpoint, body)
+}
+
+// CreateRequestContext is like CreateRequest but the returned request
+// carries ctx, so cancelling ctx aborts the request once it is sent.
+func (c Client) CreateRequestContext(
+	ctx context.Context,
+	requestType string,
+	endpoint string,
+	body io.Reader,
 ) (*http.Request, error) {
 	u, err := url.Parse(c.baseURL)
 	if err != nil {
@@ -306,15 +445,26 @@ func (c Client) CreateRequest(
 
 	endpoint = c.stripHostPrefix(endpoint)
 
+	// Pagination links carry their query string with them
+	if i := strings.Index(endpoint, "?"); i >= 0 {
+		u.RawQuery = endpoint[i+1:]
+		endpoint = endpoint[:i]
+	}
+
 	u.Path = u.Path + endpoint
 
-	req, err := http.NewRequest(requestType, u.String(), body)
+	req, err := http.NewRequestWithContext(ctx, requestType, u.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
 	if !isVersionsEndpoint(endpoint) {
-		accessToken, err := c.token.AccessToken()
+		var accessToken string
+		if token, ok := c.token.(AccessTokenContextService); ok {
+			accessToken, err = token.AccessTokenContext(ctx)
+		} else {
+			accessToken, err = c.token.AccessToken()
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -339,71 +489,168 @@ func (c Client) MakeRequest(
 	expectedStatusCode int,
 	body io.Reader,
 ) (*http.Response, error) {
-	req, err := c.CreateRequest(requestType, endpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	reqBytes, err := httputil.DumpRequestOut(req, true)
-	if err != nil {
-		return nil, err
-	}
-
-	c.logger.Debug("Making request", logger.Data{"request": string(reqBytes)})
-
-	resp, err := c.HTTP.Do(req)
-	if err != nil {
-		return nil, err
-	}
+	return c.MakeRequestContext(context.Background(), requestType, endpoint, expectedStatusCode, body)
+}
 
-	c.logger.Debug("Response status code", logger.Data{"status code": resp.StatusCode})
-	c.logger.Debug("Response headers", logger.Data{"headers": resp.Header})
+// MakeRequestContext is like MakeRequest but aborts the request when ctx is
+// cancelled or its deadline expires.
+func (c Client) MakeRequestContext(
+	ctx context.Context,
+	requestType string,
+	endpoint string,
+	expectedStatusCode int,
+	body io.Reader,
+) (*http.Response, error) {
+	return c.makeRequest(ctx, requestType, endpoint, expectedStatusCode, nil, body)
+}
 
-	if expectedStatusCode > 0 && resp.StatusCode != expectedStatusCode {
-		return nil, c.handleUnexpectedResponse(resp)
-	}
+func (c Client) MakeRequestWithParams(
+	requestType string,
+	endpoint string,
+	expectedStatusCode int,
+	params []QueryParameter,
+	body io.Reader,
+) (*http.Response, error) {
+	return c.MakeRequestWithParamsContext(context.Background(), requestType, endpoint, expectedStatusCode, params, body)
+}
 
-	return resp, nil
+// MakeRequestWithParamsContext is like MakeRequestWithParams but aborts the
+// request when ctx is cancelled or its deadline expires.
+func (c Client) MakeRequestWithParamsContext(
+	ctx context.Context,
+	requestType string,
+	endpoint string,
+	expectedStatusCode int,
+	params []QueryParameter,
+	body io.Reader,
+) (*http.Response, error) {
+	return c.makeRequest(ctx, requestType, endpoint, expectedStatusCode, params, body)
 }
 
-func (c Client) MakeRequestWithParams(
+// makeRequest sends the request, retrying it according to the client's
+// retry policy, and converts unexpected status codes into errors.
+func (c Client) makeRequest(
+	ctx context.Context,
 	requestType string,
 	endpoint string,
 	expectedStatusCode int,
 	params []QueryParameter,
 	body io.Reader,
 ) (*http.Response, error) {
-	req, err := c.CreateRequest(requestType, endpoint, body)
-	if err != nil {
-		return nil, err
-	}
+	invalidator, canReauthenticate := c.token.(AccessTokenInvalidator)
 
-	q := req.URL.Query()
-	for _, param := range params {
-		q.Add(param.Key, param.Value)
+	var bodyBytes []byte
+	if body != nil && (canReauthenticate || c.retryPolicy.retriesMethod(requestType)) {
+		// Buffer the body so that it can be sent again on a retry
+		b, err := ioutil.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		bodyBytes = b
 	}
-	req.URL.RawQuery = q.Encode()
 
-	reqBytes, err := httputil.DumpRequestOut(req, true)
-	if err != nil {
-		return nil, err
-	}
+	reauthenticated := false
+	for attempt := 1; ; attempt++ {
+		if bodyBytes != nil {
+			body = bytes.NewReader(bodyBytes)
+		}
 
-	c.logger.Debug("Making request", logger.Data{"request": string(reqBytes)})
+		req, err := c.CreateRequestContext(ctx, requestType, endpoint, body)
+		if err != nil {
+			return nil, err
+		}
 
-	resp, err := c.HTTP.Do(req)
-	if err != nil {
-		return nil, err
-	}
+		if len(params) > 0 {
+			q := req.URL.Query()
+			for _, param := range params {
+				q.Add(param.Key, param.Value)
+			}
+			req.URL.RawQuery = q.Encode()
+		}
+
+		reqBytes, err := httputil.DumpRequestOut(req, true)
+		if err != nil {
+			return nil, err
+		}
+
+		c.logger.Debug("Making request", logger.Data{"request": string(reqBytes)})
 
-	c.logger.Debug("Response status code", logger.Data{"status code": resp.StatusCode})
-	c.logger.Debug("Response headers", logger.Data{"headers": resp.Header})
+		resp, err := c.HTTP.Do(req)
+		if err != nil {
+			if ctx.Err() != nil || !c.retryPolicy.canRetry(requestType, attempt) {
+				return nil, err
+			}
+
+			err = c.waitToRetry(ctx, req, attempt, nil, err.Error())
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
 
-	if expectedStatusCode > 0 && resp.StatusCode != expectedStatusCode {
-		return nil, c.handleUnexpectedResponse(resp)
+		c.logger.Debug("Response status code", logger.Data{"status code": resp.StatusCode})
+		c.logger.Debug("Response headers", logger.Data{"headers": resp.Header})
+
+		if resp.StatusCode == http.StatusUnauthorized &&
+			expectedStatusCode != http.StatusUnauthorized &&
+			canReauthenticate &&
+			!reauthenticated {
+			// Strip the "Bearer" or "Token" scheme from the header
+			authorizationHeader := req.Header.Get("Authorization")
+			rejectedToken := authorizationHeader[strings.Index(authorizationHeader, " ")+1:]
+			if invalidator.InvalidateAccessToken(rejectedToken) {
+				io.Copy(ioutil.Discard, resp.Body)
+				resp.Body.Close()
+
+				c.logger.Debug("Access token rejected, retrying with a new access token")
+
+				// Re-authenticating does not count as an attempt
+				reauthenticated = true
+				attempt--
+				continue
+			}
+		}
+
+		if resp.StatusCode != expectedStatusCode &&
+			isRetryableStatusCode(resp.StatusCode) &&
+			c.retryPolicy.canRetry(requestType, attempt) {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+
+			err = c.waitToRetry(ctx, req, attempt, resp, http.StatusText(resp.StatusCode))
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if expectedStatusCode > 0 && resp.StatusCode != expectedStatusCode {
+			return nil, c.handleUnexpectedResponse(resp)
+		}
+
+		return resp, nil
 	}
+}
 
-	return resp, nil
+func (c Client) waitToRetry(
+	ctx context.Context,
+	req *http.Request,
+	attempt int,
+	resp *http.Response,
+	reason string,
+) error {
+	delay := c.retryPolicy.backoff(attempt, resp)
+
+	c.logger.Info("Retrying request", logger.Data{
+		"method":       req.Method,
+		"path":         req.URL.Path,
+		"attempt":      attempt + 1,
+		"max attempts": c.retryPolicy.MaxAttempts,
+		"delay":        delay.String(),
+		"reason":       reason,
+	})
+
+	return sleepContext(ctx, delay)
 }
 
 func (c Client) stripHostPrefix(downloadLink string) string {
@@ -1,6 +1,7 @@
 package pivnet
 
 import (
+	"context"
 	"net/http"
 
 	"encoding/json"
@@ -10,16 +11,27 @@ type PivnetVersionsService struct {
 	client Client
 }
 
+//go:generate counterfeiter . PivnetVersionsAPI
+type PivnetVersionsAPI interface {
+	List() (PivnetVersions, error)
+	ListContext(ctx context.Context) (PivnetVersions, error)
+}
+
 type PivnetVersions struct {
 	PivnetCliVersion       string  `json:"pivnet_cli,omitempty"`
 	PivnetResourceVersion  string  `json:"pivnet_resource,omitempty"`
 }
 
 func (v PivnetVersionsService) List() (PivnetVersions, error) {
+	return v.ListContext(context.Background())
+}
+
+func (v PivnetVersionsService) ListContext(ctx context.Context) (PivnetVersions, error) {
 	url := "/versions"
 
 	var response PivnetVersions
-	resp, err := v.client.MakeRequest(
+	resp, err := v.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
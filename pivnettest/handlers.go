@@ -0,0 +1,1203 @@
+package pivnettest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet/v9"
+)
+
+type handlerFunc func(r *http.Request, p params) (int, interface{})
+
+type apiError struct {
+	statusCode int
+	message    string
+}
+
+type redirect string
+
+func notFound(format string, a ...interface{}) (int, interface{}) {
+	return http.StatusNotFound, apiError{http.StatusNotFound, fmt.Sprintf(format, a...)}
+}
+
+func unprocessable(format string, a ...interface{}) (int, interface{}) {
+	return http.StatusUnprocessableEntity, apiError{http.StatusUnprocessableEntity, fmt.Sprintf(format, a...)}
+}
+
+func (p params) id(name string) int {
+	id, err := strconv.Atoi(p[name])
+	if err != nil {
+		return -1
+	}
+	return id
+}
+
+func decode(r *http.Request, v interface{}) error {
+	return json.NewDecoder(r.Body).Decode(v)
+}
+
+func (s *Server) apiRoutes() []route {
+	routes := []route{
+		newRoute("POST", "/authentication/access_tokens", s.createAccessToken),
+		newRoute("GET", "/versions", s.getVersions),
+	}
+
+	for _, rt := range []route{
+		newRoute("GET", "/authentication", s.checkAuthentication),
+		newRoute("GET", "/releases/release_types", s.listReleaseTypes),
+
+		newRoute("GET", "/products", s.listProducts),
+		newRoute("GET", "/products/:slug", s.getProduct),
+		newRoute("GET", "/products/:slug/slug_alias", s.getSlugAlias),
+
+		newRoute("POST", "/federation_token", s.createFederationToken),
+
+		newRoute("GET", "/products/:slug/releases", s.listReleases),
+		newRoute("POST", "/products/:slug/releases", s.createRelease),
+		newRoute("GET", "/products/:slug/releases/:release", s.getRelease),
+		newRoute("PATCH", "/products/:slug/releases/:release", s.updateRelease),
+		newRoute("DELETE", "/products/:slug/releases/:release", s.deleteRelease),
+
+		newRoute("GET", "/products/:slug/product_files", s.listProductFiles),
+		newRoute("POST", "/products/:slug/product_files", s.createProductFile),
+		newRoute("GET", "/products/:slug/product_files/:id", s.getProductFile),
+		newRoute("PATCH", "/products/:slug/product_files/:id", s.updateProductFile),
+		newRoute("DELETE", "/products/:slug/product_files/:id", s.deleteProductFile),
+		newRoute("GET", "/products/:slug/releases/:release/product_files", s.listReleaseProductFiles),
+		newRoute("GET", "/products/:slug/releases/:release/product_files/:id", s.getReleaseProductFile),
+		newRoute("POST", "/products/:slug/releases/:release/product_files/:id/download", s.downloadProductFile),
+		newRoute("PATCH", "/products/:slug/releases/:release/add_product_file", s.addReleaseProductFile),
+		newRoute("PATCH", "/products/:slug/releases/:release/remove_product_file", s.removeReleaseProductFile),
+		newRoute("PATCH", "/products/:slug/file_groups/:id/add_product_file", s.addFileGroupProductFile),
+		newRoute("PATCH", "/products/:slug/file_groups/:id/remove_product_file", s.removeFileGroupProductFile),
+
+		newRoute("GET", "/products/:slug/file_groups", s.listFileGroups),
+		newRoute("POST", "/products/:slug/file_groups", s.createFileGroup),
+		newRoute("GET", "/products/:slug/file_groups/:id", s.getFileGroup),
+		newRoute("PATCH", "/products/:slug/file_groups/:id", s.updateFileGroup),
+		newRoute("DELETE", "/products/:slug/file_groups/:id", s.deleteFileGroup),
+		newRoute("GET", "/products/:slug/releases/:release/file_groups", s.listReleaseFileGroups),
+		newRoute("PATCH", "/products/:slug/releases/:release/add_file_group", s.addReleaseFileGroup),
+		newRoute("PATCH", "/products/:slug/releases/:release/remove_file_group", s.removeReleaseFileGroup),
+
+		newRoute("GET", "/user_groups", s.listUserGroups),
+		newRoute("POST", "/user_groups", s.createUserGroup),
+		newRoute("GET", "/user_groups/:id", s.getUserGroup),
+		newRoute("PATCH", "/user_groups/:id", s.updateUserGroup),
+		newRoute("DELETE", "/user_groups/:id", s.deleteUserGroup),
+		newRoute("PATCH", "/user_groups/:id/add_member", s.addUserGroupMember),
+		newRoute("PATCH", "/user_groups/:id/remove_member", s.removeUserGroupMember),
+		newRoute("GET", "/products/:slug/releases/:release/user_groups", s.listReleaseUserGroups),
+		newRoute("PATCH", "/products/:slug/releases/:release/add_user_group", s.addReleaseUserGroup),
+		newRoute("PATCH", "/products/:slug/releases/:release/remove_user_group", s.removeReleaseUserGroup),
+
+		newRoute("GET", "/eulas", s.listEULAs),
+		newRoute("GET", "/eulas/:eula", s.getEULA),
+		newRoute("POST", "/products/:slug/releases/:release/pivnet_resource_eula_acceptance", s.acceptEULA),
+
+		newRoute("GET", "/products/:slug/releases/:release/dependencies", s.listDependencies),
+		newRoute("PATCH", "/products/:slug/releases/:release/add_dependency", s.addDependency),
+		newRoute("PATCH", "/products/:slug/releases/:release/remove_dependency", s.removeDependency),
+		newRoute("GET", "/products/:slug/releases/:release/dependency_specifiers", s.listDependencySpecifiers),
+		newRoute("POST", "/products/:slug/releases/:release/dependency_specifiers", s.createDependencySpecifier),
+		newRoute("GET", "/products/:slug/releases/:release/dependency_specifiers/:id", s.getDependencySpecifier),
+		newRoute("DELETE", "/products/:slug/releases/:release/dependency_specifiers/:id", s.deleteDependencySpecifier),
+
+		newRoute("GET", "/products/:slug/releases/:release/upgrade_paths", s.listUpgradePaths),
+		newRoute("PATCH", "/products/:slug/releases/:release/add_upgrade_path", s.addUpgradePath),
+		newRoute("PATCH", "/products/:slug/releases/:release/remove_upgrade_path", s.removeUpgradePath),
+		newRoute("GET", "/products/:slug/releases/:release/upgrade_path_specifiers", s.listUpgradePathSpecifiers),
+		newRoute("POST", "/products/:slug/releases/:release/upgrade_path_specifiers", s.createUpgradePathSpecifier),
+		newRoute("GET", "/products/:slug/releases/:release/upgrade_path_specifiers/:id", s.getUpgradePathSpecifier),
+		newRoute("DELETE", "/products/:slug/releases/:release/upgrade_path_specifiers/:id", s.deleteUpgradePathSpecifier),
+
+		newRoute("GET", "/products/:slug/artifact_references", s.listArtifactReferences),
+		newRoute("POST", "/products/:slug/artifact_references", s.createArtifactReference),
+		newRoute("GET", "/products/:slug/artifact_references/:id", s.getArtifactReference),
+		newRoute("PATCH", "/products/:slug/artifact_references/:id", s.updateArtifactReference),
+		newRoute("DELETE", "/products/:slug/artifact_references/:id", s.deleteArtifactReference),
+		newRoute("GET", "/products/:slug/releases/:release/artifact_references", s.listReleaseArtifactReferences),
+		newRoute("GET", "/products/:slug/releases/:release/artifact_references/:id", s.getReleaseArtifactReference),
+		newRoute("PATCH", "/products/:slug/releases/:release/add_artifact_reference", s.addReleaseArtifactReference),
+		newRoute("PATCH", "/products/:slug/releases/:release/remove_artifact_reference", s.removeReleaseArtifactReference),
+	} {
+		rt.authenticated = true
+		routes = append(routes, rt)
+	}
+
+	return routes
+}
+
+// Authentication
+
+func (s *Server) createAccessToken(r *http.Request, p params) (int, interface{}) {
+	var body pivnet.AuthBody
+	if err := decode(r, &body); err != nil || body.RefreshToken == "" {
+		return http.StatusUnauthorized, apiError{http.StatusUnauthorized, "invalid refresh token"}
+	}
+
+	s.store.accessTokens++
+	return http.StatusOK, pivnet.UAATokenResponse{
+		Token: fmt.Sprintf("pivnettest-access-token-%d", s.store.accessTokens),
+	}
+}
+
+func (s *Server) checkAuthentication(r *http.Request, p params) (int, interface{}) {
+	return http.StatusOK, struct{}{}
+}
+
+func (s *Server) getVersions(r *http.Request, p params) (int, interface{}) {
+	return http.StatusOK, s.store.versions
+}
+
+func (s *Server) listReleaseTypes(r *http.Request, p params) (int, interface{}) {
+	return http.StatusOK, pivnet.ReleaseTypesResponse{ReleaseTypes: s.store.releaseTypes}
+}
+
+// Products
+
+func (s *Server) listProducts(r *http.Request, p params) (int, interface{}) {
+	products := []pivnet.Product{}
+	for _, product := range s.store.products {
+		products = append(products, *product)
+	}
+	return http.StatusOK, pivnet.ProductsResponse{Products: products}
+}
+
+func (s *Server) getProduct(r *http.Request, p params) (int, interface{}) {
+	product := s.store.product(p["slug"])
+	if product == nil {
+		return notFound("product %s not found", p["slug"])
+	}
+	return http.StatusOK, product
+}
+
+func (s *Server) getSlugAlias(r *http.Request, p params) (int, interface{}) {
+	product := s.store.product(p["slug"])
+	if product == nil {
+		return notFound("product %s not found", p["slug"])
+	}
+	return http.StatusOK, pivnet.SlugAliasResponse{
+		Slugs:       []string{product.Slug},
+		CurrentSlug: product.Slug,
+	}
+}
+
+// Releases
+
+type releaseBody struct {
+	Release      pivnet.Release `json:"release"`
+	CopyMetadata bool           `json:"copy_metadata"`
+}
+
+// withRelease resolves the product and release named by the path before
+// calling fn.
+func (s *Server) withRelease(p params, fn func(*pivnet.Product, *pivnet.Release) (int, interface{})) (int, interface{}) {
+	product := s.store.product(p["slug"])
+	if product == nil {
+		return notFound("product %s not found", p["slug"])
+	}
+
+	release := s.store.release(product.Slug, p.id("release"))
+	if release == nil {
+		return notFound("release %s not found", p["release"])
+	}
+
+	return fn(product, release)
+}
+
+func (s *Server) listReleases(r *http.Request, p params) (int, interface{}) {
+	if s.store.product(p["slug"]) == nil {
+		return notFound("product %s not found", p["slug"])
+	}
+
+	all := s.store.releases[p["slug"]]
+	start, end, links := s.paginate(r, len(all))
+
+	releases := []pivnet.Release{}
+	for _, release := range all[start:end] {
+		releases = append(releases, *release)
+	}
+
+	return http.StatusOK, struct {
+		Releases []pivnet.Release `json:"releases"`
+		Links    *pivnet.Links    `json:"_links,omitempty"`
+	}{releases, links}
+}
+
+func (s *Server) createRelease(r *http.Request, p params) (int, interface{}) {
+	if s.store.product(p["slug"]) == nil {
+		return notFound("product %s not found", p["slug"])
+	}
+
+	var body releaseBody
+	if err := decode(r, &body); err != nil {
+		return unprocessable("invalid release: %s", err)
+	}
+
+	if body.Release.Version == "" {
+		return unprocessable("Version can't be blank")
+	}
+
+	for _, existing := range s.store.releases[p["slug"]] {
+		if existing.Version == body.Release.Version {
+			return unprocessable("Version has already been taken")
+		}
+	}
+
+	body.Release.ID = 0
+	release := s.store.addRelease(p["slug"], body.Release)
+	return http.StatusCreated, pivnet.CreateReleaseResponse{Release: release}
+}
+
+func (s *Server) getRelease(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		return http.StatusOK, release
+	})
+}
+
+func (s *Server) updateRelease(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		// Decoding over the stored release leaves fields absent from the
+		// request unchanged, as a PATCH does.
+		body := releaseBody{Release: *release}
+		if err := decode(r, &body); err != nil {
+			return unprocessable("invalid release: %s", err)
+		}
+
+		body.Release.ID = release.ID
+		*release = body.Release
+		return http.StatusOK, pivnet.CreateReleaseResponse{Release: *release}
+	})
+}
+
+func (s *Server) deleteRelease(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		s.store.deleteRelease(product.Slug, release.ID)
+		return http.StatusNoContent, nil
+	})
+}
+
+// Product files
+
+type productFileBody struct {
+	ProductFile pivnet.ProductFile `json:"product_file"`
+}
+
+func (s *Server) listProductFiles(r *http.Request, p params) (int, interface{}) {
+	if s.store.product(p["slug"]) == nil {
+		return notFound("product %s not found", p["slug"])
+	}
+
+	all := s.store.productFiles[p["slug"]]
+	start, end, links := s.paginate(r, len(all))
+
+	productFiles := []pivnet.ProductFile{}
+	for _, pf := range all[start:end] {
+		productFiles = append(productFiles, s.listedProductFile(*pf))
+	}
+
+	return http.StatusOK, struct {
+		ProductFiles []pivnet.ProductFile `json:"product_files"`
+		Links        *pivnet.Links        `json:"_links,omitempty"`
+	}{productFiles, links}
+}
+
+func (s *Server) createProductFile(r *http.Request, p params) (int, interface{}) {
+	if s.store.product(p["slug"]) == nil {
+		return notFound("product %s not found", p["slug"])
+	}
+
+	var body productFileBody
+	if err := decode(r, &body); err != nil {
+		return unprocessable("invalid product file: %s", err)
+	}
+
+	if body.ProductFile.AWSObjectKey == "" {
+		return unprocessable("AWS object key can't be blank")
+	}
+
+	body.ProductFile.ID = 0
+	content := s.store.s3Objects[body.ProductFile.AWSObjectKey]
+	pf := s.store.addProductFile(p["slug"], body.ProductFile, content)
+	return http.StatusCreated, pivnet.ProductFileResponse{ProductFile: pf}
+}
+
+func (s *Server) getProductFile(r *http.Request, p params) (int, interface{}) {
+	pf := s.store.productFile(p["slug"], p.id("id"))
+	if pf == nil {
+		return notFound("product file %s not found", p["id"])
+	}
+	return http.StatusOK, pivnet.ProductFileResponse{ProductFile: *pf}
+}
+
+func (s *Server) updateProductFile(r *http.Request, p params) (int, interface{}) {
+	pf := s.store.productFile(p["slug"], p.id("id"))
+	if pf == nil {
+		return notFound("product file %s not found", p["id"])
+	}
+
+	body := productFileBody{ProductFile: *pf}
+	if err := decode(r, &body); err != nil {
+		return unprocessable("invalid product file: %s", err)
+	}
+
+	body.ProductFile.ID = pf.ID
+	*pf = body.ProductFile
+	return http.StatusOK, pivnet.ProductFileResponse{ProductFile: *pf}
+}
+
+func (s *Server) deleteProductFile(r *http.Request, p params) (int, interface{}) {
+	slug := p["slug"]
+	pf := s.store.productFile(slug, p.id("id"))
+	if pf == nil {
+		return notFound("product file %s not found", p["id"])
+	}
+
+	files := s.store.productFiles[slug]
+	for i, existing := range files {
+		if existing.ID == pf.ID {
+			s.store.productFiles[slug] = append(files[:i], files[i+1:]...)
+			break
+		}
+	}
+	delete(s.store.blobs, pf.ID)
+
+	return http.StatusOK, pivnet.ProductFileResponse{ProductFile: *pf}
+}
+
+// releaseProductFile returns the product file with the download link that
+// Pivnet includes when a file is fetched through its release.
+func (s *Server) releaseProductFile(slug string, releaseID int, pf pivnet.ProductFile) pivnet.ProductFile {
+	pf.Links = &pivnet.Links{
+		Download: map[string]string{
+			"href": fmt.Sprintf(
+				"%s%s/products/%s/releases/%d/product_files/%d/download",
+				s.URL(),
+				apiVersion,
+				slug,
+				releaseID,
+				pf.ID,
+			),
+		},
+	}
+	return pf
+}
+
+// listedProductFile returns the product file as it appears in listings.
+func (s *Server) listedProductFile(pf pivnet.ProductFile) pivnet.ProductFile {
+	if s.listingsOmitChecksums {
+		pf.SHA256 = ""
+		pf.MD5 = ""
+	}
+	return pf
+}
+
+func (s *Server) listReleaseProductFiles(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		productFiles := []pivnet.ProductFile{}
+		for _, id := range s.store.releaseProductFiles[release.ID] {
+			if pf := s.store.productFile(product.Slug, id); pf != nil {
+				productFiles = append(productFiles, s.listedProductFile(s.releaseProductFile(product.Slug, release.ID, *pf)))
+			}
+		}
+		return http.StatusOK, pivnet.ProductFilesResponse{ProductFiles: productFiles}
+	})
+}
+
+func (s *Server) getReleaseProductFile(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		pf := s.store.productFile(product.Slug, p.id("id"))
+		if pf == nil || !containsID(s.store.releaseProductFiles[release.ID], pf.ID) {
+			return notFound("product file %s not found", p["id"])
+		}
+		return http.StatusOK, pivnet.ProductFileResponse{
+			ProductFile: s.releaseProductFile(product.Slug, release.ID, *pf),
+		}
+	})
+}
+
+// downloadProductFile redirects to the file's blob, as Pivnet redirects to a
+// presigned S3 URL. Releases with a EULA require it to have been accepted.
+func (s *Server) downloadProductFile(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		pf := s.store.productFile(product.Slug, p.id("id"))
+		if pf == nil || !containsID(s.store.releaseProductFiles[release.ID], pf.ID) {
+			return notFound("product file %s not found", p["id"])
+		}
+
+		if release.EULA != nil && release.EULA.Slug != "" && !s.store.eulaAcceptances[release.ID] {
+			return http.StatusUnavailableForLegalReasons, apiError{
+				http.StatusUnavailableForLegalReasons,
+				"user with email 'pivnettest' has not accepted the current EULA for release with 'id'=" + strconv.Itoa(release.ID),
+			}
+		}
+
+		return http.StatusFound, redirect(fmt.Sprintf("%s%s%d", s.URL(), blobsPath, pf.ID))
+	})
+}
+
+func (s *Server) addReleaseProductFile(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		var body productFileBody
+		if err := decode(r, &body); err != nil {
+			return unprocessable("invalid product file: %s", err)
+		}
+		if s.store.productFile(product.Slug, body.ProductFile.ID) == nil {
+			return notFound("product file %d not found", body.ProductFile.ID)
+		}
+
+		addID(s.store.releaseProductFiles, release.ID, body.ProductFile.ID)
+		return http.StatusNoContent, nil
+	})
+}
+
+func (s *Server) removeReleaseProductFile(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		var body productFileBody
+		if err := decode(r, &body); err != nil {
+			return unprocessable("invalid product file: %s", err)
+		}
+
+		removeID(s.store.releaseProductFiles, release.ID, body.ProductFile.ID)
+		return http.StatusNoContent, nil
+	})
+}
+
+func (s *Server) addFileGroupProductFile(r *http.Request, p params) (int, interface{}) {
+	fg := s.store.fileGroup(p["slug"], p.id("id"))
+	if fg == nil {
+		return notFound("file group %s not found", p["id"])
+	}
+
+	var body productFileBody
+	if err := decode(r, &body); err != nil {
+		return unprocessable("invalid product file: %s", err)
+	}
+	if s.store.productFile(p["slug"], body.ProductFile.ID) == nil {
+		return notFound("product file %d not found", body.ProductFile.ID)
+	}
+
+	addID(s.store.fileGroupFiles, fg.ID, body.ProductFile.ID)
+	return http.StatusNoContent, nil
+}
+
+func (s *Server) removeFileGroupProductFile(r *http.Request, p params) (int, interface{}) {
+	fg := s.store.fileGroup(p["slug"], p.id("id"))
+	if fg == nil {
+		return notFound("file group %s not found", p["id"])
+	}
+
+	var body productFileBody
+	if err := decode(r, &body); err != nil {
+		return unprocessable("invalid product file: %s", err)
+	}
+
+	removeID(s.store.fileGroupFiles, fg.ID, body.ProductFile.ID)
+	return http.StatusNoContent, nil
+}
+
+// File groups
+
+type fileGroupBody struct {
+	FileGroup struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"file_group"`
+}
+
+func (s *Server) listFileGroups(r *http.Request, p params) (int, interface{}) {
+	if s.store.product(p["slug"]) == nil {
+		return notFound("product %s not found", p["slug"])
+	}
+
+	fileGroups := []pivnet.FileGroup{}
+	for _, fg := range s.store.fileGroups[p["slug"]] {
+		fileGroups = append(fileGroups, s.store.fileGroupView(p["slug"], fg))
+	}
+	return http.StatusOK, pivnet.FileGroupsResponse{FileGroups: fileGroups}
+}
+
+func (s *Server) createFileGroup(r *http.Request, p params) (int, interface{}) {
+	if s.store.product(p["slug"]) == nil {
+		return notFound("product %s not found", p["slug"])
+	}
+
+	var body fileGroupBody
+	if err := decode(r, &body); err != nil {
+		return unprocessable("invalid file group: %s", err)
+	}
+	if body.FileGroup.Name == "" {
+		return unprocessable("Name can't be blank")
+	}
+
+	return http.StatusCreated, s.store.addFileGroup(p["slug"], pivnet.FileGroup{Name: body.FileGroup.Name})
+}
+
+func (s *Server) getFileGroup(r *http.Request, p params) (int, interface{}) {
+	fg := s.store.fileGroup(p["slug"], p.id("id"))
+	if fg == nil {
+		return notFound("file group %s not found", p["id"])
+	}
+	return http.StatusOK, s.store.fileGroupView(p["slug"], fg)
+}
+
+func (s *Server) updateFileGroup(r *http.Request, p params) (int, interface{}) {
+	fg := s.store.fileGroup(p["slug"], p.id("id"))
+	if fg == nil {
+		return notFound("file group %s not found", p["id"])
+	}
+
+	var body fileGroupBody
+	if err := decode(r, &body); err != nil {
+		return unprocessable("invalid file group: %s", err)
+	}
+	if body.FileGroup.Name != "" {
+		fg.Name = body.FileGroup.Name
+	}
+
+	return http.StatusOK, s.store.fileGroupView(p["slug"], fg)
+}
+
+func (s *Server) deleteFileGroup(r *http.Request, p params) (int, interface{}) {
+	slug := p["slug"]
+	fg := s.store.fileGroup(slug, p.id("id"))
+	if fg == nil {
+		return notFound("file group %s not found", p["id"])
+	}
+
+	view := s.store.fileGroupView(slug, fg)
+
+	groups := s.store.fileGroups[slug]
+	for i, existing := range groups {
+		if existing.ID == fg.ID {
+			s.store.fileGroups[slug] = append(groups[:i], groups[i+1:]...)
+			break
+		}
+	}
+	delete(s.store.fileGroupFiles, fg.ID)
+
+	return http.StatusOK, view
+}
+
+func (s *Server) listReleaseFileGroups(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		fileGroups := []pivnet.FileGroup{}
+		for _, id := range s.store.releaseFileGroups[release.ID] {
+			if fg := s.store.fileGroup(product.Slug, id); fg != nil {
+				fileGroups = append(fileGroups, s.store.fileGroupView(product.Slug, fg))
+			}
+		}
+		return http.StatusOK, pivnet.FileGroupsResponse{FileGroups: fileGroups}
+	})
+}
+
+func (s *Server) addReleaseFileGroup(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		var body fileGroupBody
+		if err := decode(r, &body); err != nil {
+			return unprocessable("invalid file group: %s", err)
+		}
+		if s.store.fileGroup(product.Slug, body.FileGroup.ID) == nil {
+			return notFound("file group %d not found", body.FileGroup.ID)
+		}
+
+		addID(s.store.releaseFileGroups, release.ID, body.FileGroup.ID)
+		return http.StatusNoContent, nil
+	})
+}
+
+func (s *Server) removeReleaseFileGroup(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		var body fileGroupBody
+		if err := decode(r, &body); err != nil {
+			return unprocessable("invalid file group: %s", err)
+		}
+
+		removeID(s.store.releaseFileGroups, release.ID, body.FileGroup.ID)
+		return http.StatusNoContent, nil
+	})
+}
+
+// User groups
+
+type userGroupBody struct {
+	UserGroup pivnet.UserGroup `json:"user_group"`
+}
+
+type memberBody struct {
+	Member struct {
+		Email string `json:"email"`
+		Admin bool   `json:"admin"`
+	} `json:"member"`
+}
+
+func (s *Server) listUserGroups(r *http.Request, p params) (int, interface{}) {
+	userGroups := []pivnet.UserGroup{}
+	for _, ug := range s.store.userGroups {
+		userGroups = append(userGroups, *ug)
+	}
+	return http.StatusOK, pivnet.UserGroupsResponse{UserGroups: userGroups}
+}
+
+func (s *Server) createUserGroup(r *http.Request, p params) (int, interface{}) {
+	var body userGroupBody
+	if err := decode(r, &body); err != nil {
+		return unprocessable("invalid user group: %s", err)
+	}
+	if body.UserGroup.Name == "" {
+		return unprocessable("Name can't be blank")
+	}
+
+	body.UserGroup.ID = 0
+	return http.StatusCreated, s.store.addUserGroup(body.UserGroup)
+}
+
+func (s *Server) getUserGroup(r *http.Request, p params) (int, interface{}) {
+	ug := s.store.userGroup(p.id("id"))
+	if ug == nil {
+		return notFound("user group %s not found", p["id"])
+	}
+	return http.StatusOK, ug
+}
+
+func (s *Server) updateUserGroup(r *http.Request, p params) (int, interface{}) {
+	ug := s.store.userGroup(p.id("id"))
+	if ug == nil {
+		return notFound("user group %s not found", p["id"])
+	}
+
+	body := userGroupBody{UserGroup: *ug}
+	if err := decode(r, &body); err != nil {
+		return unprocessable("invalid user group: %s", err)
+	}
+
+	body.UserGroup.ID = ug.ID
+	*ug = body.UserGroup
+	return http.StatusOK, pivnet.UpdateUserGroupResponse{UserGroup: *ug}
+}
+
+func (s *Server) deleteUserGroup(r *http.Request, p params) (int, interface{}) {
+	id := p.id("id")
+	for i, ug := range s.store.userGroups {
+		if ug.ID == id {
+			s.store.userGroups = append(s.store.userGroups[:i], s.store.userGroups[i+1:]...)
+			return http.StatusNoContent, nil
+		}
+	}
+	return notFound("user group %s not found", p["id"])
+}
+
+func (s *Server) addUserGroupMember(r *http.Request, p params) (int, interface{}) {
+	ug := s.store.userGroup(p.id("id"))
+	if ug == nil {
+		return notFound("user group %s not found", p["id"])
+	}
+
+	var body memberBody
+	if err := decode(r, &body); err != nil || body.Member.Email == "" {
+		return unprocessable("invalid member")
+	}
+
+	ug.Members = append(removeString(ug.Members, body.Member.Email), body.Member.Email)
+	ug.Admins = removeString(ug.Admins, body.Member.Email)
+	if body.Member.Admin {
+		ug.Admins = append(ug.Admins, body.Member.Email)
+	}
+
+	return http.StatusOK, pivnet.UpdateUserGroupResponse{UserGroup: *ug}
+}
+
+func (s *Server) removeUserGroupMember(r *http.Request, p params) (int, interface{}) {
+	ug := s.store.userGroup(p.id("id"))
+	if ug == nil {
+		return notFound("user group %s not found", p["id"])
+	}
+
+	var body memberBody
+	if err := decode(r, &body); err != nil || body.Member.Email == "" {
+		return unprocessable("invalid member")
+	}
+
+	ug.Members = removeString(ug.Members, body.Member.Email)
+	ug.Admins = removeString(ug.Admins, body.Member.Email)
+
+	return http.StatusOK, pivnet.UpdateUserGroupResponse{UserGroup: *ug}
+}
+
+func (s *Server) listReleaseUserGroups(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		userGroups := []pivnet.UserGroup{}
+		for _, id := range s.store.releaseUserGroups[release.ID] {
+			if ug := s.store.userGroup(id); ug != nil {
+				userGroups = append(userGroups, *ug)
+			}
+		}
+		return http.StatusOK, pivnet.UserGroupsResponse{UserGroups: userGroups}
+	})
+}
+
+func (s *Server) addReleaseUserGroup(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		var body userGroupBody
+		if err := decode(r, &body); err != nil {
+			return unprocessable("invalid user group: %s", err)
+		}
+		if s.store.userGroup(body.UserGroup.ID) == nil {
+			return notFound("user group %d not found", body.UserGroup.ID)
+		}
+
+		addID(s.store.releaseUserGroups, release.ID, body.UserGroup.ID)
+		return http.StatusNoContent, nil
+	})
+}
+
+func (s *Server) removeReleaseUserGroup(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		var body userGroupBody
+		if err := decode(r, &body); err != nil {
+			return unprocessable("invalid user group: %s", err)
+		}
+
+		removeID(s.store.releaseUserGroups, release.ID, body.UserGroup.ID)
+		return http.StatusNoContent, nil
+	})
+}
+
+// EULAs
+
+func (s *Server) listEULAs(r *http.Request, p params) (int, interface{}) {
+	eulas := []pivnet.EULA{}
+	for _, e := range s.store.eulas {
+		eulas = append(eulas, *e)
+	}
+	return http.StatusOK, pivnet.EULAsResponse{EULAs: eulas}
+}
+
+func (s *Server) getEULA(r *http.Request, p params) (int, interface{}) {
+	e := s.store.eula(p["eula"])
+	if e == nil {
+		return notFound("eula %s not found", p["eula"])
+	}
+	return http.StatusOK, e
+}
+
+func (s *Server) acceptEULA(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		s.store.eulaAcceptances[release.ID] = true
+		return http.StatusOK, pivnet.EULAAcceptanceResponse{
+			AcceptedAt: time.Now().UTC().Format(time.RFC3339),
+		}
+	})
+}
+
+// Dependencies
+
+type dependencyBody struct {
+	Dependency struct {
+		ReleaseID int `json:"release_id"`
+	} `json:"dependency"`
+}
+
+type dependencySpecifierBody struct {
+	DependencySpecifier struct {
+		ProductSlug string `json:"product_slug"`
+		Specifier   string `json:"specifier"`
+	} `json:"dependency_specifier"`
+}
+
+func (s *Server) listDependencies(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		dependencies := []pivnet.ReleaseDependency{}
+		for _, id := range s.store.dependencies[release.ID] {
+			dependentProduct, dependent := s.store.findRelease(id)
+			if dependent == nil {
+				continue
+			}
+			dependencies = append(dependencies, pivnet.ReleaseDependency{
+				Release: pivnet.DependentRelease{
+					ID:      dependent.ID,
+					Version: dependent.Version,
+					Product: *dependentProduct,
+				},
+			})
+		}
+		return http.StatusOK, pivnet.ReleaseDependenciesResponse{ReleaseDependencies: dependencies}
+	})
+}
+
+func (s *Server) addDependency(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		var body dependencyBody
+		if err := decode(r, &body); err != nil {
+			return unprocessable("invalid dependency: %s", err)
+		}
+		if _, dependent := s.store.findRelease(body.Dependency.ReleaseID); dependent == nil {
+			return notFound("release %d not found", body.Dependency.ReleaseID)
+		}
+
+		addID(s.store.dependencies, release.ID, body.Dependency.ReleaseID)
+		return http.StatusNoContent, nil
+	})
+}
+
+func (s *Server) removeDependency(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		var body dependencyBody
+		if err := decode(r, &body); err != nil {
+			return unprocessable("invalid dependency: %s", err)
+		}
+
+		removeID(s.store.dependencies, release.ID, body.Dependency.ReleaseID)
+		return http.StatusNoContent, nil
+	})
+}
+
+func (s *Server) listDependencySpecifiers(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		specifiers := []pivnet.DependencySpecifier{}
+		for _, ds := range s.store.dependencySpecifiers[release.ID] {
+			specifiers = append(specifiers, *ds)
+		}
+		return http.StatusOK, pivnet.DependencySpecifiersResponse{DependencySpecifiers: specifiers}
+	})
+}
+
+func (s *Server) createDependencySpecifier(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		var body dependencySpecifierBody
+		if err := decode(r, &body); err != nil {
+			return unprocessable("invalid dependency specifier: %s", err)
+		}
+
+		dependentProduct := s.store.product(body.DependencySpecifier.ProductSlug)
+		if dependentProduct == nil {
+			return notFound("product %s not found", body.DependencySpecifier.ProductSlug)
+		}
+		if body.DependencySpecifier.Specifier == "" {
+			return unprocessable("Specifier can't be blank")
+		}
+
+		ds := &pivnet.DependencySpecifier{
+			ID:        s.store.id(0),
+			Product:   *dependentProduct,
+			Specifier: body.DependencySpecifier.Specifier,
+		}
+		s.store.dependencySpecifiers[release.ID] = append(s.store.dependencySpecifiers[release.ID], ds)
+
+		return http.StatusCreated, pivnet.DependencySpecifierResponse{DependencySpecifier: *ds}
+	})
+}
+
+func (s *Server) getDependencySpecifier(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		for _, ds := range s.store.dependencySpecifiers[release.ID] {
+			if ds.ID == p.id("id") {
+				return http.StatusOK, pivnet.DependencySpecifierResponse{DependencySpecifier: *ds}
+			}
+		}
+		return notFound("dependency specifier %s not found", p["id"])
+	})
+}
+
+func (s *Server) deleteDependencySpecifier(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		specifiers := s.store.dependencySpecifiers[release.ID]
+		for i, ds := range specifiers {
+			if ds.ID == p.id("id") {
+				s.store.dependencySpecifiers[release.ID] = append(specifiers[:i], specifiers[i+1:]...)
+				return http.StatusNoContent, nil
+			}
+		}
+		return notFound("dependency specifier %s not found", p["id"])
+	})
+}
+
+// Upgrade paths
+
+type upgradePathBody struct {
+	UpgradePath struct {
+		ReleaseID int `json:"release_id"`
+	} `json:"upgrade_path"`
+}
+
+type upgradePathSpecifierBody struct {
+	UpgradePathSpecifier struct {
+		Specifier string `json:"specifier"`
+	} `json:"upgrade_path_specifier"`
+}
+
+func (s *Server) listUpgradePaths(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		upgradePaths := []pivnet.ReleaseUpgradePath{}
+		for _, id := range s.store.upgradePaths[release.ID] {
+			if previous := s.store.release(product.Slug, id); previous != nil {
+				upgradePaths = append(upgradePaths, pivnet.ReleaseUpgradePath{
+					Release: pivnet.UpgradePathRelease{ID: previous.ID, Version: previous.Version},
+				})
+			}
+		}
+		return http.StatusOK, pivnet.ReleaseUpgradePathsResponse{ReleaseUpgradePaths: upgradePaths}
+	})
+}
+
+func (s *Server) addUpgradePath(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		var body upgradePathBody
+		if err := decode(r, &body); err != nil {
+			return unprocessable("invalid upgrade path: %s", err)
+		}
+		if s.store.release(product.Slug, body.UpgradePath.ReleaseID) == nil {
+			return notFound("release %d not found", body.UpgradePath.ReleaseID)
+		}
+
+		addID(s.store.upgradePaths, release.ID, body.UpgradePath.ReleaseID)
+		return http.StatusNoContent, nil
+	})
+}
+
+func (s *Server) removeUpgradePath(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		var body upgradePathBody
+		if err := decode(r, &body); err != nil {
+			return unprocessable("invalid upgrade path: %s", err)
+		}
+
+		removeID(s.store.upgradePaths, release.ID, body.UpgradePath.ReleaseID)
+		return http.StatusNoContent, nil
+	})
+}
+
+func (s *Server) listUpgradePathSpecifiers(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		specifiers := []pivnet.UpgradePathSpecifier{}
+		for _, ups := range s.store.upgradePathSpecifiers[release.ID] {
+			specifiers = append(specifiers, *ups)
+		}
+		return http.StatusOK, pivnet.UpgradePathSpecifiersResponse{UpgradePathSpecifiers: specifiers}
+	})
+}
+
+func (s *Server) createUpgradePathSpecifier(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		var body upgradePathSpecifierBody
+		if err := decode(r, &body); err != nil {
+			return unprocessable("invalid upgrade path specifier: %s", err)
+		}
+		if body.UpgradePathSpecifier.Specifier == "" {
+			return unprocessable("Specifier can't be blank")
+		}
+
+		ups := &pivnet.UpgradePathSpecifier{
+			ID:        s.store.id(0),
+			Specifier: body.UpgradePathSpecifier.Specifier,
+		}
+		s.store.upgradePathSpecifiers[release.ID] = append(s.store.upgradePathSpecifiers[release.ID], ups)
+
+		return http.StatusCreated, pivnet.UpgradePathSpecifierResponse{UpgradePathSpecifier: *ups}
+	})
+}
+
+func (s *Server) getUpgradePathSpecifier(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		for _, ups := range s.store.upgradePathSpecifiers[release.ID] {
+			if ups.ID == p.id("id") {
+				return http.StatusOK, pivnet.UpgradePathSpecifierResponse{UpgradePathSpecifier: *ups}
+			}
+		}
+		return notFound("upgrade path specifier %s not found", p["id"])
+	})
+}
+
+func (s *Server) deleteUpgradePathSpecifier(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		specifiers := s.store.upgradePathSpecifiers[release.ID]
+		for i, ups := range specifiers {
+			if ups.ID == p.id("id") {
+				s.store.upgradePathSpecifiers[release.ID] = append(specifiers[:i], specifiers[i+1:]...)
+				return http.StatusNoContent, nil
+			}
+		}
+		return notFound("upgrade path specifier %s not found", p["id"])
+	})
+}
+
+// Artifact references
+
+type artifactReferenceBody struct {
+	ArtifactReference pivnet.ArtifactReference `json:"artifact_reference"`
+}
+
+func (s *Server) listArtifactReferences(r *http.Request, p params) (int, interface{}) {
+	if s.store.product(p["slug"]) == nil {
+		return notFound("product %s not found", p["slug"])
+	}
+
+	digest := r.URL.Query().Get("digest")
+
+	artifactReferences := []pivnet.ArtifactReference{}
+	for _, ar := range s.store.artifactReferences[p["slug"]] {
+		if digest == "" || ar.Digest == digest {
+			artifactReferences = append(artifactReferences, *ar)
+		}
+	}
+	return http.StatusOK, pivnet.ArtifactReferencesResponse{ArtifactReferences: artifactReferences}
+}
+
+func (s *Server) createArtifactReference(r *http.Request, p params) (int, interface{}) {
+	if s.store.product(p["slug"]) == nil {
+		return notFound("product %s not found", p["slug"])
+	}
+
+	var body artifactReferenceBody
+	if err := decode(r, &body); err != nil {
+		return unprocessable("invalid artifact reference: %s", err)
+	}
+	if body.ArtifactReference.ArtifactPath == "" || body.ArtifactReference.Digest == "" {
+		return unprocessable("Artifact path and digest can't be blank")
+	}
+
+	body.ArtifactReference.ID = 0
+	ar := s.store.addArtifactReference(p["slug"], body.ArtifactReference)
+	return http.StatusCreated, pivnet.ArtifactReferenceResponse{ArtifactReference: ar}
+}
+
+func (s *Server) getArtifactReference(r *http.Request, p params) (int, interface{}) {
+	ar := s.store.artifactReference(p["slug"], p.id("id"))
+	if ar == nil {
+		return notFound("artifact reference %s not found", p["id"])
+	}
+	return http.StatusOK, pivnet.ArtifactReferenceResponse{ArtifactReference: *ar}
+}
+
+func (s *Server) updateArtifactReference(r *http.Request, p params) (int, interface{}) {
+	ar := s.store.artifactReference(p["slug"], p.id("id"))
+	if ar == nil {
+		return notFound("artifact reference %s not found", p["id"])
+	}
+
+	body := artifactReferenceBody{ArtifactReference: *ar}
+	if err := decode(r, &body); err != nil {
+		return unprocessable("invalid artifact reference: %s", err)
+	}
+
+	body.ArtifactReference.ID = ar.ID
+	*ar = body.ArtifactReference
+	return http.StatusOK, pivnet.ArtifactReferenceResponse{ArtifactReference: *ar}
+}
+
+func (s *Server) deleteArtifactReference(r *http.Request, p params) (int, interface{}) {
+	slug := p["slug"]
+	ar := s.store.artifactReference(slug, p.id("id"))
+	if ar == nil {
+		return notFound("artifact reference %s not found", p["id"])
+	}
+
+	refs := s.store.artifactReferences[slug]
+	for i, existing := range refs {
+		if existing.ID == ar.ID {
+			s.store.artifactReferences[slug] = append(refs[:i], refs[i+1:]...)
+			break
+		}
+	}
+
+	return http.StatusOK, pivnet.ArtifactReferenceResponse{ArtifactReference: *ar}
+}
+
+func (s *Server) listReleaseArtifactReferences(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		artifactReferences := []pivnet.ArtifactReference{}
+		for _, id := range s.store.releaseArtifactReferences[release.ID] {
+			if ar := s.store.artifactReference(product.Slug, id); ar != nil {
+				artifactReferences = append(artifactReferences, *ar)
+			}
+		}
+		return http.StatusOK, pivnet.ArtifactReferencesResponse{ArtifactReferences: artifactReferences}
+	})
+}
+
+func (s *Server) getReleaseArtifactReference(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		ar := s.store.artifactReference(product.Slug, p.id("id"))
+		if ar == nil || !containsID(s.store.releaseArtifactReferences[release.ID], ar.ID) {
+			return notFound("artifact reference %s not found", p["id"])
+		}
+		return http.StatusOK, pivnet.ArtifactReferenceResponse{ArtifactReference: *ar}
+	})
+}
+
+func (s *Server) addReleaseArtifactReference(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		var body artifactReferenceBody
+		if err := decode(r, &body); err != nil {
+			return unprocessable("invalid artifact reference: %s", err)
+		}
+		if s.store.artifactReference(product.Slug, body.ArtifactReference.ID) == nil {
+			return notFound("artifact reference %d not found", body.ArtifactReference.ID)
+		}
+
+		addID(s.store.releaseArtifactReferences, release.ID, body.ArtifactReference.ID)
+		return http.StatusNoContent, nil
+	})
+}
+
+func (s *Server) removeReleaseArtifactReference(r *http.Request, p params) (int, interface{}) {
+	return s.withRelease(p, func(product *pivnet.Product, release *pivnet.Release) (int, interface{}) {
+		var body artifactReferenceBody
+		if err := decode(r, &body); err != nil {
+			return unprocessable("invalid artifact reference: %s", err)
+		}
+
+		removeID(s.store.releaseArtifactReferences, release.ID, body.ArtifactReference.ID)
+		return http.StatusNoContent, nil
+	})
+}
+
+// paginate applies the per_page and page query parameters to a collection of
+// total items, returning the bounds of the requested page and its links.
+// Without per_page the whole collection is returned.
+func (s *Server) paginate(r *http.Request, total int) (int, int, *pivnet.Links) {
+	query := r.URL.Query()
+
+	perPage, err := strconv.Atoi(query.Get("per_page"))
+	if err != nil || perPage <= 0 {
+		return 0, total, nil
+	}
+
+	page, err := strconv.Atoi(query.Get("page"))
+	if err != nil || page <= 0 {
+		page = 1
+	}
+
+	start := (page - 1) * perPage
+	if start > total {
+		start = total
+	}
+	end := start + perPage
+	if end > total {
+		end = total
+	}
+
+	pageLink := func(n int) map[string]string {
+		q := url.Values{}
+		for k, v := range query {
+			q[k] = v
+		}
+		q.Set("page", strconv.Itoa(n))
+		return map[string]string{"href": s.URL() + r.URL.Path + "?" + q.Encode()}
+	}
+
+	links := &pivnet.Links{Self: pageLink(page)}
+	if end < total {
+		links.Next = pageLink(page + 1)
+	}
+	if page > 1 {
+		links.Previous = pageLink(page - 1)
+	}
+
+	return start, end, links
+}
@@ -0,0 +1,13 @@
+package pivnettest_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestPivnettest(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Pivnettest Suite")
+}
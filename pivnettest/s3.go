@@ -0,0 +1,276 @@
+package pivnettest
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet/v9"
+)
+
+const s3Path = "/s3/"
+
+// The federation token handed out by the Server grants access to its S3
+// stand-in.
+const (
+	S3Bucket          = "pivnettest-bucket"
+	S3Region          = "us-east-1"
+	S3AccessKeyID     = "pivnettest-access-key-id"
+	S3SecretAccessKey = "pivnettest-secret-access-key"
+	S3SessionToken    = "pivnettest-session-token"
+)
+
+type s3Upload struct {
+	key       string
+	initiated time.Time
+	parts     map[int][]byte
+}
+
+// S3Endpoint is the endpoint of the Server's S3 stand-in, to configure the
+// client with as ClientConfig.S3Endpoint. It supports the path style
+// requests of multipart uploads, and checks that they are signed with the
+// federation token's credentials.
+func (s *Server) S3Endpoint() string {
+	return s.URL() + strings.TrimSuffix(s3Path, "/")
+}
+
+// S3Object returns the content of an object uploaded to S3Bucket.
+func (s *Server) S3Object(key string) ([]byte, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	content, ok := s.store.s3Objects[key]
+	return content, ok
+}
+
+// S3Uploads returns the keys of the multipart uploads that have been started
+// but not completed or aborted.
+func (s *Server) S3Uploads() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var keys []string
+	for _, u := range s.store.s3Uploads {
+		keys = append(keys, u.key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (s *Server) createFederationToken(r *http.Request, p params) (int, interface{}) {
+	var body struct {
+		ProductID string `json:"product_id"`
+	}
+	if err := decode(r, &body); err != nil {
+		return unprocessable("invalid federation token request: %s", err)
+	}
+
+	if s.store.product(body.ProductID) == nil {
+		return notFound("product %s not found", body.ProductID)
+	}
+
+	return http.StatusOK, pivnet.FederationToken{
+		AccessKeyID:     S3AccessKeyID,
+		SecretAccessKey: S3SecretAccessKey,
+		SessionToken:    S3SessionToken,
+		Bucket:          S3Bucket,
+		Region:          S3Region,
+	}
+}
+
+type s3ErrorBody struct {
+	XMLName xml.Name `xml:"Error"`
+	Code    string   `xml:"Code"`
+	Message string   `xml:"Message"`
+}
+
+type s3UploadBody struct {
+	Key       string `xml:"Key"`
+	UploadID  string `xml:"UploadId"`
+	Initiated string `xml:"Initiated"`
+}
+
+type s3PartBody struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+	Size       int    `xml:"Size"`
+}
+
+func writeXML(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(statusCode)
+	if body != nil {
+		_ = xml.NewEncoder(w).Encode(body)
+	}
+}
+
+func writeS3Error(w http.ResponseWriter, statusCode int, code string, message string) {
+	writeXML(w, statusCode, s3ErrorBody{Code: code, Message: message})
+}
+
+func s3ETag(content []byte) string {
+	return fmt.Sprintf("%q", fmt.Sprintf("%x", md5.Sum(content)))
+}
+
+// serveS3 implements the parts of the S3 API used by multipart uploads.
+func (s *Server) serveS3(w http.ResponseWriter, r *http.Request, body []byte) {
+	if r.Header.Get("X-Amz-Security-Token") != S3SessionToken {
+		writeS3Error(w, http.StatusForbidden, "AccessDenied", "Access Denied")
+		return
+	}
+
+	if err := verifySignature(r, body); err != nil {
+		writeS3Error(w, http.StatusForbidden, "SignatureDoesNotMatch", err.Error())
+		return
+	}
+
+	bucketAndKey := strings.SplitN(strings.TrimPrefix(r.URL.Path, s3Path), "/", 2)
+	if bucketAndKey[0] != S3Bucket {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+		return
+	}
+
+	key := ""
+	if len(bucketAndKey) == 2 {
+		key = bucketAndKey[1]
+	}
+
+	if md5Header := r.Header.Get("Content-MD5"); md5Header != "" {
+		sum := md5.Sum(body)
+		if md5Header != base64.StdEncoding.EncodeToString(sum[:]) {
+			writeS3Error(w, http.StatusBadRequest, "BadDigest", "The Content-MD5 you specified did not match what we received.")
+			return
+		}
+	}
+
+	query := r.URL.Query()
+	_, uploads := query["uploads"]
+	uploadID := query.Get("uploadId")
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	switch {
+	case r.Method == "GET" && key == "" && uploads:
+		s.listS3Uploads(w, query.Get("prefix"))
+	case r.Method == "POST" && uploads:
+		s.store.s3UploadIDs++
+		id := fmt.Sprintf("pivnettest-upload-%d", s.store.s3UploadIDs)
+		s.store.s3Uploads[id] = &s3Upload{key: key, initiated: time.Now(), parts: map[int][]byte{}}
+
+		writeXML(w, http.StatusOK, struct {
+			XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+			Bucket   string   `xml:"Bucket"`
+			Key      string   `xml:"Key"`
+			UploadID string   `xml:"UploadId"`
+		}{Bucket: S3Bucket, Key: key, UploadID: id})
+	case uploadID != "":
+		upload, ok := s.store.s3Uploads[uploadID]
+		if !ok || upload.key != key {
+			writeS3Error(w, http.StatusNotFound, "NoSuchUpload", "The specified upload does not exist.")
+			return
+		}
+		s.serveS3Upload(w, r, uploadID, upload, body)
+	case r.Method == "GET" && key != "":
+		content, ok := s.store.s3Objects[key]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+			return
+		}
+		w.Header().Set("ETag", s3ETag(content))
+		_, _ = w.Write(content)
+	default:
+		writeS3Error(w, http.StatusNotImplemented, "NotImplemented", fmt.Sprintf("%s %s is not supported by pivnettest", r.Method, r.URL))
+	}
+}
+
+// listS3Uploads must be called with the mutex held.
+func (s *Server) listS3Uploads(w http.ResponseWriter, prefix string) {
+	var uploads []s3UploadBody
+	for id, u := range s.store.s3Uploads {
+		if strings.HasPrefix(u.key, prefix) {
+			uploads = append(uploads, s3UploadBody{Key: u.key, UploadID: id, Initiated: u.initiated.UTC().Format(time.RFC3339Nano)})
+		}
+	}
+	sort.Slice(uploads, func(i, j int) bool { return uploads[i].UploadID < uploads[j].UploadID })
+
+	writeXML(w, http.StatusOK, struct {
+		XMLName     xml.Name       `xml:"ListMultipartUploadsResult"`
+		Bucket      string         `xml:"Bucket"`
+		IsTruncated bool           `xml:"IsTruncated"`
+		Uploads     []s3UploadBody `xml:"Upload"`
+	}{Bucket: S3Bucket, Uploads: uploads})
+}
+
+// serveS3Upload must be called with the mutex held.
+func (s *Server) serveS3Upload(w http.ResponseWriter, r *http.Request, uploadID string, upload *s3Upload, body []byte) {
+	switch r.Method {
+	case "PUT":
+		partNumber, err := strconv.Atoi(r.URL.Query().Get("partNumber"))
+		if err != nil || partNumber < 1 || partNumber > 10000 {
+			writeS3Error(w, http.StatusBadRequest, "InvalidArgument", "Part number must be an integer between 1 and 10000, inclusive")
+			return
+		}
+
+		upload.parts[partNumber] = body
+		w.Header().Set("ETag", s3ETag(body))
+		w.WriteHeader(http.StatusOK)
+	case "GET":
+		var parts []s3PartBody
+		for n, content := range upload.parts {
+			parts = append(parts, s3PartBody{PartNumber: n, ETag: s3ETag(content), Size: len(content)})
+		}
+		sort.Slice(parts, func(i, j int) bool { return parts[i].PartNumber < parts[j].PartNumber })
+
+		writeXML(w, http.StatusOK, struct {
+			XMLName     xml.Name     `xml:"ListPartsResult"`
+			UploadID    string       `xml:"UploadId"`
+			IsTruncated bool         `xml:"IsTruncated"`
+			Parts       []s3PartBody `xml:"Part"`
+		}{UploadID: uploadID, Parts: parts})
+	case "POST":
+		var complete struct {
+			Parts []s3PartBody `xml:"Part"`
+		}
+		if err := xml.Unmarshal(body, &complete); err != nil || len(complete.Parts) == 0 {
+			writeS3Error(w, http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed")
+			return
+		}
+
+		var content bytes.Buffer
+		for i, part := range complete.Parts {
+			data, ok := upload.parts[part.PartNumber]
+			if !ok || s3ETag(data) != part.ETag {
+				writeS3Error(w, http.StatusBadRequest, "InvalidPart", fmt.Sprintf("part %d was not uploaded or its ETag does not match", part.PartNumber))
+				return
+			}
+			if i > 0 && part.PartNumber <= complete.Parts[i-1].PartNumber {
+				writeS3Error(w, http.StatusBadRequest, "InvalidPartOrder", "The list of parts was not in ascending order.")
+				return
+			}
+			content.Write(data)
+		}
+
+		s.store.s3Objects[upload.key] = content.Bytes()
+		delete(s.store.s3Uploads, uploadID)
+
+		writeXML(w, http.StatusOK, struct {
+			XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+			Bucket  string   `xml:"Bucket"`
+			Key     string   `xml:"Key"`
+			ETag    string   `xml:"ETag"`
+		}{Bucket: S3Bucket, Key: upload.key, ETag: s3ETag(content.Bytes())})
+	case "DELETE":
+		delete(s.store.s3Uploads, uploadID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeS3Error(w, http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed against this resource.")
+	}
+}
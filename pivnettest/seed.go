@@ -0,0 +1,89 @@
+package pivnettest
+
+import (
+	"github.com/pivotal-cf/go-pivnet/v9"
+)
+
+// The Add methods seed the Server's state directly, bypassing the API. Zero
+// IDs are assigned by the Server; the stored value is returned.
+
+func (s *Server) AddProduct(product pivnet.Product) pivnet.Product {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.store.addProduct(product)
+}
+
+func (s *Server) AddRelease(productSlug string, release pivnet.Release) pivnet.Release {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.store.addRelease(productSlug, release)
+}
+
+// AddProductFile adds a product file whose download serves content. SHA256,
+// MD5 and Size are filled in from content when not set. A non-zero releaseID
+// also adds the file to that release.
+func (s *Server) AddProductFile(productSlug string, releaseID int, productFile pivnet.ProductFile, content []byte) pivnet.ProductFile {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	pf := s.store.addProductFile(productSlug, productFile, content)
+	if releaseID != 0 {
+		addID(s.store.releaseProductFiles, releaseID, pf.ID)
+	}
+
+	return pf
+}
+
+// AddFileGroup adds a file group containing the product files listed in
+// fileGroup.ProductFiles, which must already have been added. A non-zero
+// releaseID also adds the group to that release.
+func (s *Server) AddFileGroup(productSlug string, releaseID int, fileGroup pivnet.FileGroup) pivnet.FileGroup {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	fg := s.store.addFileGroup(productSlug, fileGroup)
+	if releaseID != 0 {
+		addID(s.store.releaseFileGroups, releaseID, fg.ID)
+	}
+
+	return fg
+}
+
+func (s *Server) AddUserGroup(userGroup pivnet.UserGroup) pivnet.UserGroup {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.store.addUserGroup(userGroup)
+}
+
+func (s *Server) AddEULA(eula pivnet.EULA) pivnet.EULA {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.store.addEULA(eula)
+}
+
+func (s *Server) AddArtifactReference(productSlug string, artifactReference pivnet.ArtifactReference) pivnet.ArtifactReference {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.store.addArtifactReference(productSlug, artifactReference)
+}
+
+// SetVersions sets the response of the /versions endpoint.
+func (s *Server) SetVersions(versions pivnet.PivnetVersions) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.store.versions = versions
+}
+
+// EULAAccepted reports whether the EULA has been accepted for the release.
+func (s *Server) EULAAccepted(releaseID int) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.store.eulaAcceptances[releaseID]
+}
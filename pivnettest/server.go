@@ -0,0 +1,300 @@
+// Package pivnettest provides an in-process fake of the Pivnet API that a real
+// pivnet.Client can be pointed at in tests.
+package pivnettest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	apiVersion = "/api/v2"
+	blobsPath  = "/blobs/"
+)
+
+// Fault describes a canned error response returned in place of the normal
+// handling of matching requests.
+type Fault struct {
+	// Method matches the request method. Empty matches any method.
+	Method string
+
+	// Path is a path.Match pattern matched against the request path with the
+	// /api/v2 prefix removed, e.g. "/products/*/releases". Empty matches any
+	// path.
+	Path string
+
+	StatusCode int
+	Message    string
+	Header     http.Header
+
+	// Times is the number of requests the fault applies to before it is
+	// removed. Zero applies it to every matching request.
+	Times int
+}
+
+// RecordedRequest is a request received by the Server.
+type RecordedRequest struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// Server is a stateful fake of the Pivnet API backed by an httptest.Server.
+// It is safe for concurrent use.
+type Server struct {
+	server *httptest.Server
+
+	mutex    sync.Mutex
+	faults   []*Fault
+	requests []RecordedRequest
+	store    *store
+	routes   []route
+
+	listingsOmitChecksums bool
+}
+
+// NewServer starts a Server. Callers should Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		store: newStore(),
+	}
+	s.routes = s.apiRoutes()
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// URL is the host to configure the client with, e.g. as ClientConfig.Host.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// InjectFault makes matching requests fail. Faults are checked in the order
+// they were injected.
+func (s *Server) InjectFault(f Fault) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.faults = append(s.faults, &f)
+}
+
+func (s *Server) ClearFaults() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.faults = nil
+}
+
+// OmitChecksumsFromListings makes product file listings leave out the
+// checksums, as Pivnet's listings may. Getting a single product file still
+// returns them.
+func (s *Server) OmitChecksumsFromListings(omit bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.listingsOmitChecksums = omit
+}
+
+// Requests returns the requests received so far, oldest first.
+func (s *Server) Requests() []RecordedRequest {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]RecordedRequest{}, s.requests...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	p := strings.TrimPrefix(r.URL.Path, apiVersion)
+
+	s.mutex.Lock()
+	s.requests = append(s.requests, RecordedRequest{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+	fault, faulted := s.takeFault(r.Method, p)
+	s.mutex.Unlock()
+
+	if faulted {
+		for k, v := range fault.Header {
+			w.Header()[k] = v
+		}
+		writeError(w, fault.StatusCode, fault.Message)
+		return
+	}
+
+	if strings.HasPrefix(r.URL.Path, blobsPath) {
+		s.serveBlob(w, r)
+		return
+	}
+
+	if strings.HasPrefix(r.URL.Path, s3Path) {
+		s.serveS3(w, r, body)
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, apiVersion+"/") {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+
+	for _, rt := range s.routes {
+		params, ok := rt.match(r.Method, p)
+		if !ok {
+			continue
+		}
+
+		if rt.authenticated && r.Header.Get("Authorization") == "" {
+			writeError(w, http.StatusUnauthorized, "401 Unauthorized")
+			return
+		}
+
+		s.mutex.Lock()
+		statusCode, body := rt.handler(r, params)
+		s.mutex.Unlock()
+
+		switch b := body.(type) {
+		case apiError:
+			writeError(w, b.statusCode, b.message)
+		case redirect:
+			http.Redirect(w, r, string(b), statusCode)
+		default:
+			writeJSON(w, statusCode, body)
+		}
+		return
+	}
+
+	writeError(w, http.StatusNotFound, fmt.Sprintf("no route for %s %s", r.Method, r.URL.Path))
+}
+
+// serveBlob serves product file content, standing in for the presigned S3
+// URLs Pivnet redirects downloads to. Range and HEAD requests are supported.
+func (s *Server) serveBlob(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, blobsPath))
+
+	s.mutex.Lock()
+	content, ok := s.store.blobs[id]
+	s.mutex.Unlock()
+
+	if err != nil || !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(content))
+}
+
+// takeFault must be called with the mutex held.
+func (s *Server) takeFault(method string, p string) (Fault, bool) {
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != method {
+			continue
+		}
+
+		if f.Path != "" {
+			if ok, _ := path.Match(f.Path, p); !ok {
+				continue
+			}
+		}
+
+		fault := *f
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+
+		return fault, true
+	}
+
+	return Fault{}, false
+}
+
+// writeError writes the error body in the shape Pivnet uses for the status
+// code, so the client maps it to the same typed error.
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	var body interface{}
+	if statusCode == http.StatusInternalServerError {
+		body = map[string]string{"error": message}
+	} else {
+		body = map[string]interface{}{"message": message, "errors": []string{}}
+	}
+
+	writeJSON(w, statusCode, body)
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+type route struct {
+	method        string
+	segments      []string
+	authenticated bool
+	handler       handlerFunc
+}
+
+func newRoute(method string, pattern string, handler handlerFunc) route {
+	return route{
+		method:   method,
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		handler:  handler,
+	}
+}
+
+type params map[string]string
+
+// match compares the path against the route's pattern, where segments
+// starting with ":" capture the corresponding path segment.
+func (rt route) match(method string, p string) (params, bool) {
+	if rt.method != method {
+		return nil, false
+	}
+
+	segments := strings.Split(strings.Trim(p, "/"), "/")
+	if len(segments) != len(rt.segments) {
+		return nil, false
+	}
+
+	ps := params{}
+	for i, seg := range rt.segments {
+		if strings.HasPrefix(seg, ":") {
+			ps[seg[1:]] = segments[i]
+		} else if seg != segments[i] {
+			return nil, false
+		}
+	}
+
+	return ps, true
+}
@@ -0,0 +1,408 @@
+package pivnettest_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet/v9"
+	"github.com/pivotal-cf/go-pivnet/v9/download"
+	"github.com/pivotal-cf/go-pivnet/v9/logger/loggerfakes"
+	"github.com/pivotal-cf/go-pivnet/v9/pivnettest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Server", func() {
+	const refreshToken = "some-refresh-token-longer-than-a-legacy-token"
+
+	var (
+		server *pivnettest.Server
+		client pivnet.Client
+
+		product pivnet.Product
+		release pivnet.Release
+	)
+
+	BeforeEach(func() {
+		server = pivnettest.NewServer()
+
+		product = server.AddProduct(pivnet.Product{Slug: "some-product", Name: "Some Product"})
+		release = server.AddRelease(product.Slug, pivnet.Release{Version: "1.0.0"})
+
+		token := pivnet.NewAccessTokenOrLegacyToken(refreshToken, server.URL(), false)
+		client = pivnet.NewClient(token, pivnet.ClientConfig{Host: server.URL()}, &loggerfakes.FakeLogger{})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("exchanges the refresh token for an access token", func() {
+		_, err := client.Products.Get(product.Slug)
+		Expect(err).NotTo(HaveOccurred())
+
+		requests := server.Requests()
+		Expect(requests[0].Path).To(Equal("/api/v2/authentication/access_tokens"))
+		Expect(requests[1].Header.Get("Authorization")).To(HavePrefix("Bearer pivnettest-access-token-"))
+	})
+
+	It("rejects requests without an Authorization header", func() {
+		resp, err := http.Get(server.URL() + "/api/v2/products")
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+
+		Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+	})
+
+	Describe("releases", func() {
+		It("creates, updates and deletes releases", func() {
+			created, err := client.Releases.Create(pivnet.CreateReleaseConfig{
+				ProductSlug: product.Slug,
+				Version:     "2.0.0",
+				ReleaseType: "Major Release",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.ID).NotTo(BeZero())
+
+			created.Description = "some description"
+			updated, err := client.Releases.Update(product.Slug, created)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.Description).To(Equal("some description"))
+			Expect(updated.Version).To(Equal("2.0.0"))
+
+			Expect(client.Releases.Delete(product.Slug, updated)).To(Succeed())
+
+			releases, err := client.Releases.List(product.Slug)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releases).To(Equal([]pivnet.Release{release}))
+		})
+
+		It("rejects duplicate versions", func() {
+			_, err := client.Releases.Create(pivnet.CreateReleaseConfig{
+				ProductSlug: product.Slug,
+				Version:     release.Version,
+			})
+			Expect(err).To(BeAssignableToTypeOf(pivnet.ErrPivnetOther{}))
+			Expect(err.(pivnet.ErrPivnetOther).ResponseCode).To(Equal(http.StatusUnprocessableEntity))
+		})
+
+		It("paginates listings", func() {
+			server.AddRelease(product.Slug, pivnet.Release{Version: "1.1.0"})
+			server.AddRelease(product.Slug, pivnet.Release{Version: "1.2.0"})
+
+			releases, err := client.Releases.ListAll(product.Slug, pivnet.ListOptions{PerPage: 2})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releases).To(HaveLen(3))
+			Expect(releases[2].Version).To(Equal("1.2.0"))
+		})
+
+		It("returns ErrNotFound for unknown releases", func() {
+			_, err := client.Releases.Get(product.Slug, 9999)
+			Expect(err).To(BeAssignableToTypeOf(pivnet.ErrNotFound{}))
+		})
+	})
+
+	Describe("product files", func() {
+		var (
+			content     []byte
+			productFile pivnet.ProductFile
+		)
+
+		BeforeEach(func() {
+			content = make([]byte, 1024*1024)
+			for i := range content {
+				content[i] = byte(i % 251)
+			}
+
+			productFile = server.AddProductFile(product.Slug, release.ID, pivnet.ProductFile{
+				Name:         "some-file",
+				AWSObjectKey: "product-files/some-file.tgz",
+			}, content)
+		})
+
+		It("fills in the checksums and size from the content", func() {
+			pf, err := client.ProductFiles.GetForRelease(product.Slug, release.ID, productFile.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pf.Size).To(Equal(len(content)))
+			Expect(pf.SHA256).To(HaveLen(64))
+			Expect(pf.MD5).To(HaveLen(32))
+		})
+
+		It("can leave the checksums out of listings", func() {
+			server.OmitChecksumsFromListings(true)
+
+			listed, err := client.ProductFiles.ListForRelease(product.Slug, release.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listed).To(HaveLen(1))
+			Expect(listed[0].SHA256).To(BeEmpty())
+			Expect(listed[0].MD5).To(BeEmpty())
+
+			pf, err := client.ProductFiles.GetForRelease(product.Slug, release.ID, productFile.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pf.SHA256).To(HaveLen(64))
+		})
+
+		It("adds and removes files from releases and file groups", func() {
+			created, err := client.ProductFiles.Create(pivnet.CreateProductFileConfig{
+				ProductSlug:  product.Slug,
+				AWSObjectKey: "product-files/other-file.tgz",
+				Name:         "other-file",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ProductFiles.AddToRelease(product.Slug, release.ID, created.ID)).To(Succeed())
+
+			productFiles, err := client.ProductFiles.ListForRelease(product.Slug, release.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(productFiles).To(HaveLen(2))
+
+			Expect(client.ProductFiles.RemoveFromRelease(product.Slug, release.ID, created.ID)).To(Succeed())
+
+			fileGroup, err := client.FileGroups.Create(pivnet.CreateFileGroupConfig{ProductSlug: product.Slug, Name: "some-group"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.ProductFiles.AddToFileGroup(product.Slug, fileGroup.ID, created.ID)).To(Succeed())
+			Expect(client.FileGroups.AddToRelease(product.Slug, release.ID, fileGroup.ID)).To(Succeed())
+
+			fileGroups, err := client.FileGroups.ListForRelease(product.Slug, release.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fileGroups).To(HaveLen(1))
+			Expect(fileGroups[0].ProductFiles).To(HaveLen(1))
+			Expect(fileGroups[0].ProductFiles[0].ID).To(Equal(created.ID))
+		})
+
+		It("serves ranged downloads through a redirect", func() {
+			tmpFile, err := ioutil.TempFile("", "pivnettest")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.Remove(tmpFile.Name())
+
+			fileInfo, err := download.NewFileInfo(tmpFile)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = client.ProductFiles.DownloadForRelease(fileInfo, product.Slug, release.ID, productFile.ID, ioutil.Discard)
+			Expect(err).NotTo(HaveOccurred())
+
+			downloaded, err := ioutil.ReadFile(tmpFile.Name())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(downloaded).To(Equal(content))
+
+			var ranged int
+			for _, r := range server.Requests() {
+				if r.Method == "GET" && r.Header.Get("Range") != "" {
+					ranged++
+				}
+			}
+			Expect(ranged).To(BeNumerically(">", 1))
+		})
+
+		Context("when the release has a EULA", func() {
+			BeforeEach(func() {
+				eula := server.AddEULA(pivnet.EULA{Slug: "some-eula", Name: "Some EULA"})
+				release.EULA = &eula
+				_, err := client.Releases.Update(product.Slug, release)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("requires the EULA to be accepted before downloading", func() {
+				fetcher := pivnet.NewProductFileLinkFetcher(
+					fmt.Sprintf("%s/api/v2/products/%s/releases/%d/product_files/%d/download", server.URL(), product.Slug, release.ID, productFile.ID),
+					client,
+				)
+
+				_, err := fetcher.NewDownloadLink()
+				Expect(err).To(BeAssignableToTypeOf(pivnet.ErrUnavailableForLegalReasons{}))
+
+				Expect(client.EULA.Accept(product.Slug, release.ID)).To(Succeed())
+				Expect(server.EULAAccepted(release.ID)).To(BeTrue())
+
+				link, err := fetcher.NewDownloadLink()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(link).To(HavePrefix(server.URL() + "/blobs/"))
+			})
+		})
+	})
+
+	Describe("user groups", func() {
+		It("manages membership", func() {
+			userGroup, err := client.UserGroups.Create("some-group", "some description", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			userGroup, err = client.UserGroups.AddMemberToGroup(userGroup.ID, "someone@example.com", true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(userGroup.Members).To(ConsistOf("someone@example.com"))
+			Expect(userGroup.Admins).To(ConsistOf("someone@example.com"))
+
+			Expect(client.UserGroups.AddToRelease(product.Slug, release.ID, userGroup.ID)).To(Succeed())
+
+			userGroups, err := client.UserGroups.ListForRelease(product.Slug, release.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(userGroups).To(HaveLen(1))
+		})
+	})
+
+	Describe("dependencies and upgrade paths", func() {
+		It("links releases together", func() {
+			other := server.AddProduct(pivnet.Product{Slug: "other-product"})
+			dependency := server.AddRelease(other.Slug, pivnet.Release{Version: "3.0.0"})
+			previous := server.AddRelease(product.Slug, pivnet.Release{Version: "0.9.0"})
+
+			Expect(client.ReleaseDependencies.Add(product.Slug, release.ID, dependency.ID)).To(Succeed())
+			dependencies, err := client.ReleaseDependencies.List(product.Slug, release.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dependencies).To(HaveLen(1))
+			Expect(dependencies[0].Release.Product.Slug).To(Equal(other.Slug))
+
+			_, err = client.DependencySpecifiers.Create(product.Slug, release.ID, other.Slug, "3.*")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.ReleaseUpgradePaths.Add(product.Slug, release.ID, previous.ID)).To(Succeed())
+			upgradePaths, err := client.ReleaseUpgradePaths.Get(product.Slug, release.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(upgradePaths).To(Equal([]pivnet.ReleaseUpgradePath{
+				{Release: pivnet.UpgradePathRelease{ID: previous.ID, Version: "0.9.0"}},
+			}))
+		})
+	})
+
+	Describe("artifact references", func() {
+		It("lists artifact references by digest", func() {
+			server.AddArtifactReference(product.Slug, pivnet.ArtifactReference{ArtifactPath: "repo/a", Digest: "sha256:a"})
+			b := server.AddArtifactReference(product.Slug, pivnet.ArtifactReference{ArtifactPath: "repo/b", Digest: "sha256:b"})
+
+			refs, err := client.ArtifactReferences.ListForDigest(product.Slug, "sha256:b")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(refs).To(Equal([]pivnet.ArtifactReference{b}))
+		})
+	})
+
+	Describe("fault injection", func() {
+		It("returns the injected error the given number of times", func() {
+			server.InjectFault(pivnettest.Fault{
+				Method:     "GET",
+				Path:       "/products/*",
+				StatusCode: http.StatusForbidden,
+				Message:    "forbidden",
+				Times:      1,
+			})
+
+			_, err := client.Products.Get(product.Slug)
+			Expect(err).To(BeAssignableToTypeOf(pivnet.ErrPivnetOther{}))
+			Expect(err.(pivnet.ErrPivnetOther).ResponseCode).To(Equal(http.StatusForbidden))
+
+			_, err = client.Products.Get(product.Slug)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("uses the internal server error body shape for 500s", func() {
+			server.InjectFault(pivnettest.Fault{Path: "/products", StatusCode: http.StatusInternalServerError, Message: "boom"})
+
+			_, err := client.Products.List()
+			Expect(err).To(Equal(pivnet.ErrPivnetOther{ResponseCode: http.StatusInternalServerError, Message: "boom"}))
+		})
+
+		It("maps 401 and 451 to typed errors", func() {
+			server.InjectFault(pivnettest.Fault{Path: "/products", StatusCode: http.StatusUnavailableForLegalReasons, Message: "legal"})
+			_, err := client.Products.List()
+			Expect(err).To(Equal(pivnet.ErrUnavailableForLegalReasons{ResponseCode: http.StatusUnavailableForLegalReasons, Message: "legal"}))
+
+			server.ClearFaults()
+			server.InjectFault(pivnettest.Fault{Path: "/products/*", StatusCode: http.StatusUnauthorized, Message: "nope"})
+			_, err = client.Products.Get(product.Slug)
+			Expect(err).To(BeAssignableToTypeOf(pivnet.ErrUnauthorized{}))
+		})
+
+		It("sends the injected headers so the client can honour Retry-After", func() {
+			token := pivnet.NewAccessTokenOrLegacyToken(refreshToken, server.URL(), false)
+			client = pivnet.NewClient(token, pivnet.ClientConfig{
+				Host: server.URL(),
+				RetryPolicy: pivnet.RetryPolicy{
+					MaxAttempts: 2,
+					BaseBackoff: time.Millisecond,
+					MaxBackoff:  time.Second,
+				},
+			}, &loggerfakes.FakeLogger{})
+
+			server.InjectFault(pivnettest.Fault{
+				Path:       "/products",
+				StatusCode: http.StatusTooManyRequests,
+				Header:     http.Header{"Retry-After": []string{"0"}},
+				Times:      1,
+			})
+
+			products, err := client.Products.List()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(products).To(HaveLen(1))
+		})
+	})
+
+	Describe("S3 stand-in", func() {
+		It("hands out federation tokens for it and serves the files created from its objects", func() {
+			token, err := client.FederationToken.GenerateFederationToken(product.Slug)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(token.Bucket).To(Equal(pivnettest.S3Bucket))
+			Expect(token.SessionToken).To(Equal(pivnettest.S3SessionToken))
+
+			_, err = client.FederationToken.GenerateFederationToken("missing-product")
+			Expect(err).To(MatchError(ContainSubstring("product missing-product not found")))
+
+			dir, err := ioutil.TempDir("", "")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.RemoveAll(dir)
+
+			file := filepath.Join(dir, "some-file.tgz")
+			Expect(ioutil.WriteFile(file, []byte("some contents"), 0600)).To(Succeed())
+
+			client = pivnet.NewClient(pivnet.NewAccessTokenOrLegacyToken(refreshToken, server.URL(), false), pivnet.ClientConfig{
+				Host:       server.URL(),
+				S3Endpoint: server.S3Endpoint(),
+			}, &loggerfakes.FakeLogger{})
+
+			pf, err := client.ProductFiles.Upload(pivnet.UploadProductFileConfig{
+				CreateProductFileConfig: pivnet.CreateProductFileConfig{
+					ProductSlug:  product.Slug,
+					AWSObjectKey: "product-files/some-product/some-file.tgz",
+				},
+				FilePath:  file,
+				ReleaseID: release.ID,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pf.Size).To(Equal(len("some contents")))
+
+			downloaded, err := ioutil.TempFile(dir, "")
+			Expect(err).NotTo(HaveOccurred())
+			location, err := download.NewFileInfo(downloaded)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = client.ProductFiles.DownloadForRelease(location, product.Slug, release.ID, pf.ID, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ioutil.ReadFile(downloaded.Name())).To(Equal([]byte("some contents")))
+		})
+
+		It("rejects requests whose signature does not match", func() {
+			req, err := http.NewRequest("GET", server.S3Endpoint()+"/"+pivnettest.S3Bucket+"?uploads", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			req.Header.Set("X-Amz-Security-Token", pivnettest.S3SessionToken)
+			req.Header.Set("X-Amz-Date", "20130524T000000Z")
+			req.Header.Set("X-Amz-Content-Sha256", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855")
+			req.Header.Set("Authorization", fmt.Sprintf(
+				"AWS4-HMAC-SHA256 Credential=%s/20130524/%s/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=%064d",
+				pivnettest.S3AccessKeyID, pivnettest.S3Region, 0,
+			))
+
+			resp, err := http.DefaultClient.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+
+			body, err := ioutil.ReadAll(resp.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+			Expect(string(body)).To(ContainSubstring("SignatureDoesNotMatch"))
+		})
+	})
+})
@@ -0,0 +1,134 @@
+package pivnettest
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const sigV4Algorithm = "AWS4-HMAC-SHA256"
+
+// verifySignature recomputes the Signature Version 4 signature of an S3
+// request from what was received, as S3 does, and checks that it matches the
+// one in its Authorization header.
+func verifySignature(r *http.Request, body []byte) error {
+	authorization := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, sigV4Algorithm+" ") {
+		return errors.New("the request is not signed with " + sigV4Algorithm)
+	}
+
+	fields := map[string]string{}
+	for _, field := range strings.Split(strings.TrimPrefix(authorization, sigV4Algorithm+" "), ",") {
+		kv := strings.SplitN(strings.TrimSpace(field), "=", 2)
+		if len(kv) == 2 {
+			fields[kv[0]] = kv[1]
+		}
+	}
+
+	credential := strings.Split(fields["Credential"], "/")
+	if len(credential) != 5 || credential[4] != "aws4_request" || credential[3] != "s3" {
+		return fmt.Errorf("malformed credential %q", fields["Credential"])
+	}
+	if credential[0] != S3AccessKeyID {
+		return fmt.Errorf("unknown access key %q", credential[0])
+	}
+	if credential[2] != S3Region {
+		return fmt.Errorf("the credential is scoped to %s, not %s", credential[2], S3Region)
+	}
+
+	amzDate := r.Header.Get("X-Amz-Date")
+	if _, err := time.Parse("20060102T150405Z", amzDate); err != nil || !strings.HasPrefix(amzDate, credential[1]) {
+		return fmt.Errorf("invalid X-Amz-Date %q", amzDate)
+	}
+
+	payloadHash := r.Header.Get("X-Amz-Content-Sha256")
+	sum := sha256.Sum256(body)
+	if payloadHash != hex.EncodeToString(sum[:]) {
+		return errors.New("the X-Amz-Content-Sha256 does not match the body")
+	}
+
+	signedHeaders := strings.Split(fields["SignedHeaders"], ";")
+	if !sort.StringsAreSorted(signedHeaders) || !contains(signedHeaders, "host") || !contains(signedHeaders, "x-amz-date") || !contains(signedHeaders, "x-amz-content-sha256") {
+		return fmt.Errorf("invalid signed headers %q", fields["SignedHeaders"])
+	}
+
+	var headers strings.Builder
+	for _, name := range signedHeaders {
+		value := r.Header.Get(name)
+		if name == "host" {
+			value = r.Host
+		}
+		fmt.Fprintf(&headers, "%s:%s\n", name, strings.Join(strings.Fields(value), " "))
+	}
+
+	var query []string
+	for key, values := range r.URL.Query() {
+		for _, value := range values {
+			query = append(query, uriEncode(key, true)+"="+uriEncode(value, true))
+		}
+	}
+	sort.Strings(query)
+
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		uriEncode(r.URL.Path, false),
+		strings.Join(query, "&"),
+		headers.String(),
+		fields["SignedHeaders"],
+		payloadHash,
+	}, "\n")
+
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	scope := strings.Join(credential[1:], "/")
+	stringToSign := strings.Join([]string{sigV4Algorithm, amzDate, scope, hex.EncodeToString(canonicalHash[:])}, "\n")
+
+	key := []byte("AWS4" + S3SecretAccessKey)
+	for _, part := range credential[1:] {
+		key = hmacSum(key, part)
+	}
+	expected := hex.EncodeToString(hmacSum(key, stringToSign))
+
+	if !hmac.Equal([]byte(expected), []byte(fields["Signature"])) {
+		return errors.New("the request signature we calculated does not match the signature you provided")
+	}
+	return nil
+}
+
+// uriEncode is the UriEncode function of the Signature Version 4 docs.
+func uriEncode(s string, encodeSlash bool) string {
+	const hexDigits = "0123456789ABCDEF"
+
+	var b strings.Builder
+	for _, c := range []byte(s) {
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !encodeSlash) {
+			b.WriteByte(c)
+			continue
+		}
+		b.WriteByte('%')
+		b.WriteByte(hexDigits[c>>4])
+		b.WriteByte(hexDigits[c&15])
+	}
+	return b.String()
+}
+
+func hmacSum(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
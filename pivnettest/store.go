@@ -0,0 +1,300 @@
+package pivnettest
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/pivotal-cf/go-pivnet/v9"
+)
+
+type store struct {
+	nextID       int
+	accessTokens int
+
+	products              []*pivnet.Product
+	releases              map[string][]*pivnet.Release
+	productFiles          map[string][]*pivnet.ProductFile
+	blobs                 map[int][]byte
+	fileGroups            map[string][]*pivnet.FileGroup
+	userGroups            []*pivnet.UserGroup
+	eulas                 []*pivnet.EULA
+	artifactReferences    map[string][]*pivnet.ArtifactReference
+	dependencySpecifiers  map[int][]*pivnet.DependencySpecifier
+	upgradePathSpecifiers map[int][]*pivnet.UpgradePathSpecifier
+	eulaAcceptances       map[int]bool
+
+	// The S3 stand-in's objects by key, and unfinished multipart uploads by
+	// upload ID.
+	s3Objects   map[string][]byte
+	s3Uploads   map[string]*s3Upload
+	s3UploadIDs int
+
+	// Associations keyed by release or file group ID.
+	fileGroupFiles            map[int][]int
+	releaseProductFiles       map[int][]int
+	releaseFileGroups         map[int][]int
+	releaseUserGroups         map[int][]int
+	releaseArtifactReferences map[int][]int
+	dependencies              map[int][]int
+	upgradePaths              map[int][]int
+
+	releaseTypes []pivnet.ReleaseType
+	versions     pivnet.PivnetVersions
+}
+
+func newStore() *store {
+	return &store{
+		releases:                  map[string][]*pivnet.Release{},
+		productFiles:              map[string][]*pivnet.ProductFile{},
+		blobs:                     map[int][]byte{},
+		fileGroups:                map[string][]*pivnet.FileGroup{},
+		artifactReferences:        map[string][]*pivnet.ArtifactReference{},
+		dependencySpecifiers:      map[int][]*pivnet.DependencySpecifier{},
+		upgradePathSpecifiers:     map[int][]*pivnet.UpgradePathSpecifier{},
+		eulaAcceptances:           map[int]bool{},
+		s3Objects:                 map[string][]byte{},
+		s3Uploads:                 map[string]*s3Upload{},
+		fileGroupFiles:            map[int][]int{},
+		releaseProductFiles:       map[int][]int{},
+		releaseFileGroups:         map[int][]int{},
+		releaseUserGroups:         map[int][]int{},
+		releaseArtifactReferences: map[int][]int{},
+		dependencies:              map[int][]int{},
+		upgradePaths:              map[int][]int{},
+		releaseTypes: []pivnet.ReleaseType{
+			"All-In-One",
+			"Major Release",
+			"Minor Release",
+			"Service Release",
+			"Maintenance Release",
+			"Security Release",
+			"Alpha Release",
+			"Beta Release",
+			"Edge Release",
+			"Developer Release",
+		},
+	}
+}
+
+func (st *store) id(id int) int {
+	if id != 0 {
+		if id > st.nextID {
+			st.nextID = id
+		}
+		return id
+	}
+
+	st.nextID++
+	return st.nextID
+}
+
+func (st *store) product(slug string) *pivnet.Product {
+	for _, p := range st.products {
+		if p.Slug == slug {
+			return p
+		}
+	}
+	return nil
+}
+
+func (st *store) release(slug string, id int) *pivnet.Release {
+	for _, r := range st.releases[slug] {
+		if r.ID == id {
+			return r
+		}
+	}
+	return nil
+}
+
+// findRelease looks a release up across all products.
+func (st *store) findRelease(id int) (*pivnet.Product, *pivnet.Release) {
+	for _, p := range st.products {
+		if r := st.release(p.Slug, id); r != nil {
+			return p, r
+		}
+	}
+	return nil, nil
+}
+
+func (st *store) productFile(slug string, id int) *pivnet.ProductFile {
+	for _, pf := range st.productFiles[slug] {
+		if pf.ID == id {
+			return pf
+		}
+	}
+	return nil
+}
+
+func (st *store) fileGroup(slug string, id int) *pivnet.FileGroup {
+	for _, fg := range st.fileGroups[slug] {
+		if fg.ID == id {
+			return fg
+		}
+	}
+	return nil
+}
+
+func (st *store) userGroup(id int) *pivnet.UserGroup {
+	for _, ug := range st.userGroups {
+		if ug.ID == id {
+			return ug
+		}
+	}
+	return nil
+}
+
+func (st *store) eula(slug string) *pivnet.EULA {
+	for _, e := range st.eulas {
+		if e.Slug == slug {
+			return e
+		}
+	}
+	return nil
+}
+
+func (st *store) artifactReference(slug string, id int) *pivnet.ArtifactReference {
+	for _, ar := range st.artifactReferences[slug] {
+		if ar.ID == id {
+			return ar
+		}
+	}
+	return nil
+}
+
+func (st *store) addProduct(p pivnet.Product) pivnet.Product {
+	p.ID = st.id(p.ID)
+	st.products = append(st.products, &p)
+	return p
+}
+
+func (st *store) addRelease(slug string, r pivnet.Release) pivnet.Release {
+	r.ID = st.id(r.ID)
+	st.releases[slug] = append(st.releases[slug], &r)
+	return r
+}
+
+func (st *store) addProductFile(slug string, pf pivnet.ProductFile, content []byte) pivnet.ProductFile {
+	pf.ID = st.id(pf.ID)
+
+	if content != nil {
+		if pf.SHA256 == "" {
+			sum := sha256.Sum256(content)
+			pf.SHA256 = hex.EncodeToString(sum[:])
+		}
+		if pf.MD5 == "" {
+			sum := md5.Sum(content)
+			pf.MD5 = hex.EncodeToString(sum[:])
+		}
+		if pf.Size == 0 {
+			pf.Size = len(content)
+		}
+		st.blobs[pf.ID] = content
+	}
+
+	st.productFiles[slug] = append(st.productFiles[slug], &pf)
+	return pf
+}
+
+func (st *store) addFileGroup(slug string, fg pivnet.FileGroup) pivnet.FileGroup {
+	fg.ID = st.id(fg.ID)
+	if p := st.product(slug); p != nil {
+		fg.Product = pivnet.FileGroupProduct{ID: p.ID, Name: p.Name}
+	}
+
+	for _, pf := range fg.ProductFiles {
+		addID(st.fileGroupFiles, fg.ID, pf.ID)
+	}
+	fg.ProductFiles = nil
+
+	st.fileGroups[slug] = append(st.fileGroups[slug], &fg)
+	return st.fileGroupView(slug, &fg)
+}
+
+func (st *store) addUserGroup(ug pivnet.UserGroup) pivnet.UserGroup {
+	ug.ID = st.id(ug.ID)
+	st.userGroups = append(st.userGroups, &ug)
+	return ug
+}
+
+func (st *store) addEULA(e pivnet.EULA) pivnet.EULA {
+	e.ID = st.id(e.ID)
+	st.eulas = append(st.eulas, &e)
+	return e
+}
+
+func (st *store) addArtifactReference(slug string, ar pivnet.ArtifactReference) pivnet.ArtifactReference {
+	ar.ID = st.id(ar.ID)
+	if ar.ReplicationStatus == "" {
+		ar.ReplicationStatus = pivnet.Complete
+	}
+	st.artifactReferences[slug] = append(st.artifactReferences[slug], &ar)
+	return ar
+}
+
+func (st *store) fileGroupView(slug string, fg *pivnet.FileGroup) pivnet.FileGroup {
+	view := *fg
+	view.ProductFiles = nil
+	for _, id := range st.fileGroupFiles[fg.ID] {
+		if pf := st.productFile(slug, id); pf != nil {
+			view.ProductFiles = append(view.ProductFiles, *pf)
+		}
+	}
+	return view
+}
+
+func (st *store) deleteRelease(slug string, id int) {
+	releases := st.releases[slug]
+	for i, r := range releases {
+		if r.ID == id {
+			st.releases[slug] = append(releases[:i], releases[i+1:]...)
+			break
+		}
+	}
+
+	delete(st.releaseProductFiles, id)
+	delete(st.releaseFileGroups, id)
+	delete(st.releaseUserGroups, id)
+	delete(st.releaseArtifactReferences, id)
+	delete(st.dependencies, id)
+	delete(st.upgradePaths, id)
+	delete(st.dependencySpecifiers, id)
+	delete(st.upgradePathSpecifiers, id)
+	delete(st.eulaAcceptances, id)
+}
+
+func addID(m map[int][]int, key int, id int) {
+	if containsID(m[key], id) {
+		return
+	}
+	m[key] = append(m[key], id)
+}
+
+func removeID(m map[int][]int, key int, id int) {
+	ids := m[key]
+	for i, existing := range ids {
+		if existing == id {
+			m[key] = append(ids[:i], ids[i+1:]...)
+			return
+		}
+	}
+}
+
+func containsID(ids []int, id int) bool {
+	for _, existing := range ids {
+		if existing == id {
+			return true
+		}
+	}
+	return false
+}
+
+func removeString(values []string, value string) []string {
+	var kept []string
+	for _, v := range values {
+		if v != value {
+			kept = append(kept, v)
+		}
+	}
+	return kept
+}
@@ -1,31 +1,43 @@
 package pivnet
 
 import (
+	"context"
 	"net/http"
 )
 
 type ProductFileLinkFetcher struct {
 	downloadLink string
 	client       Client
+	ctx          context.Context
 }
 
 func NewProductFileLinkFetcher(downloadLink string, client Client) ProductFileLinkFetcher {
-	return ProductFileLinkFetcher{downloadLink: downloadLink, client: client}
+	return NewProductFileLinkFetcherContext(context.Background(), downloadLink, client)
+}
+
+// NewProductFileLinkFetcherContext is like NewProductFileLinkFetcher but the
+// download link requests it makes are bound to ctx.
+func NewProductFileLinkFetcherContext(ctx context.Context, downloadLink string, client Client) ProductFileLinkFetcher {
+	return ProductFileLinkFetcher{downloadLink: downloadLink, client: client, ctx: ctx}
 }
 
 func (p ProductFileLinkFetcher) NewDownloadLink() (string, error) {
-	p.client.HTTP.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+	// a copy of the http.Client, so that links can be fetched for several
+	// downloads at once without following each other's redirects
+	httpClient := *p.client.HTTP
+	httpClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
 		return http.ErrUseLastResponse
 	}
 
-	resp, err := p.client.MakeRequest("POST", p.downloadLink, http.StatusFound, nil)
+	client := p.client
+	client.HTTP = &httpClient
+
+	resp, err := client.MakeRequestContext(p.ctx, "POST", p.downloadLink, http.StatusFound, nil)
 	if err != nil {
 		return "", err
 	}
 
 	defer resp.Body.Close()
 
-	p.client.HTTP.CheckRedirect = nil
-
 	return resp.Header.Get("Location"), nil
 }
@@ -0,0 +1,107 @@
+package pivnet
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pivotal-cf/go-pivnet/v9/logger"
+	"github.com/pivotal-cf/go-pivnet/v9/md5sum"
+	"github.com/pivotal-cf/go-pivnet/v9/sha256sum"
+	"github.com/pivotal-cf/go-pivnet/v9/upload"
+)
+
+// UploadProductFileConfig is a local file to upload and the product file to
+// create for it. AWSObjectKey, SHA256 and MD5 are filled in when empty.
+type UploadProductFileConfig struct {
+	CreateProductFileConfig
+
+	FilePath string
+
+	// Release to add the product file to (optional)
+	ReleaseID int
+}
+
+// Upload uploads the file to the product's S3 directory with the
+// credentials of a federation token, creates the product file and, when
+// config.ReleaseID is set, adds it to that release. The upload is multipart
+// and resumable: uploading the same file to the same key after a failure
+// only sends the parts that are missing.
+func (p ProductFilesService) Upload(config UploadProductFileConfig) (ProductFile, error) {
+	return p.UploadContext(context.Background(), config)
+}
+
+func (p ProductFilesService) UploadContext(ctx context.Context, config UploadProductFileConfig) (ProductFile, error) {
+	if config.FilePath == "" {
+		return ProductFile{}, fmt.Errorf("file path must not be empty")
+	}
+
+	createConfig := config.CreateProductFileConfig
+
+	if createConfig.AWSObjectKey == "" {
+		product, err := ProductsService{client: p.client, l: p.client.logger}.GetContext(ctx, createConfig.ProductSlug)
+		if err != nil {
+			return ProductFile{}, err
+		}
+
+		if product.S3Directory == nil || product.S3Directory.Path == "" {
+			return ProductFile{}, fmt.Errorf("product %s has no S3 directory", createConfig.ProductSlug)
+		}
+
+		objectPath := path.Join(product.S3Directory.Path, filepath.Base(config.FilePath))
+		createConfig.AWSObjectKey = strings.TrimPrefix(objectPath, "/")
+	}
+
+	var err error
+	if createConfig.SHA256 == "" {
+		createConfig.SHA256, err = sha256sum.NewFileSummer().SumFile(config.FilePath)
+		if err != nil {
+			return ProductFile{}, fmt.Errorf("failed to compute SHA256 of %s: %w", config.FilePath, err)
+		}
+	}
+
+	if createConfig.MD5 == "" {
+		createConfig.MD5, err = md5sum.NewFileSummer().SumFile(config.FilePath)
+		if err != nil {
+			return ProductFile{}, fmt.Errorf("failed to compute MD5 of %s: %w", config.FilePath, err)
+		}
+	}
+
+	token, err := FederationTokenService{client: p.client}.GenerateFederationTokenContext(ctx, createConfig.ProductSlug)
+	if err != nil {
+		return ProductFile{}, err
+	}
+
+	target := upload.Target{
+		Bucket: token.Bucket,
+		Region: token.Region,
+		Credentials: upload.Credentials{
+			AccessKeyID:     token.AccessKeyID,
+			SecretAccessKey: token.SecretAccessKey,
+			SessionToken:    token.SessionToken,
+		},
+	}
+
+	p.client.logger.Debug("Uploading file", logger.Data{"path": config.FilePath, "awsObjectKey": createConfig.AWSObjectKey})
+
+	err = p.client.uploader.Upload(ctx, target, config.FilePath, createConfig.AWSObjectKey)
+	if err != nil {
+		return ProductFile{}, fmt.Errorf("failed to upload %s: %w", config.FilePath, err)
+	}
+
+	productFile, err := p.CreateContext(ctx, createConfig)
+	if err != nil {
+		return ProductFile{}, err
+	}
+
+	if config.ReleaseID != 0 {
+		err = p.AddToReleaseContext(ctx, createConfig.ProductSlug, config.ReleaseID, productFile.ID)
+		if err != nil {
+			return productFile, fmt.Errorf("failed to add product file %d to release %d: %w", productFile.ID, config.ReleaseID, err)
+		}
+	}
+
+	return productFile, nil
+}
@@ -0,0 +1,128 @@
+package pivnet_test
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pivotal-cf/go-pivnet/v9"
+	"github.com/pivotal-cf/go-pivnet/v9/logger/loggerfakes"
+	"github.com/pivotal-cf/go-pivnet/v9/pivnettest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PivnetClient - product file upload", func() {
+	var (
+		server  *pivnettest.Server
+		client  pivnet.Client
+		product pivnet.Product
+		release pivnet.Release
+
+		dir     string
+		file    string
+		content []byte
+	)
+
+	BeforeEach(func() {
+		server = pivnettest.NewServer()
+
+		product = server.AddProduct(pivnet.Product{
+			Slug:        "some-product",
+			S3Directory: &pivnet.S3Directory{Path: "/product-files/some-product"},
+		})
+		release = server.AddRelease(product.Slug, pivnet.Release{Version: "1.0.0"})
+
+		token := pivnet.NewAccessTokenOrLegacyToken("some-refresh-token-longer-than-a-legacy-token", server.URL(), false)
+		client = pivnet.NewClient(token, pivnet.ClientConfig{
+			Host:       server.URL(),
+			S3Endpoint: server.S3Endpoint(),
+		}, &loggerfakes.FakeLogger{})
+
+		var err error
+		dir, err = ioutil.TempDir("", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		content = []byte("some tile contents")
+		file = filepath.Join(dir, "some-tile.pivotal")
+		Expect(ioutil.WriteFile(file, content, 0600)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(dir)
+	})
+
+	It("uploads the file to the product's S3 directory and creates the product file", func() {
+		productFile, err := client.ProductFiles.Upload(pivnet.UploadProductFileConfig{
+			CreateProductFileConfig: pivnet.CreateProductFileConfig{
+				ProductSlug: product.Slug,
+				Name:        "Some Tile",
+				FileType:    pivnet.FileTypeSoftware,
+				FileVersion: "1.0.0",
+			},
+			FilePath: file,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(productFile.AWSObjectKey).To(Equal("product-files/some-product/some-tile.pivotal"))
+		Expect(productFile.Name).To(Equal("Some Tile"))
+		Expect(productFile.SHA256).To(Equal(fmt.Sprintf("%x", sha256.Sum256(content))))
+		Expect(productFile.MD5).To(Equal(fmt.Sprintf("%x", md5.Sum(content))))
+
+		uploaded, ok := server.S3Object(productFile.AWSObjectKey)
+		Expect(ok).To(BeTrue())
+		Expect(uploaded).To(Equal(content))
+
+		releaseFiles, err := client.ProductFiles.ListForRelease(product.Slug, release.ID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(releaseFiles).To(BeEmpty())
+	})
+
+	It("adds the product file to the release", func() {
+		productFile, err := client.ProductFiles.Upload(pivnet.UploadProductFileConfig{
+			CreateProductFileConfig: pivnet.CreateProductFileConfig{
+				ProductSlug:  product.Slug,
+				Name:         "Some Tile",
+				AWSObjectKey: "product-files/some-product/renamed.pivotal",
+			},
+			FilePath:  file,
+			ReleaseID: release.ID,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(productFile.AWSObjectKey).To(Equal("product-files/some-product/renamed.pivotal"))
+
+		releaseFiles, err := client.ProductFiles.ListForRelease(product.Slug, release.ID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(releaseFiles).To(HaveLen(1))
+		Expect(releaseFiles[0].ID).To(Equal(productFile.ID))
+	})
+
+	It("returns an error when the product has no S3 directory", func() {
+		server.AddProduct(pivnet.Product{Slug: "other-product"})
+
+		_, err := client.ProductFiles.Upload(pivnet.UploadProductFileConfig{
+			CreateProductFileConfig: pivnet.CreateProductFileConfig{ProductSlug: "other-product"},
+			FilePath:                file,
+		})
+		Expect(err).To(MatchError("product other-product has no S3 directory"))
+	})
+
+	It("does not create the product file when the upload fails", func() {
+		server.InjectFault(pivnettest.Fault{Method: "PUT", Path: "/s3/*/*/*/*", StatusCode: 500})
+
+		_, err := client.ProductFiles.Upload(pivnet.UploadProductFileConfig{
+			CreateProductFileConfig: pivnet.CreateProductFileConfig{ProductSlug: product.Slug},
+			FilePath:                file,
+		})
+		Expect(err).To(MatchError(ContainSubstring("failed to upload " + file)))
+
+		productFiles, err := client.ProductFiles.List(product.Slug)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(productFiles).To(BeEmpty())
+	})
+})
@@ -2,6 +2,7 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -15,6 +16,44 @@ type ProductFilesService struct {
 	client Client
 }
 
+//go:generate counterfeiter . ProductFilesAPI
+type ProductFilesAPI interface {
+	List(productSlug string) ([]ProductFile, error)
+	ListContext(ctx context.Context, productSlug string) ([]ProductFile, error)
+	Iterate(productSlug string, opts ListOptions) *ProductFileIterator
+	IterateContext(ctx context.Context, productSlug string, opts ListOptions) *ProductFileIterator
+	ListAll(productSlug string, opts ListOptions) ([]ProductFile, error)
+	ListAllContext(ctx context.Context, productSlug string, opts ListOptions) ([]ProductFile, error)
+	ListForRelease(productSlug string, releaseID int) ([]ProductFile, error)
+	ListForReleaseContext(ctx context.Context, productSlug string, releaseID int) ([]ProductFile, error)
+	Get(productSlug string, productFileID int) (ProductFile, error)
+	GetContext(ctx context.Context, productSlug string, productFileID int) (ProductFile, error)
+	GetForRelease(productSlug string, releaseID int, productFileID int) (ProductFile, error)
+	GetForReleaseContext(ctx context.Context, productSlug string, releaseID int, productFileID int) (ProductFile, error)
+	Create(config CreateProductFileConfig) (ProductFile, error)
+	CreateContext(ctx context.Context, config CreateProductFileConfig) (ProductFile, error)
+	Update(productSlug string, productFile ProductFile) (ProductFile, error)
+	UpdateContext(ctx context.Context, productSlug string, productFile ProductFile) (ProductFile, error)
+	Delete(productSlug string, id int) (ProductFile, error)
+	DeleteContext(ctx context.Context, productSlug string, id int) (ProductFile, error)
+	AddToRelease(productSlug string, releaseID int, productFileID int) error
+	AddToReleaseContext(ctx context.Context, productSlug string, releaseID int, productFileID int) error
+	RemoveFromRelease(productSlug string, releaseID int, productFileID int) error
+	RemoveFromReleaseContext(ctx context.Context, productSlug string, releaseID int, productFileID int) error
+	AddToFileGroup(productSlug string, fileGroupID int, productFileID int) error
+	AddToFileGroupContext(ctx context.Context, productSlug string, fileGroupID int, productFileID int) error
+	RemoveFromFileGroup(productSlug string, fileGroupID int, productFileID int) error
+	RemoveFromFileGroupContext(ctx context.Context, productSlug string, fileGroupID int, productFileID int) error
+	DownloadForRelease(location *download.FileInfo, productSlug string, releaseID int, productFileID int, progressWriter io.Writer) error
+	DownloadForReleaseContext(ctx context.Context, location *download.FileInfo, productSlug string, releaseID int, productFileID int, progressWriter io.Writer) error
+	DownloadToWriter(writer io.Writer, productSlug string, releaseID int, productFileID int, progressWriter io.Writer) error
+	DownloadToWriterContext(ctx context.Context, writer io.Writer, productSlug string, releaseID int, productFileID int, progressWriter io.Writer) error
+	DownloadRelease(dir string, productSlug string, releaseID int, config DownloadReleaseConfig, progressWriter io.Writer) (DownloadReport, error)
+	DownloadReleaseContext(ctx context.Context, dir string, productSlug string, releaseID int, config DownloadReleaseConfig, progressWriter io.Writer) (DownloadReport, error)
+	Upload(config UploadProductFileConfig) (ProductFile, error)
+	UploadContext(ctx context.Context, config UploadProductFileConfig) (ProductFile, error)
+}
+
 type CreateProductFileConfig struct {
 	ProductSlug        string
 	AWSObjectKey       string
@@ -35,6 +74,16 @@ type ProductFilesResponse struct {
 	ProductFiles []ProductFile `json:"product_files,omitempty"`
 }
 
+// productFilesPage is a single page of a paginated product file listing.
+type productFilesPage struct {
+	ProductFilesResponse
+	Links *Links `json:"_links,omitempty"`
+}
+
+func (r *productFilesPage) nextPage() string {
+	return r.Links.NextPage()
+}
+
 type ProductFileResponse struct {
 	ProductFile ProductFile `json:"product_file,omitempty"`
 }
@@ -75,10 +124,15 @@ const (
 )
 
 func (p ProductFilesService) List(productSlug string) ([]ProductFile, error) {
+	return p.ListContext(context.Background(), productSlug)
+}
+
+func (p ProductFilesService) ListContext(ctx context.Context, productSlug string) ([]ProductFile, error) {
 	url := fmt.Sprintf("/products/%s/product_files", productSlug)
 
 	var response ProductFilesResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -97,7 +151,95 @@ func (p ProductFilesService) List(productSlug string) ([]ProductFile, error) {
 	return response.ProductFiles, nil
 }
 
+// ProductFileIterator walks the product files of a product one page at a
+// time. Pages are only requested as the iteration reaches them.
+type ProductFileIterator struct {
+	pager        *pager
+	err          error
+	productFiles []ProductFile
+	current      ProductFile
+}
+
+// Next advances the iterator to the next product file, fetching the next
+// page if needed. It returns false when there are no more product files or a
+// request fails.
+func (it *ProductFileIterator) Next() bool {
+	for len(it.productFiles) == 0 {
+		if it.pager == nil {
+			return false
+		}
+
+		var response productFilesPage
+		if !it.pager.next(&response) {
+			return false
+		}
+		it.productFiles = response.ProductFiles
+	}
+
+	it.current = it.productFiles[0]
+	it.productFiles = it.productFiles[1:]
+
+	return true
+}
+
+// ProductFile returns the product file the iterator is positioned at.
+func (it *ProductFileIterator) ProductFile() ProductFile {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ProductFileIterator) Err() error {
+	if it.pager == nil {
+		return it.err
+	}
+	return it.pager.err
+}
+
+// NewProductFileIterator returns an iterator over the given product files
+// that then stops with err, e.g. to return from a fake ProductFilesAPI. The
+// zero ProductFileIterator is empty.
+func NewProductFileIterator(productFiles []ProductFile, err error) *ProductFileIterator {
+	return &ProductFileIterator{productFiles: productFiles, err: err}
+}
+
+func (p ProductFilesService) Iterate(productSlug string, opts ListOptions) *ProductFileIterator {
+	return p.IterateContext(context.Background(), productSlug, opts)
+}
+
+func (p ProductFilesService) IterateContext(ctx context.Context, productSlug string, opts ListOptions) *ProductFileIterator {
+	url := fmt.Sprintf("/products/%s/product_files", productSlug)
+
+	return &ProductFileIterator{
+		pager: newPager(ctx, p.client, url, opts),
+	}
+}
+
+// ListAll returns the product files from every page, following the
+// pagination links returned by Pivnet.
+func (p ProductFilesService) ListAll(productSlug string, opts ListOptions) ([]ProductFile, error) {
+	return p.ListAllContext(context.Background(), productSlug, opts)
+}
+
+func (p ProductFilesService) ListAllContext(ctx context.Context, productSlug string, opts ListOptions) ([]ProductFile, error) {
+	var productFiles []ProductFile
+
+	it := p.IterateContext(ctx, productSlug, opts)
+	for it.Next() {
+		productFiles = append(productFiles, it.ProductFile())
+	}
+
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return productFiles, nil
+}
+
 func (p ProductFilesService) ListForRelease(productSlug string, releaseID int) ([]ProductFile, error) {
+	return p.ListForReleaseContext(context.Background(), productSlug, releaseID)
+}
+
+func (p ProductFilesService) ListForReleaseContext(ctx context.Context, productSlug string, releaseID int) ([]ProductFile, error) {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/product_files",
 		productSlug,
@@ -105,7 +247,8 @@ func (p ProductFilesService) ListForRelease(productSlug string, releaseID int) (
 	)
 
 	var response ProductFilesResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -125,6 +268,10 @@ func (p ProductFilesService) ListForRelease(productSlug string, releaseID int) (
 }
 
 func (p ProductFilesService) Get(productSlug string, productFileID int) (ProductFile, error) {
+	return p.GetContext(context.Background(), productSlug, productFileID)
+}
+
+func (p ProductFilesService) GetContext(ctx context.Context, productSlug string, productFileID int) (ProductFile, error) {
 	url := fmt.Sprintf(
 		"/products/%s/product_files/%d",
 		productSlug,
@@ -132,7 +279,8 @@ func (p ProductFilesService) Get(productSlug string, productFileID int) (Product
 	)
 
 	var response ProductFileResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -152,6 +300,10 @@ func (p ProductFilesService) Get(productSlug string, productFileID int) (Product
 }
 
 func (p ProductFilesService) GetForRelease(productSlug string, releaseID int, productFileID int) (ProductFile, error) {
+	return p.GetForReleaseContext(context.Background(), productSlug, releaseID, productFileID)
+}
+
+func (p ProductFilesService) GetForReleaseContext(ctx context.Context, productSlug string, releaseID int, productFileID int) (ProductFile, error) {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/product_files/%d",
 		productSlug,
@@ -160,7 +312,8 @@ func (p ProductFilesService) GetForRelease(productSlug string, releaseID int, pr
 	)
 
 	var response ProductFileResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -180,6 +333,10 @@ func (p ProductFilesService) GetForRelease(productSlug string, releaseID int, pr
 }
 
 func (p ProductFilesService) Create(config CreateProductFileConfig) (ProductFile, error) {
+	return p.CreateContext(context.Background(), config)
+}
+
+func (p ProductFilesService) CreateContext(ctx context.Context, config CreateProductFileConfig) (ProductFile, error) {
 	if config.AWSObjectKey == "" {
 		return ProductFile{}, fmt.Errorf("AWS object key must not be empty")
 	}
@@ -211,7 +368,8 @@ func (p ProductFilesService) Create(config CreateProductFileConfig) (ProductFile
 	}
 
 	var response ProductFileResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"POST",
 		url,
 		http.StatusCreated,
@@ -236,6 +394,10 @@ func (p ProductFilesService) Create(config CreateProductFileConfig) (ProductFile
 }
 
 func (p ProductFilesService) Update(productSlug string, productFile ProductFile) (ProductFile, error) {
+	return p.UpdateContext(context.Background(), productSlug, productFile)
+}
+
+func (p ProductFilesService) UpdateContext(ctx context.Context, productSlug string, productFile ProductFile) (ProductFile, error) {
 	url := fmt.Sprintf("/products/%s/product_files/%d", productSlug, productFile.ID)
 
 	body := createUpdateProductFileBody{
@@ -258,7 +420,8 @@ func (p ProductFilesService) Update(productSlug string, productFile ProductFile)
 	}
 
 	var response ProductFileResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusOK,
@@ -282,6 +445,10 @@ type createUpdateProductFileBody struct {
 }
 
 func (p ProductFilesService) Delete(productSlug string, id int) (ProductFile, error) {
+	return p.DeleteContext(context.Background(), productSlug, id)
+}
+
+func (p ProductFilesService) DeleteContext(ctx context.Context, productSlug string, id int) (ProductFile, error) {
 	url := fmt.Sprintf(
 		"/products/%s/product_files/%d",
 		productSlug,
@@ -289,7 +456,8 @@ func (p ProductFilesService) Delete(productSlug string, id int) (ProductFile, er
 	)
 
 	var response ProductFileResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"DELETE",
 		url,
 		http.StatusOK,
@@ -312,6 +480,15 @@ func (p ProductFilesService) AddToRelease(
 	productSlug string,
 	releaseID int,
 	productFileID int,
+) error {
+	return p.AddToReleaseContext(context.Background(), productSlug, releaseID, productFileID)
+}
+
+func (p ProductFilesService) AddToReleaseContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	productFileID int,
 ) error {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/add_product_file",
@@ -332,7 +509,8 @@ func (p ProductFilesService) AddToRelease(
 		return err
 	}
 
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -350,6 +528,15 @@ func (p ProductFilesService) RemoveFromRelease(
 	productSlug string,
 	releaseID int,
 	productFileID int,
+) error {
+	return p.RemoveFromReleaseContext(context.Background(), productSlug, releaseID, productFileID)
+}
+
+func (p ProductFilesService) RemoveFromReleaseContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	productFileID int,
 ) error {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/remove_product_file",
@@ -370,7 +557,8 @@ func (p ProductFilesService) RemoveFromRelease(
 		return err
 	}
 
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -388,6 +576,15 @@ func (p ProductFilesService) AddToFileGroup(
 	productSlug string,
 	fileGroupID int,
 	productFileID int,
+) error {
+	return p.AddToFileGroupContext(context.Background(), productSlug, fileGroupID, productFileID)
+}
+
+func (p ProductFilesService) AddToFileGroupContext(
+	ctx context.Context,
+	productSlug string,
+	fileGroupID int,
+	productFileID int,
 ) error {
 	url := fmt.Sprintf(
 		"/products/%s/file_groups/%d/add_product_file",
@@ -408,7 +605,8 @@ func (p ProductFilesService) AddToFileGroup(
 		return err
 	}
 
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -426,6 +624,15 @@ func (p ProductFilesService) RemoveFromFileGroup(
 	productSlug string,
 	fileGroupID int,
 	productFileID int,
+) error {
+	return p.RemoveFromFileGroupContext(context.Background(), productSlug, fileGroupID, productFileID)
+}
+
+func (p ProductFilesService) RemoveFromFileGroupContext(
+	ctx context.Context,
+	productSlug string,
+	fileGroupID int,
+	productFileID int,
 ) error {
 	url := fmt.Sprintf(
 		"/products/%s/file_groups/%d/remove_product_file",
@@ -446,7 +653,8 @@ func (p ProductFilesService) RemoveFromFileGroup(
 		return err
 	}
 
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -467,13 +675,25 @@ func (p ProductFilesService) DownloadForRelease(
 	productFileID int,
 	progressWriter io.Writer,
 ) error {
-	pf, err := p.GetForRelease(
+	return p.DownloadForReleaseContext(context.Background(), location, productSlug, releaseID, productFileID, progressWriter)
+}
+
+func (p ProductFilesService) DownloadForReleaseContext(
+	ctx context.Context,
+	location *download.FileInfo,
+	productSlug string,
+	releaseID int,
+	productFileID int,
+	progressWriter io.Writer,
+) error {
+	pf, err := p.GetForReleaseContext(
+		ctx,
 		productSlug,
 		releaseID,
 		productFileID,
 	)
 	if err != nil {
-		return fmt.Errorf("GetForRelease: %s", err)
+		return fmt.Errorf("GetForRelease: %w", err)
 	}
 
 	downloadLink, err := pf.DownloadLink()
@@ -483,17 +703,85 @@ func (p ProductFilesService) DownloadForRelease(
 
 	p.client.logger.Debug("Downloading file", logger.Data{"downloadLink": downloadLink})
 
-	productFileDownloadLinkFetcher := NewProductFileLinkFetcher(downloadLink, p.client)
+	productFileDownloadLinkFetcher := NewProductFileLinkFetcherContext(ctx, downloadLink, p.client)
+
+	if p.client.downloader.Progress == nil {
+		p.client.downloader.Bar = download.NewBar()
+	}
+
+	fileInfo := *location
+	if fileInfo.ProductFileID == 0 {
+		fileInfo.ProductFileID = pf.ID
+	}
+	if fileInfo.SHA256 == "" && fileInfo.MD5 == "" {
+		fileInfo.SHA256 = pf.SHA256
+		fileInfo.MD5 = pf.MD5
+	}
 
-	p.client.downloader.Bar = download.NewBar()
+	err = p.client.downloader.GetContext(
+		ctx,
+		&fileInfo,
+		productFileDownloadLinkFetcher,
+		progressWriter,
+	)
+	if err != nil {
+		return fmt.Errorf("Downloader.Get: %w", err)
+	}
+
+	return nil
+}
+
+// DownloadToWriter streams the product file into writer in order, without
+// staging it on disk.
+func (p ProductFilesService) DownloadToWriter(
+	writer io.Writer,
+	productSlug string,
+	releaseID int,
+	productFileID int,
+	progressWriter io.Writer,
+) error {
+	return p.DownloadToWriterContext(context.Background(), writer, productSlug, releaseID, productFileID, progressWriter)
+}
+
+func (p ProductFilesService) DownloadToWriterContext(
+	ctx context.Context,
+	writer io.Writer,
+	productSlug string,
+	releaseID int,
+	productFileID int,
+	progressWriter io.Writer,
+) error {
+	pf, err := p.GetForReleaseContext(
+		ctx,
+		productSlug,
+		releaseID,
+		productFileID,
+	)
+	if err != nil {
+		return fmt.Errorf("GetForRelease: %w", err)
+	}
+
+	downloadLink, err := pf.DownloadLink()
+	if err != nil {
+		return fmt.Errorf("DownloadLink: %s", err)
+	}
+
+	p.client.logger.Debug("Streaming file", logger.Data{"downloadLink": downloadLink})
+
+	productFileDownloadLinkFetcher := NewProductFileLinkFetcherContext(ctx, downloadLink, p.client)
+
+	if p.client.downloader.Progress == nil {
+		p.client.downloader.Bar = download.NewBar()
+	}
 
-	err = p.client.downloader.Get(
-		location,
+	err = p.client.downloader.StreamContext(
+		ctx,
 		productFileDownloadLinkFetcher,
+		writer,
 		progressWriter,
 	)
 	if err != nil {
-		return fmt.Errorf("Downloader.Get: %s", err)
+		return fmt.Errorf("Downloader.Stream: %w", err)
 	}
 
 	return nil
@@ -1,9 +1,13 @@
 package pivnet_test
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"os"
 	"regexp"
 	"strconv"
 
@@ -134,6 +138,60 @@ var _ = Describe("PivnetClient - product files", func() {
 		})
 	})
 
+	Describe("List all product files", func() {
+		It("returns the product files from every page", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", apiPrefix+"/products/banana/product_files", "per_page=1"),
+					ghttp.RespondWith(http.StatusOK, fmt.Sprintf(`{"product_files": [{"id":1234}], "_links": {"next": {"href":"%s/api/v2/products/banana/product_files?page=2&per_page=1"}}}`, apiAddress)),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", apiPrefix+"/products/banana/product_files", "page=2&per_page=1"),
+					ghttp.RespondWith(http.StatusOK, `{"product_files": [{"id":2345}]}`),
+				),
+			)
+
+			productFiles, err := client.ProductFiles.ListAll("banana", pivnet.ListOptions{PerPage: 1})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(productFiles).To(HaveLen(2))
+			Expect(productFiles[0].ID).To(Equal(1234))
+			Expect(productFiles[1].ID).To(Equal(2345))
+		})
+
+		Context("when the server responds with a non-2XX status code", func() {
+			It("returns an error", func() {
+				server.AppendHandlers(
+					ghttp.RespondWith(http.StatusTeapot, `{"message":"foo message"}`),
+				)
+
+				_, err := client.ProductFiles.ListAll("banana", pivnet.ListOptions{})
+				Expect(err).To(MatchError(ContainSubstring("foo message")))
+			})
+		})
+	})
+
+	Describe("NewProductFileIterator", func() {
+		It("iterates over the given product files and then reports the error", func() {
+			fake := &gopivnetfakes.FakeProductFilesAPI{}
+			fake.IterateReturns(pivnet.NewProductFileIterator([]pivnet.ProductFile{{ID: 1}, {ID: 2}}, errors.New("some error")))
+
+			var ids []int
+			it := fake.Iterate("banana", pivnet.ListOptions{})
+			for it.Next() {
+				ids = append(ids, it.ProductFile().ID)
+			}
+
+			Expect(ids).To(Equal([]int{1, 2}))
+			Expect(it.Err()).To(MatchError("some error"))
+		})
+
+		It("makes the zero iterator empty", func() {
+			it := &pivnet.ProductFileIterator{}
+			Expect(it.Next()).To(BeFalse())
+			Expect(it.Err()).NotTo(HaveOccurred())
+		})
+	})
+
 	Describe("List product files for release", func() {
 		var (
 			productSlug string
@@ -1261,6 +1319,59 @@ var _ = Describe("PivnetClient - product files", func() {
 			Expect(contents).To(Equal(downloadLinkResponseBody))
 		})
 
+		It("streams file contents to a writer with DownloadToWriter", func() {
+			var buf bytes.Buffer
+
+			err := client.ProductFiles.DownloadToWriter(
+				&buf,
+				productSlug,
+				releaseID,
+				productFileID,
+				GinkgoWriter,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(buf.Bytes()).To(Equal(downloadLinkResponseBody))
+		})
+
+		Context("when the downloaded file does not match the product file's SHA256", func() {
+			BeforeEach(func() {
+				getResponse = pivnet.ProductFileResponse{
+					pivnet.ProductFile{
+						ID:     1234,
+						SHA256: "some-other-sha256",
+						Links: &pivnet.Links{
+							Download: map[string]string{
+								"href": downloadLink,
+							},
+						},
+					},
+				}
+			})
+
+			It("returns an ErrChecksumMismatch", func() {
+				tmpFile, err := ioutil.TempFile("", "")
+				Expect(err).NotTo(HaveOccurred())
+				defer os.Remove(tmpFile.Name())
+
+				tmpLocation, err := download.NewFileInfo(tmpFile)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = client.ProductFiles.DownloadForRelease(
+					tmpLocation,
+					productSlug,
+					releaseID,
+					productFileID,
+					GinkgoWriter,
+				)
+
+				var mismatch download.ErrChecksumMismatch
+				Expect(errors.As(err, &mismatch)).To(BeTrue())
+				Expect(mismatch.Expected).To(Equal("some-other-sha256"))
+				Expect(mismatch.Actual).To(Equal(fmt.Sprintf("%x", sha256.Sum256(downloadLinkResponseBody))))
+			})
+		})
+
 		Context("when productFile.DownloadLink() returns an error", func() {
 			BeforeEach(func() {
 				getResponse = pivnet.ProductFileResponse{
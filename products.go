@@ -1,6 +1,7 @@
 package pivnet
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -13,6 +14,16 @@ type ProductsService struct {
 	l      logger.Logger
 }
 
+//go:generate counterfeiter . ProductsAPI
+type ProductsAPI interface {
+	List() ([]Product, error)
+	ListContext(ctx context.Context) ([]Product, error)
+	Get(slug string) (Product, error)
+	GetContext(ctx context.Context, slug string) (Product, error)
+	SlugAlias(slug string) (SlugAliasResponse, error)
+	SlugAliasContext(ctx context.Context, slug string) (SlugAliasResponse, error)
+}
+
 type S3Directory struct {
 	Path string `json:"path,omitempty" yaml:"path,omitempty"`
 }
@@ -35,10 +46,15 @@ type ProductsResponse struct {
 }
 
 func (p ProductsService) List() ([]Product, error) {
+	return p.ListContext(context.Background())
+}
+
+func (p ProductsService) ListContext(ctx context.Context) ([]Product, error) {
 	url := "/products"
 
 	var response ProductsResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -58,10 +74,15 @@ func (p ProductsService) List() ([]Product, error) {
 }
 
 func (p ProductsService) Get(slug string) (Product, error) {
+	return p.GetContext(context.Background(), slug)
+}
+
+func (p ProductsService) GetContext(ctx context.Context, slug string) (Product, error) {
 	url := fmt.Sprintf("/products/%s", slug)
 
 	var response Product
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -81,10 +102,15 @@ func (p ProductsService) Get(slug string) (Product, error) {
 }
 
 func (p ProductsService) SlugAlias(slug string) (SlugAliasResponse, error) {
+	return p.SlugAliasContext(context.Background(), slug)
+}
+
+func (p ProductsService) SlugAliasContext(ctx context.Context, slug string) (SlugAliasResponse, error) {
 	url := fmt.Sprintf("/products/%s/slug_alias", slug)
 
 	var response SlugAliasResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
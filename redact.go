@@ -0,0 +1,134 @@
+package pivnet
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/pivotal-cf/go-pivnet/v9/logger"
+)
+
+const redacted = "[REDACTED]"
+
+// defaultRedactedFields are the header, JSON field and query parameter names
+// whose values are always removed from logged data.
+var defaultRedactedFields = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"refresh_token",
+	"access_token",
+	"secret_access_key",
+	"session_token",
+	"X-Amz-Signature",
+	"X-Amz-Credential",
+	"X-Amz-Security-Token",
+	"Signature",
+}
+
+// redactingLogger scrubs credentials from everything passed to the wrapped
+// logger, so debug logging of requests and download URLs is safe to keep in
+// CI output.
+type redactingLogger struct {
+	logger logger.Logger
+	names  map[string]bool
+
+	headerPattern *regexp.Regexp
+	jsonPattern   *regexp.Regexp
+	paramPattern  *regexp.Regexp
+}
+
+func newRedactingLogger(lgr logger.Logger, extraFields []string) logger.Logger {
+	if _, ok := lgr.(*redactingLogger); ok || lgr == nil {
+		return lgr
+	}
+
+	fields := append(append([]string{}, defaultRedactedFields...), extraFields...)
+
+	names := map[string]bool{}
+	quoted := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if f == "" || names[strings.ToLower(f)] {
+			continue
+		}
+		names[strings.ToLower(f)] = true
+		quoted = append(quoted, regexp.QuoteMeta(f))
+	}
+	alternation := strings.Join(quoted, "|")
+
+	return &redactingLogger{
+		logger: lgr,
+		names:  names,
+		// "Authorization: Bearer ..." lines in dumped requests
+		headerPattern: regexp.MustCompile(`(?im)^((?:` + alternation + `):[ \t]*)[^\r\n]*`),
+		// "refresh_token": "..." in JSON bodies
+		jsonPattern: regexp.MustCompile(`(?i)("(?:` + alternation + `)"\s*:\s*)"(?:[^"\\]|\\.)*"`),
+		// ?X-Amz-Signature=... in URLs and form bodies
+		paramPattern: regexp.MustCompile(`(?i)((?:^|[?&\s])(?:` + alternation + `)=)[^&\s"]*`),
+	}
+}
+
+func (l *redactingLogger) Debug(action string, data ...logger.Data) {
+	l.logger.Debug(l.redactString(action), l.redactData(data)...)
+}
+
+func (l *redactingLogger) Info(action string, data ...logger.Data) {
+	l.logger.Info(l.redactString(action), l.redactData(data)...)
+}
+
+func (l *redactingLogger) redactData(data []logger.Data) []logger.Data {
+	if len(data) == 0 {
+		return data
+	}
+
+	redactedData := make([]logger.Data, len(data))
+	for i, d := range data {
+		redactedData[i] = logger.Data{}
+		for k, v := range d {
+			if l.names[strings.ToLower(k)] {
+				redactedData[i][k] = redacted
+				continue
+			}
+			redactedData[i][k] = l.redactValue(v)
+		}
+	}
+
+	return redactedData
+}
+
+func (l *redactingLogger) redactValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case string:
+		return l.redactString(value)
+	case []byte:
+		return l.redactString(string(value))
+	case http.Header:
+		header := http.Header{}
+		for k, vs := range value {
+			if l.names[strings.ToLower(k)] {
+				header[k] = []string{redacted}
+				continue
+			}
+			for _, s := range vs {
+				header[k] = append(header[k], l.redactString(s))
+			}
+		}
+		return header
+	case *url.URL:
+		if value == nil {
+			return value
+		}
+		return l.redactString(value.String())
+	case error:
+		return l.redactString(value.Error())
+	default:
+		return v
+	}
+}
+
+func (l *redactingLogger) redactString(s string) string {
+	s = l.headerPattern.ReplaceAllString(s, "${1}"+redacted)
+	s = l.jsonPattern.ReplaceAllString(s, `${1}"`+redacted+`"`)
+	s = l.paramPattern.ReplaceAllString(s, "${1}"+redacted)
+	return s
+}
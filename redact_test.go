@@ -0,0 +1,97 @@
+package pivnet
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pivotal-cf/go-pivnet/v9/logger"
+	"github.com/pivotal-cf/go-pivnet/v9/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("redactingLogger", func() {
+	var (
+		fakeLogger *loggerfakes.FakeLogger
+		lgr        logger.Logger
+	)
+
+	BeforeEach(func() {
+		fakeLogger = &loggerfakes.FakeLogger{}
+		lgr = newRedactingLogger(fakeLogger, []string{"X-Custom-Secret", "password"})
+	})
+
+	It("redacts credential headers in dumped requests", func() {
+		lgr.Debug("Making request", logger.Data{"request": "POST /api/v2/products HTTP/1.1\r\n" +
+			"Host: example.com\r\n" +
+			"Authorization: Bearer some-access-token\r\n" +
+			"Proxy-Authorization: Basic dXNlcjpwYXNz\r\n" +
+			"X-Custom-Secret: shh\r\n" +
+			"\r\n" +
+			`{"refresh_token": "some-refresh-token", "password":"p\"w", "name":"kept"}`,
+		})
+
+		_, data := fakeLogger.DebugArgsForCall(0)
+		request := data[0]["request"].(string)
+
+		Expect(request).To(ContainSubstring("Host: example.com\r\n"))
+		Expect(request).To(ContainSubstring("Authorization: [REDACTED]\r\n"))
+		Expect(request).To(ContainSubstring("Proxy-Authorization: [REDACTED]\r\n"))
+		Expect(request).To(ContainSubstring("X-Custom-Secret: [REDACTED]\r\n"))
+		Expect(request).To(ContainSubstring(`"refresh_token": "[REDACTED]"`))
+		Expect(request).To(ContainSubstring(`"password":"[REDACTED]"`))
+		Expect(request).To(ContainSubstring(`"name":"kept"`))
+		Expect(request).NotTo(ContainSubstring("some-access-token"))
+		Expect(request).NotTo(ContainSubstring("some-refresh-token"))
+	})
+
+	It("redacts federation token secrets", func() {
+		lgr.Info("token", logger.Data{
+			"body": []byte(`{"access_key_id":"AKIA","secret_access_key":"secret","session_token":"session"}`),
+		})
+
+		_, data := fakeLogger.InfoArgsForCall(0)
+		Expect(data[0]["body"]).To(Equal(`{"access_key_id":"AKIA","secret_access_key":"[REDACTED]","session_token":"[REDACTED]"}`))
+	})
+
+	It("redacts presigned URL signatures in actions, strings and errors", func() {
+		presigned := "https://bucket.s3.amazonaws.com/file?X-Amz-Credential=cred&X-Amz-Date=20200101&X-Amz-Signature=sig"
+		expected := "https://bucket.s3.amazonaws.com/file?X-Amz-Credential=[REDACTED]&X-Amz-Date=20200101&X-Amz-Signature=[REDACTED]"
+
+		lgr.Debug("retrying "+presigned, logger.Data{
+			"url": presigned,
+			"err": fmt.Errorf("Get %q: EOF", presigned),
+		})
+
+		action, data := fakeLogger.DebugArgsForCall(0)
+		Expect(action).To(Equal("retrying " + expected))
+		Expect(data[0]["url"]).To(Equal(expected))
+		Expect(data[0]["err"]).To(Equal(fmt.Sprintf("Get %q: EOF", expected)))
+	})
+
+	It("redacts sensitive keys and headers", func() {
+		lgr.Debug("Response", logger.Data{
+			"access_token": "some-token",
+			"status code":  200,
+			"headers": http.Header{
+				"Authorization": []string{"Bearer some-token"},
+				"Content-Type":  []string{"application/json"},
+			},
+		})
+
+		_, data := fakeLogger.DebugArgsForCall(0)
+		Expect(data[0]).To(Equal(logger.Data{
+			"access_token": "[REDACTED]",
+			"status code":  200,
+			"headers": http.Header{
+				"Authorization": []string{"[REDACTED]"},
+				"Content-Type":  []string{"application/json"},
+			},
+		}))
+	})
+
+	It("does not wrap a logger twice", func() {
+		Expect(newRedactingLogger(lgr, nil)).To(BeIdenticalTo(lgr))
+	})
+})
@@ -0,0 +1,221 @@
+package pivnet
+
+import (
+	"context"
+	"fmt"
+)
+
+// CloneReleaseOptions choose what a cloned release copies from its source.
+// Every association is copied unless skipped.
+type CloneReleaseOptions struct {
+	SkipProductFiles          bool
+	SkipFileGroups            bool
+	SkipArtifactReferences    bool
+	SkipUserGroups            bool
+	SkipDependencySpecifiers  bool
+	SkipUpgradePathSpecifiers bool
+
+	// Release type of the new release (optional, the source's by default)
+	ReleaseType string
+	// Release date of the new release (optional, today by default)
+	ReleaseDate string
+
+	// Passed on to Create as CreateReleaseConfig.CopyMetadata
+	CopyMetadata bool
+}
+
+// CloneReleaseReport is the new release and what was copied to it.
+type CloneReleaseReport struct {
+	Release               Release                `json:"release" yaml:"release"`
+	ProductFiles          []ProductFile          `json:"product_files,omitempty" yaml:"product_files,omitempty"`
+	FileGroups            []FileGroup            `json:"file_groups,omitempty" yaml:"file_groups,omitempty"`
+	ArtifactReferences    []ArtifactReference    `json:"artifact_references,omitempty" yaml:"artifact_references,omitempty"`
+	UserGroups            []UserGroup            `json:"user_groups,omitempty" yaml:"user_groups,omitempty"`
+	DependencySpecifiers  []DependencySpecifier  `json:"dependency_specifiers,omitempty" yaml:"dependency_specifiers,omitempty"`
+	UpgradePathSpecifiers []UpgradePathSpecifier `json:"upgrade_path_specifiers,omitempty" yaml:"upgrade_path_specifiers,omitempty"`
+}
+
+// Clone creates a release with newVersion that has the source release's
+// details and associations. The associations are added one at a time, so if
+// adding one fails the new release is left partly populated; the returned
+// report then says what was copied before the error.
+func (r ReleasesService) Clone(productSlug string, sourceReleaseID int, newVersion string, opts CloneReleaseOptions) (CloneReleaseReport, error) {
+	return r.CloneContext(context.Background(), productSlug, sourceReleaseID, newVersion, opts)
+}
+
+func (r ReleasesService) CloneContext(ctx context.Context, productSlug string, sourceReleaseID int, newVersion string, opts CloneReleaseOptions) (CloneReleaseReport, error) {
+	source, err := r.GetContext(ctx, productSlug, sourceReleaseID)
+	if err != nil {
+		return CloneReleaseReport{}, err
+	}
+
+	config := CreateReleaseConfig{
+		ProductSlug:           productSlug,
+		Version:               newVersion,
+		ReleaseType:           string(source.ReleaseType),
+		ReleaseDate:           opts.ReleaseDate,
+		Description:           source.Description,
+		ReleaseNotesURL:       source.ReleaseNotesURL,
+		Controlled:            source.Controlled,
+		ECCN:                  source.ECCN,
+		LicenseException:      source.LicenseException,
+		EndOfSupportDate:      source.EndOfSupportDate,
+		EndOfGuidanceDate:     source.EndOfGuidanceDate,
+		EndOfAvailabilityDate: source.EndOfAvailabilityDate,
+		CopyMetadata:          opts.CopyMetadata,
+	}
+	if source.EULA != nil {
+		config.EULASlug = source.EULA.Slug
+	}
+	if opts.ReleaseType != "" {
+		config.ReleaseType = opts.ReleaseType
+	}
+
+	release, err := r.CreateContext(ctx, config)
+	if err != nil {
+		return CloneReleaseReport{}, err
+	}
+
+	report := CloneReleaseReport{Release: release}
+	c := releaseCloner{client: r.client, productSlug: productSlug, source: source.ID, target: release.ID}
+
+	for _, step := range []struct {
+		skip bool
+		copy func(context.Context, *CloneReleaseReport) error
+	}{
+		{opts.SkipProductFiles, c.copyProductFiles},
+		{opts.SkipFileGroups, c.copyFileGroups},
+		{opts.SkipArtifactReferences, c.copyArtifactReferences},
+		{opts.SkipUserGroups, c.copyUserGroups},
+		{opts.SkipDependencySpecifiers, c.copyDependencySpecifiers},
+		{opts.SkipUpgradePathSpecifiers, c.copyUpgradePathSpecifiers},
+	} {
+		if step.skip {
+			continue
+		}
+		if err := step.copy(ctx, &report); err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// releaseCloner copies the associations of the source release to the
+// target release.
+type releaseCloner struct {
+	client      Client
+	productSlug string
+	source      int
+	target      int
+}
+
+func (c releaseCloner) copyProductFiles(ctx context.Context, report *CloneReleaseReport) error {
+	service := ProductFilesService{client: c.client}
+
+	productFiles, err := service.ListForReleaseContext(ctx, c.productSlug, c.source)
+	if err != nil {
+		return fmt.Errorf("failed to list product files of release %d: %w", c.source, err)
+	}
+
+	for _, pf := range productFiles {
+		if err := service.AddToReleaseContext(ctx, c.productSlug, c.target, pf.ID); err != nil {
+			return fmt.Errorf("failed to add product file %d to release %d: %w", pf.ID, c.target, err)
+		}
+		report.ProductFiles = append(report.ProductFiles, pf)
+	}
+
+	return nil
+}
+
+func (c releaseCloner) copyFileGroups(ctx context.Context, report *CloneReleaseReport) error {
+	service := FileGroupsService{client: c.client}
+
+	fileGroups, err := service.ListForReleaseContext(ctx, c.productSlug, c.source)
+	if err != nil {
+		return fmt.Errorf("failed to list file groups of release %d: %w", c.source, err)
+	}
+
+	for _, fg := range fileGroups {
+		if err := service.AddToReleaseContext(ctx, c.productSlug, c.target, fg.ID); err != nil {
+			return fmt.Errorf("failed to add file group %d to release %d: %w", fg.ID, c.target, err)
+		}
+		report.FileGroups = append(report.FileGroups, fg)
+	}
+
+	return nil
+}
+
+func (c releaseCloner) copyArtifactReferences(ctx context.Context, report *CloneReleaseReport) error {
+	service := ArtifactReferencesService{client: c.client}
+
+	artifactReferences, err := service.ListForReleaseContext(ctx, c.productSlug, c.source)
+	if err != nil {
+		return fmt.Errorf("failed to list artifact references of release %d: %w", c.source, err)
+	}
+
+	for _, ar := range artifactReferences {
+		if err := service.AddToReleaseContext(ctx, c.productSlug, c.target, ar.ID); err != nil {
+			return fmt.Errorf("failed to add artifact reference %d to release %d: %w", ar.ID, c.target, err)
+		}
+		report.ArtifactReferences = append(report.ArtifactReferences, ar)
+	}
+
+	return nil
+}
+
+func (c releaseCloner) copyUserGroups(ctx context.Context, report *CloneReleaseReport) error {
+	service := UserGroupsService{client: c.client}
+
+	userGroups, err := service.ListForReleaseContext(ctx, c.productSlug, c.source)
+	if err != nil {
+		return fmt.Errorf("failed to list user groups of release %d: %w", c.source, err)
+	}
+
+	for _, ug := range userGroups {
+		if err := service.AddToReleaseContext(ctx, c.productSlug, c.target, ug.ID); err != nil {
+			return fmt.Errorf("failed to add user group %d to release %d: %w", ug.ID, c.target, err)
+		}
+		report.UserGroups = append(report.UserGroups, ug)
+	}
+
+	return nil
+}
+
+func (c releaseCloner) copyDependencySpecifiers(ctx context.Context, report *CloneReleaseReport) error {
+	service := DependencySpecifiersService{client: c.client}
+
+	specifiers, err := service.ListContext(ctx, c.productSlug, c.source)
+	if err != nil {
+		return fmt.Errorf("failed to list dependency specifiers of release %d: %w", c.source, err)
+	}
+
+	for _, ds := range specifiers {
+		created, err := service.CreateContext(ctx, c.productSlug, c.target, ds.Product.Slug, ds.Specifier)
+		if err != nil {
+			return fmt.Errorf("failed to create dependency specifier %s %s for release %d: %w", ds.Product.Slug, ds.Specifier, c.target, err)
+		}
+		report.DependencySpecifiers = append(report.DependencySpecifiers, created)
+	}
+
+	return nil
+}
+
+func (c releaseCloner) copyUpgradePathSpecifiers(ctx context.Context, report *CloneReleaseReport) error {
+	service := UpgradePathSpecifiersService{client: c.client}
+
+	specifiers, err := service.ListContext(ctx, c.productSlug, c.source)
+	if err != nil {
+		return fmt.Errorf("failed to list upgrade path specifiers of release %d: %w", c.source, err)
+	}
+
+	for _, us := range specifiers {
+		created, err := service.CreateContext(ctx, c.productSlug, c.target, us.Specifier)
+		if err != nil {
+			return fmt.Errorf("failed to create upgrade path specifier %s for release %d: %w", us.Specifier, c.target, err)
+		}
+		report.UpgradePathSpecifiers = append(report.UpgradePathSpecifiers, created)
+	}
+
+	return nil
+}
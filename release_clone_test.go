@@ -0,0 +1,148 @@
+package pivnet_test
+
+import (
+	"github.com/pivotal-cf/go-pivnet/v9"
+	"github.com/pivotal-cf/go-pivnet/v9/logger/loggerfakes"
+	"github.com/pivotal-cf/go-pivnet/v9/pivnettest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PivnetClient - release clone", func() {
+	var (
+		server  *pivnettest.Server
+		client  pivnet.Client
+		product pivnet.Product
+		source  pivnet.Release
+	)
+
+	BeforeEach(func() {
+		server = pivnettest.NewServer()
+
+		product = server.AddProduct(pivnet.Product{Slug: "some-product"})
+		server.AddProduct(pivnet.Product{Slug: "some-dependency"})
+		eula := server.AddEULA(pivnet.EULA{Slug: "some-eula"})
+
+		source = server.AddRelease(product.Slug, pivnet.Release{
+			Version:          "2.13.4",
+			ReleaseType:      "Minor Release",
+			EULA:             &eula,
+			Description:      "some description",
+			ECCN:             "5D002",
+			EndOfSupportDate: "2025-01-31",
+		})
+
+		pf := server.AddProductFile(product.Slug, source.ID, pivnet.ProductFile{AWSObjectKey: "product-files/some-product/tile.pivotal"}, []byte("tile"))
+		server.AddFileGroup(product.Slug, source.ID, pivnet.FileGroup{Name: "Stemcells", ProductFiles: []pivnet.ProductFile{pf}})
+		ug := server.AddUserGroup(pivnet.UserGroup{Name: "Early Access"})
+		ar := server.AddArtifactReference(product.Slug, pivnet.ArtifactReference{ArtifactPath: "some/path", Digest: "sha256:abc"})
+
+		token := pivnet.NewAccessTokenOrLegacyToken("some-refresh-token-longer-than-a-legacy-token", server.URL(), false)
+		client = pivnet.NewClient(token, pivnet.ClientConfig{Host: server.URL()}, &loggerfakes.FakeLogger{})
+
+		Expect(client.UserGroups.AddToRelease(product.Slug, source.ID, ug.ID)).To(Succeed())
+		Expect(client.ArtifactReferences.AddToRelease(product.Slug, source.ID, ar.ID)).To(Succeed())
+		_, err := client.DependencySpecifiers.Create(product.Slug, source.ID, "some-dependency", "1.2.*")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.UpgradePathSpecifiers.Create(product.Slug, source.ID, "2.12.*")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("creates a release with the source's details and associations", func() {
+		report, err := client.Releases.Clone(product.Slug, source.ID, "2.13.5", pivnet.CloneReleaseOptions{ReleaseType: "Security Release"})
+		Expect(err).NotTo(HaveOccurred())
+
+		clone := report.Release
+		Expect(clone.ID).NotTo(Equal(source.ID))
+		Expect(clone.Version).To(Equal("2.13.5"))
+		Expect(clone.ReleaseType).To(Equal(pivnet.ReleaseType("Security Release")))
+		Expect(clone.EULA.Slug).To(Equal("some-eula"))
+		Expect(clone.Description).To(Equal("some description"))
+		Expect(clone.ECCN).To(Equal("5D002"))
+		Expect(clone.EndOfSupportDate).To(Equal("2025-01-31"))
+
+		Expect(report.ProductFiles).To(HaveLen(1))
+		Expect(report.FileGroups).To(HaveLen(1))
+		Expect(report.UserGroups).To(HaveLen(1))
+		Expect(report.ArtifactReferences).To(HaveLen(1))
+		Expect(report.DependencySpecifiers).To(HaveLen(1))
+		Expect(report.UpgradePathSpecifiers).To(HaveLen(1))
+
+		productFiles, err := client.ProductFiles.ListForRelease(product.Slug, clone.ID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(productFiles).To(HaveLen(1))
+		Expect(productFiles[0].AWSObjectKey).To(Equal("product-files/some-product/tile.pivotal"))
+
+		fileGroups, err := client.FileGroups.ListForRelease(product.Slug, clone.ID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fileGroups).To(HaveLen(1))
+		Expect(fileGroups[0].Name).To(Equal("Stemcells"))
+
+		userGroups, err := client.UserGroups.ListForRelease(product.Slug, clone.ID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(userGroups).To(HaveLen(1))
+
+		artifactReferences, err := client.ArtifactReferences.ListForRelease(product.Slug, clone.ID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(artifactReferences).To(HaveLen(1))
+
+		dependencySpecifiers, err := client.DependencySpecifiers.List(product.Slug, clone.ID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dependencySpecifiers).To(HaveLen(1))
+		Expect(dependencySpecifiers[0].Product.Slug).To(Equal("some-dependency"))
+		Expect(dependencySpecifiers[0].Specifier).To(Equal("1.2.*"))
+
+		upgradePathSpecifiers, err := client.UpgradePathSpecifiers.List(product.Slug, clone.ID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(upgradePathSpecifiers).To(HaveLen(1))
+		Expect(upgradePathSpecifiers[0].Specifier).To(Equal("2.12.*"))
+	})
+
+	It("skips the associations it is told to", func() {
+		report, err := client.Releases.Clone(product.Slug, source.ID, "2.13.5", pivnet.CloneReleaseOptions{
+			SkipFileGroups:            true,
+			SkipUserGroups:            true,
+			SkipUpgradePathSpecifiers: true,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Release.ReleaseType).To(Equal(pivnet.ReleaseType("Minor Release")))
+
+		Expect(report.ProductFiles).To(HaveLen(1))
+		Expect(report.ArtifactReferences).To(HaveLen(1))
+		Expect(report.DependencySpecifiers).To(HaveLen(1))
+		Expect(report.FileGroups).To(BeEmpty())
+		Expect(report.UserGroups).To(BeEmpty())
+		Expect(report.UpgradePathSpecifiers).To(BeEmpty())
+
+		fileGroups, err := client.FileGroups.ListForRelease(product.Slug, report.Release.ID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fileGroups).To(BeEmpty())
+	})
+
+	It("returns what was copied when adding an association fails", func() {
+		server.InjectFault(pivnettest.Fault{Path: "/products/*/releases/*/add_artifact_reference", StatusCode: 500, Message: "boom"})
+
+		report, err := client.Releases.Clone(product.Slug, source.ID, "2.13.5", pivnet.CloneReleaseOptions{})
+		Expect(err).To(MatchError(ContainSubstring("failed to add artifact reference")))
+
+		Expect(report.Release.Version).To(Equal("2.13.5"))
+		Expect(report.ProductFiles).To(HaveLen(1))
+		Expect(report.FileGroups).To(HaveLen(1))
+		Expect(report.ArtifactReferences).To(BeEmpty())
+		Expect(report.DependencySpecifiers).To(BeEmpty())
+	})
+
+	It("does not create a release when the source does not exist", func() {
+		_, err := client.Releases.Clone(product.Slug, 9999, "2.13.5", pivnet.CloneReleaseOptions{})
+		Expect(err).To(BeAssignableToTypeOf(pivnet.ErrNotFound{}))
+
+		releases, err := client.Releases.List(product.Slug)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(releases).To(HaveLen(1))
+	})
+})
@@ -2,6 +2,7 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -11,6 +12,16 @@ type ReleaseDependenciesService struct {
 	client Client
 }
 
+//go:generate counterfeiter . ReleaseDependenciesAPI
+type ReleaseDependenciesAPI interface {
+	List(productSlug string, releaseID int) ([]ReleaseDependency, error)
+	ListContext(ctx context.Context, productSlug string, releaseID int) ([]ReleaseDependency, error)
+	Add(productSlug string, releaseID int, dependentReleaseID int) error
+	AddContext(ctx context.Context, productSlug string, releaseID int, dependentReleaseID int) error
+	Remove(productSlug string, releaseID int, dependentReleaseID int) error
+	RemoveContext(ctx context.Context, productSlug string, releaseID int, dependentReleaseID int) error
+}
+
 type ReleaseDependenciesResponse struct {
 	ReleaseDependencies []ReleaseDependency `json:"dependencies,omitempty"`
 }
@@ -26,6 +37,10 @@ type DependentRelease struct {
 }
 
 func (r ReleaseDependenciesService) List(productSlug string, releaseID int) ([]ReleaseDependency, error) {
+	return r.ListContext(context.Background(), productSlug, releaseID)
+}
+
+func (r ReleaseDependenciesService) ListContext(ctx context.Context, productSlug string, releaseID int) ([]ReleaseDependency, error) {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/dependencies",
 		productSlug,
@@ -33,7 +48,8 @@ func (r ReleaseDependenciesService) List(productSlug string, releaseID int) ([]R
 	)
 
 	var response ReleaseDependenciesResponse
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -56,6 +72,15 @@ func (r ReleaseDependenciesService) Add(
 	productSlug string,
 	releaseID int,
 	dependentReleaseID int,
+) error {
+	return r.AddContext(context.Background(), productSlug, releaseID, dependentReleaseID)
+}
+
+func (r ReleaseDependenciesService) AddContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	dependentReleaseID int,
 ) error {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/add_dependency",
@@ -76,7 +101,8 @@ func (r ReleaseDependenciesService) Add(
 		return err
 	}
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -94,6 +120,15 @@ func (r ReleaseDependenciesService) Remove(
 	productSlug string,
 	releaseID int,
 	dependentReleaseID int,
+) error {
+	return r.RemoveContext(context.Background(), productSlug, releaseID, dependentReleaseID)
+}
+
+func (r ReleaseDependenciesService) RemoveContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	dependentReleaseID int,
 ) error {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/remove_dependency",
@@ -114,7 +149,8 @@ func (r ReleaseDependenciesService) Remove(
 		return err
 	}
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -0,0 +1,331 @@
+package pivnet
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+
+	"github.com/pivotal-cf/go-pivnet/v9/download"
+	"github.com/pivotal-cf/go-pivnet/v9/logger"
+)
+
+// DownloadReleaseConfig selects the product files of a release to download.
+// Files must match every filter that is set; with no filters every product
+// file of the release is downloaded.
+type DownloadReleaseConfig struct {
+	// Only files in one of these file groups, by name.
+	FileGroups []string
+	// Only files of one of these types, e.g. FileTypeSoftware.
+	FileTypes []string
+	// Only files whose file name matches this glob, as in path.Match.
+	Glob string
+
+	// Number of requests in flight across all files (optional, the
+	// client's ConcurrentDownloads by default).
+	Concurrency int
+}
+
+// DownloadResult is the outcome of downloading one product file. Error is
+// the message of Err, for reports that are encoded.
+type DownloadResult struct {
+	ProductFile ProductFile `json:"product_file" yaml:"product_file"`
+	Path        string      `json:"path" yaml:"path"`
+	Err         error       `json:"-" yaml:"-"`
+	Error       string      `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+type DownloadReport struct {
+	Results []DownloadResult `json:"results" yaml:"results"`
+}
+
+func (r DownloadReport) Failed() []DownloadResult {
+	var failed []DownloadResult
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// DownloadRelease downloads the product files of a release selected by
+// config into dir, each named after the last element of its AWS object key.
+// Files that would get the same name are prefixed with their product file ID.
+// Every file is verified against its checksum. The report holds a result for
+// every selected file; the returned error is non-nil if any of them failed.
+// Progress is reported for the release as a whole, started with the sum of
+// the sizes of the selected files.
+func (p ProductFilesService) DownloadRelease(
+	dir string,
+	productSlug string,
+	releaseID int,
+	config DownloadReleaseConfig,
+	progressWriter io.Writer,
+) (DownloadReport, error) {
+	return p.DownloadReleaseContext(context.Background(), dir, productSlug, releaseID, config, progressWriter)
+}
+
+func (p ProductFilesService) DownloadReleaseContext(
+	ctx context.Context,
+	dir string,
+	productSlug string,
+	releaseID int,
+	config DownloadReleaseConfig,
+	progressWriter io.Writer,
+) (DownloadReport, error) {
+	productFiles, err := p.releaseFilesToDownload(ctx, productSlug, releaseID, config)
+	if err != nil {
+		return DownloadReport{}, err
+	}
+
+	names, err := releaseFileNames(productFiles)
+	if err != nil {
+		return DownloadReport{}, err
+	}
+
+	concurrency := config.Concurrency
+	if concurrency <= 0 {
+		concurrency = p.client.downloader.Concurrency
+	}
+	if concurrency <= 0 {
+		concurrency = concurrentDownloads
+	}
+
+	reporter := p.client.downloader.Progress
+	if reporter == nil {
+		reporter = download.NewBarReporter(progressWriter)
+	}
+
+	var size int64
+	for _, pf := range productFiles {
+		size += int64(pf.Size)
+	}
+
+	downloader := p.client.downloader
+	downloader.Limiter = download.NewLimiter(concurrency)
+	downloader.Progress = releaseProgress{reporter: reporter}
+
+	report := DownloadReport{Results: make([]DownloadResult, len(productFiles))}
+
+	reporter.Start(size)
+
+	// files beyond the concurrency limit would only wait on the limiter
+	files := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency && w < len(productFiles); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range files {
+				report.Results[i] = p.downloadReleaseFile(ctx, downloader, filepath.Join(dir, names[i]), productSlug, releaseID, productFiles[i], progressWriter)
+			}
+		}()
+	}
+
+	// files that are not handed to a worker before ctx is done fail with its
+	// error
+	for i := range productFiles {
+		if ctx.Err() == nil {
+			select {
+			case files <- i:
+				continue
+			case <-ctx.Done():
+			}
+		}
+
+		report.Results[i] = DownloadResult{
+			ProductFile: productFiles[i],
+			Path:        filepath.Join(dir, names[i]),
+			Err:         ctx.Err(),
+		}
+	}
+	close(files)
+	wg.Wait()
+
+	for i, result := range report.Results {
+		if result.Err != nil {
+			report.Results[i].Error = result.Err.Error()
+		}
+	}
+
+	if failed := report.Failed(); len(failed) > 0 {
+		err = fmt.Errorf("failed to download %d of %d product files: %w", len(failed), len(productFiles), failed[0].Err)
+	}
+
+	reporter.Finish(err)
+	return report, err
+}
+
+// releaseProgress passes the progress of each file of a release on to the
+// reporter of the whole release, which is started and finished once.
+type releaseProgress struct {
+	reporter download.ProgressReporter
+}
+
+func (r releaseProgress) Start(contentLength int64) {}
+
+func (r releaseProgress) Add(lower, upper, n int64) {
+	r.reporter.Add(lower, upper, n)
+}
+
+func (r releaseProgress) Retry(lower, upper int64, attempt int, cause error) {
+	r.reporter.Retry(lower, upper, attempt, cause)
+}
+
+func (r releaseProgress) Finish(err error) {}
+
+func (p ProductFilesService) releaseFilesToDownload(ctx context.Context, productSlug string, releaseID int, config DownloadReleaseConfig) ([]ProductFile, error) {
+	if config.Glob != "" {
+		if _, err := path.Match(config.Glob, ""); err != nil {
+			return nil, fmt.Errorf("invalid glob %q: %w", config.Glob, err)
+		}
+	}
+
+	var productFiles []ProductFile
+	if len(config.FileGroups) > 0 {
+		fileGroups, err := FileGroupsService{client: p.client}.ListForReleaseContext(ctx, productSlug, releaseID)
+		if err != nil {
+			return nil, fmt.Errorf("ListFileGroupsForRelease: %w", err)
+		}
+
+		seen := map[int]bool{}
+		for _, fileGroup := range fileGroups {
+			if !containsString(config.FileGroups, fileGroup.Name) {
+				continue
+			}
+			for _, pf := range fileGroup.ProductFiles {
+				if !seen[pf.ID] {
+					seen[pf.ID] = true
+					productFiles = append(productFiles, pf)
+				}
+			}
+		}
+	} else {
+		var err error
+		productFiles, err = p.ListForReleaseContext(ctx, productSlug, releaseID)
+		if err != nil {
+			return nil, fmt.Errorf("ListForRelease: %w", err)
+		}
+	}
+
+	var selected []ProductFile
+	for _, pf := range productFiles {
+		if len(config.FileTypes) > 0 && !containsString(config.FileTypes, pf.FileType) {
+			continue
+		}
+		if config.Glob != "" {
+			if matched, _ := path.Match(config.Glob, productFileName(pf)); !matched {
+				continue
+			}
+		}
+		selected = append(selected, pf)
+	}
+
+	return selected, nil
+}
+
+func (p ProductFilesService) downloadReleaseFile(
+	ctx context.Context,
+	downloader download.Client,
+	filePath string,
+	productSlug string,
+	releaseID int,
+	pf ProductFile,
+	progressWriter io.Writer,
+) DownloadResult {
+	result := DownloadResult{
+		ProductFile: pf,
+		Path:        filePath,
+	}
+
+	// listings may leave out the download link or checksums
+	if _, err := pf.DownloadLink(); err != nil || (pf.SHA256 == "" && pf.MD5 == "") {
+		full, err := p.GetForReleaseContext(ctx, productSlug, releaseID, pf.ID)
+		if err != nil {
+			result.Err = fmt.Errorf("GetForRelease: %w", err)
+			return result
+		}
+		pf = full
+		result.ProductFile = full
+	}
+
+	downloadLink, err := pf.DownloadLink()
+	if err != nil {
+		result.Err = fmt.Errorf("DownloadLink: %s", err)
+		return result
+	}
+
+	p.client.logger.Debug("Downloading file", logger.Data{"downloadLink": downloadLink, "path": result.Path})
+
+	location := &download.FileInfo{
+		Name:          result.Path,
+		Mode:          os.FileMode(0644),
+		ProductFileID: pf.ID,
+		SHA256:        pf.SHA256,
+		MD5:           pf.MD5,
+	}
+
+	err = downloader.GetContext(ctx, location, NewProductFileLinkFetcherContext(ctx, downloadLink, p.client), progressWriter)
+	if err != nil {
+		result.Err = fmt.Errorf("Downloader.Get: %w", err)
+	}
+
+	return result
+}
+
+// productFileName is the name a product file is downloaded as. It is a
+// single path element, so the file cannot be written outside the directory.
+func productFileName(pf ProductFile) string {
+	for _, name := range []string{path.Base(pf.AWSObjectKey), pf.Name} {
+		if name = filepath.Base(name); validFileName(name) {
+			return name
+		}
+	}
+	return fmt.Sprintf("product-file-%d", pf.ID)
+}
+
+// validFileName reports whether name, as returned by filepath.Base, names a
+// file in the directory rather than the directory itself or its parent.
+func validFileName(name string) bool {
+	return name != "." && name != ".." && name != string(filepath.Separator)
+}
+
+// releaseFileNames returns the names the product files are downloaded as,
+// prefixing the product file ID to names that would otherwise be shared, so
+// that no two files are written to the same path.
+func releaseFileNames(productFiles []ProductFile) ([]string, error) {
+	counts := map[string]int{}
+	for _, pf := range productFiles {
+		counts[productFileName(pf)]++
+	}
+
+	names := make([]string, len(productFiles))
+	used := map[string]int{}
+	for i, pf := range productFiles {
+		name := productFileName(pf)
+		if counts[name] > 1 {
+			name = fmt.Sprintf("%d-%s", pf.ID, name)
+		}
+
+		if other, ok := used[name]; ok {
+			return nil, fmt.Errorf("product files %d and %d would both be downloaded as %s", productFiles[other].ID, pf.ID, name)
+		}
+		used[name] = i
+		names[i] = name
+	}
+
+	return names, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,307 @@
+package pivnet_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/pivotal-cf/go-pivnet/v9"
+	"github.com/pivotal-cf/go-pivnet/v9/download"
+	"github.com/pivotal-cf/go-pivnet/v9/go-pivnetfakes"
+	"github.com/pivotal-cf/go-pivnet/v9/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PivnetClient - release download", func() {
+	var (
+		server     *ghttp.Server
+		cloudfront *ghttp.Server
+		client     pivnet.Client
+		dir        string
+
+		productSlug  string
+		releaseID    int
+		contents     map[string]string
+		productFiles []pivnet.ProductFile
+	)
+
+	productFile := func(id int, key string, fileType string) pivnet.ProductFile {
+		return pivnet.ProductFile{
+			ID:           id,
+			AWSObjectKey: "product-files/banana/" + key,
+			FileType:     fileType,
+			Size:         len(contents[key]),
+			SHA256:       fmt.Sprintf("%x", sha256.Sum256([]byte(contents[key]))),
+			Links: &pivnet.Links{
+				Download: map[string]string{
+					"href": fmt.Sprintf("/products/%s/releases/%d/product_files/%d/download", productSlug, releaseID, id),
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		cloudfront = ghttp.NewServer()
+
+		productSlug = "banana"
+		releaseID = 1234
+		contents = map[string]string{
+			"tile.pivotal": "some tile contents",
+			"stemcell.tgz": "some stemcell contents",
+			"notes.pdf":    "some release notes",
+		}
+		productFiles = []pivnet.ProductFile{
+			productFile(1, "tile.pivotal", pivnet.FileTypeSoftware),
+			productFile(2, "stemcell.tgz", pivnet.FileTypeSoftware),
+			productFile(3, "notes.pdf", pivnet.FileTypeDocumentation),
+		}
+
+		client = pivnet.NewClient(&gopivnetfakes.FakeAccessTokenService{}, pivnet.ClientConfig{
+			Host:             server.URL(),
+			ProgressReporter: download.ProgressFunc(func(download.ProgressEvent) {}),
+		}, &loggerfakes.FakeLogger{})
+
+		var err error
+		dir, err = ioutil.TempDir("", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		server.RouteToHandler("POST", regexp.MustCompile(`/download$`), func(w http.ResponseWriter, req *http.Request) {
+			id, _ := strconv.Atoi(filepath.Base(filepath.Dir(req.URL.Path)))
+			for _, pf := range productFiles {
+				if pf.ID == id {
+					key := pf.AWSObjectKey
+					if key == "" {
+						key = pf.Name
+					}
+					w.Header().Set("Location", cloudfront.URL()+"/"+filepath.Base(key))
+				}
+			}
+			w.WriteHeader(http.StatusFound)
+		})
+
+		for key, content := range contents {
+			key, content := key, content
+
+			cloudfront.RouteToHandler("HEAD", "/"+key, ghttp.RespondWith(http.StatusOK, nil, http.Header{
+				"Content-Length": []string{strconv.Itoa(len(content))},
+			}))
+
+			cloudfront.RouteToHandler("GET", "/"+key, func(w http.ResponseWriter, req *http.Request) {
+				matches := regexp.MustCompile(`bytes=(\d+)-(\d+)`).FindStringSubmatch(req.Header.Get("Range"))
+				start, _ := strconv.Atoi(matches[1])
+				end, _ := strconv.Atoi(matches[2])
+
+				w.WriteHeader(http.StatusPartialContent)
+				w.Write([]byte(content[start : end+1]))
+			})
+		}
+
+		server.RouteToHandler("GET", fmt.Sprintf("%s/products/%s/releases/%d/product_files", apiPrefix, productSlug, releaseID), func(w http.ResponseWriter, req *http.Request) {
+			ghttp.RespondWithJSONEncoded(http.StatusOK, pivnet.ProductFilesResponse{ProductFiles: productFiles})(w, req)
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+		cloudfront.Close()
+		os.RemoveAll(dir)
+	})
+
+	expectDownloaded := func(names ...string) {
+		files, err := ioutil.ReadDir(dir)
+		Expect(err).NotTo(HaveOccurred())
+
+		var downloaded []string
+		for _, f := range files {
+			downloaded = append(downloaded, f.Name())
+
+			content, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal(contents[f.Name()]))
+		}
+		Expect(downloaded).To(ConsistOf(names))
+	}
+
+	It("downloads every product file of the release into the directory", func() {
+		report, err := client.ProductFiles.DownloadRelease(dir, productSlug, releaseID, pivnet.DownloadReleaseConfig{Concurrency: 2}, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(report.Results).To(HaveLen(3))
+		for _, result := range report.Results {
+			Expect(result.Err).NotTo(HaveOccurred())
+			Expect(result.Path).To(Equal(filepath.Join(dir, filepath.Base(result.ProductFile.AWSObjectKey))))
+		}
+		Expect(report.Failed()).To(BeEmpty())
+
+		expectDownloaded("tile.pivotal", "stemcell.tgz", "notes.pdf")
+	})
+
+	It("only downloads the files of the given types whose names match the glob", func() {
+		report, err := client.ProductFiles.DownloadRelease(dir, productSlug, releaseID, pivnet.DownloadReleaseConfig{
+			FileTypes: []string{pivnet.FileTypeSoftware},
+			Glob:      "*.pivotal",
+		}, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(report.Results).To(HaveLen(1))
+		Expect(report.Results[0].ProductFile.ID).To(Equal(1))
+
+		expectDownloaded("tile.pivotal")
+	})
+
+	It("only downloads the files in the given file groups", func() {
+		server.RouteToHandler("GET", fmt.Sprintf("%s/products/%s/releases/%d/file_groups", apiPrefix, productSlug, releaseID),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, pivnet.FileGroupsResponse{FileGroups: []pivnet.FileGroup{
+				{ID: 10, Name: "Stemcells", ProductFiles: []pivnet.ProductFile{productFiles[1]}},
+				{ID: 11, Name: "Docs", ProductFiles: []pivnet.ProductFile{productFiles[2]}},
+			}}),
+		)
+
+		_, err := client.ProductFiles.DownloadRelease(dir, productSlug, releaseID, pivnet.DownloadReleaseConfig{
+			FileGroups: []string{"Stemcells"},
+		}, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectDownloaded("stemcell.tgz")
+	})
+
+	It("reports the files that fail checksum verification", func() {
+		productFiles[2].SHA256 = "not-the-sha256"
+
+		report, err := client.ProductFiles.DownloadRelease(dir, productSlug, releaseID, pivnet.DownloadReleaseConfig{}, GinkgoWriter)
+		Expect(err).To(MatchError(ContainSubstring("failed to download 1 of 3 product files")))
+
+		failed := report.Failed()
+		Expect(failed).To(HaveLen(1))
+		Expect(failed[0].ProductFile.ID).To(Equal(3))
+
+		var mismatch download.ErrChecksumMismatch
+		Expect(errors.As(failed[0].Err, &mismatch)).To(BeTrue())
+		Expect(failed[0].Error).To(Equal(failed[0].Err.Error()))
+
+		encoded, err := json.Marshal(report)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(encoded)).To(ContainSubstring(fmt.Sprintf(`"error":%q`, failed[0].Error)))
+		Expect(strings.Count(string(encoded), `"error"`)).To(Equal(1))
+
+		Expect(filepath.Join(dir, "tile.pivotal")).To(BeAnExistingFile())
+		Expect(filepath.Join(dir, "stemcell.tgz")).To(BeAnExistingFile())
+	})
+
+	It("prefixes the product file ID to names that more than one file would get", func() {
+		productFiles[1].AWSObjectKey = "product-files/banana/older/tile.pivotal"
+		productFiles[1].SHA256 = productFiles[0].SHA256
+
+		report, err := client.ProductFiles.DownloadRelease(dir, productSlug, releaseID, pivnet.DownloadReleaseConfig{}, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(report.Results[0].Path).To(Equal(filepath.Join(dir, "1-tile.pivotal")))
+		Expect(report.Results[1].Path).To(Equal(filepath.Join(dir, "2-tile.pivotal")))
+		Expect(report.Results[2].Path).To(Equal(filepath.Join(dir, "notes.pdf")))
+
+		for _, name := range []string{"1-tile.pivotal", "2-tile.pivotal"} {
+			content, err := ioutil.ReadFile(filepath.Join(dir, name))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal(contents["tile.pivotal"]))
+		}
+	})
+
+	It("does not write files outside the directory", func() {
+		productFiles[2].AWSObjectKey = ""
+		productFiles[2].Name = "../../notes.pdf"
+
+		report, err := client.ProductFiles.DownloadRelease(dir, productSlug, releaseID, pivnet.DownloadReleaseConfig{}, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(report.Results[2].Path).To(Equal(filepath.Join(dir, "notes.pdf")))
+		expectDownloaded("tile.pivotal", "stemcell.tgz", "notes.pdf")
+	})
+
+	It("reports the progress of the release as a whole", func() {
+		var (
+			m      sync.Mutex
+			events []download.ProgressEvent
+		)
+		client = pivnet.NewClient(&gopivnetfakes.FakeAccessTokenService{}, pivnet.ClientConfig{
+			Host: server.URL(),
+			ProgressReporter: download.ProgressFunc(func(e download.ProgressEvent) {
+				m.Lock()
+				events = append(events, e)
+				m.Unlock()
+			}),
+		}, &loggerfakes.FakeLogger{})
+
+		_, err := client.ProductFiles.DownloadRelease(dir, productSlug, releaseID, pivnet.DownloadReleaseConfig{Concurrency: 3}, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		total := 0
+		for _, content := range contents {
+			total += len(content)
+		}
+
+		var started, finished []download.ProgressEvent
+		var added int64
+		for _, e := range events {
+			switch e.Type {
+			case download.ProgressStarted:
+				started = append(started, e)
+			case download.ProgressFinished:
+				finished = append(finished, e)
+			case download.ProgressAdded:
+				added += e.Bytes
+			}
+		}
+
+		Expect(started).To(HaveLen(1))
+		Expect(started[0].ContentLength).To(Equal(int64(total)))
+		Expect(events[0].Type).To(Equal(download.ProgressStarted))
+		Expect(finished).To(HaveLen(1))
+		Expect(finished[0].Err).NotTo(HaveOccurred())
+		Expect(events[len(events)-1].Type).To(Equal(download.ProgressFinished))
+		Expect(added).To(Equal(int64(total)))
+	})
+
+	It("does not start files once the context is cancelled", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		cloudfront.RouteToHandler("GET", "/tile.pivotal", func(w http.ResponseWriter, req *http.Request) {
+			cancel()
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+
+		report, err := client.ProductFiles.DownloadReleaseContext(ctx, dir, productSlug, releaseID, pivnet.DownloadReleaseConfig{Concurrency: 1}, GinkgoWriter)
+		Expect(err).To(MatchError(ContainSubstring("failed to download 3 of 3 product files")))
+
+		Expect(report.Results).To(HaveLen(3))
+		for _, result := range report.Results[1:] {
+			Expect(result.Err).To(Equal(context.Canceled))
+			Expect(result.Error).To(Equal(context.Canceled.Error()))
+			Expect(result.Path).To(Equal(filepath.Join(dir, filepath.Base(result.ProductFile.AWSObjectKey))))
+		}
+
+		for _, req := range cloudfront.ReceivedRequests() {
+			Expect(req.URL.Path).To(Equal("/tile.pivotal"))
+		}
+	})
+
+	It("returns an error for an invalid glob", func() {
+		_, err := client.ProductFiles.DownloadRelease(dir, productSlug, releaseID, pivnet.DownloadReleaseConfig{Glob: "["}, GinkgoWriter)
+		Expect(err).To(MatchError(ContainSubstring("invalid glob")))
+	})
+})
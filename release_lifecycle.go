@@ -0,0 +1,182 @@
+package pivnet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// LifecycleStatus is where a release is in its support lifecycle.
+type LifecycleStatus string
+
+const (
+	LifecycleSupported    LifecycleStatus = "Supported"
+	LifecycleGuidanceOnly LifecycleStatus = "Guidance-only"
+	LifecycleEndOfSupport LifecycleStatus = "End of support"
+	LifecycleUnavailable  LifecycleStatus = "Unavailable"
+)
+
+const dateFormat = "2006-01-02"
+
+// ReleaseDates are the dates of a release parsed into times. Dates that are
+// not set are zero. Dates without a time of day are midnight UTC.
+type ReleaseDates struct {
+	ReleaseDate            time.Time
+	EndOfSupportDate       time.Time
+	EndOfGuidanceDate      time.Time
+	EndOfAvailabilityDate  time.Time
+	UpdatedAt              time.Time
+	SoftwareFilesUpdatedAt time.Time
+	UserGroupsUpdatedAt    time.Time
+}
+
+// Dates parses the release's dates. It returns an error naming the first
+// date that is neither empty nor in a format Pivnet uses.
+func (r Release) Dates() (ReleaseDates, error) {
+	var dates ReleaseDates
+
+	err := r.parseDates([]releaseDate{
+		{"release date", r.ReleaseDate, &dates.ReleaseDate},
+		{"end of support date", r.EndOfSupportDate, &dates.EndOfSupportDate},
+		{"end of guidance date", r.EndOfGuidanceDate, &dates.EndOfGuidanceDate},
+		{"end of availability date", r.EndOfAvailabilityDate, &dates.EndOfAvailabilityDate},
+		{"updated at", r.UpdatedAt, &dates.UpdatedAt},
+		{"software files updated at", r.SoftwareFilesUpdatedAt, &dates.SoftwareFilesUpdatedAt},
+		{"user groups updated at", r.UserGroupsUpdatedAt, &dates.UserGroupsUpdatedAt},
+	})
+	if err != nil {
+		return ReleaseDates{}, err
+	}
+
+	return dates, nil
+}
+
+type releaseDate struct {
+	name  string
+	value string
+	time  *time.Time
+}
+
+func (r Release) parseDates(dates []releaseDate) error {
+	for _, d := range dates {
+		t, err := parseDate(d.value)
+		if err != nil {
+			return fmt.Errorf("release %d has an invalid %s: %w", r.ID, d.name, err)
+		}
+		*d.time = t
+	}
+	return nil
+}
+
+// LifecycleStatus returns the release's status at now. The end of support,
+// guidance and availability dates are the last days of each phase, so a
+// release whose end of support date is today is still supported. Only those
+// three dates are parsed.
+//
+// Once support ends a release is LifecycleGuidanceOnly until its end of
+// guidance date, or LifecycleEndOfSupport if it has none, and it is
+// LifecycleUnavailable after its end of availability date.
+func (r Release) LifecycleStatus(now time.Time) (LifecycleStatus, error) {
+	var dates ReleaseDates
+
+	err := r.parseDates([]releaseDate{
+		{"end of support date", r.EndOfSupportDate, &dates.EndOfSupportDate},
+		{"end of guidance date", r.EndOfGuidanceDate, &dates.EndOfGuidanceDate},
+		{"end of availability date", r.EndOfAvailabilityDate, &dates.EndOfAvailabilityDate},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case ended(dates.EndOfAvailabilityDate, now):
+		return LifecycleUnavailable, nil
+	case !ended(dates.EndOfSupportDate, now):
+		return LifecycleSupported, nil
+	case !dates.EndOfGuidanceDate.IsZero() && !ended(dates.EndOfGuidanceDate, now):
+		return LifecycleGuidanceOnly, nil
+	}
+
+	return LifecycleEndOfSupport, nil
+}
+
+// ListReachingEndOfSupport returns the product's releases whose end of
+// support date is between the UTC dates of from and to, inclusive, ordered
+// by that date. Only end of support dates are parsed; an invalid one is
+// returned as an error naming its release.
+func (r ReleasesService) ListReachingEndOfSupport(productSlug string, from time.Time, to time.Time) ([]Release, error) {
+	return r.ListReachingEndOfSupportContext(context.Background(), productSlug, from, to)
+}
+
+func (r ReleasesService) ListReachingEndOfSupportContext(ctx context.Context, productSlug string, from time.Time, to time.Time) ([]Release, error) {
+	releases, err := r.ListAllContext(ctx, productSlug, ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	fromDate, toDate := truncateToDate(from), truncateToDate(to)
+
+	type endingRelease struct {
+		release      Release
+		endOfSupport time.Time
+	}
+
+	var ending []endingRelease
+	for _, release := range releases {
+		// only the end of support date is parsed, so that other dates this
+		// query does not need cannot fail it
+		endOfSupport, err := parseDate(release.EndOfSupportDate)
+		if err != nil {
+			return nil, fmt.Errorf("release %d has an invalid end of support date: %w", release.ID, err)
+		}
+
+		if endOfSupport.IsZero() {
+			continue
+		}
+
+		eos := truncateToDate(endOfSupport)
+		if eos.Before(fromDate) || eos.After(toDate) {
+			continue
+		}
+
+		ending = append(ending, endingRelease{release, eos})
+	}
+
+	sort.SliceStable(ending, func(i, j int) bool {
+		return ending[i].endOfSupport.Before(ending[j].endOfSupport)
+	})
+
+	result := make([]Release, len(ending))
+	for i, e := range ending {
+		result[i] = e.release
+	}
+
+	return result, nil
+}
+
+func parseDate(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.Parse(dateFormat, s); err == nil {
+		return t, nil
+	}
+
+	return time.Parse(time.RFC3339, s)
+}
+
+// ended reports whether now is after the day of the date. A zero date never
+// ends.
+func ended(date time.Time, now time.Time) bool {
+	if date.IsZero() {
+		return false
+	}
+	return !now.Before(truncateToDate(date).AddDate(0, 0, 1))
+}
+
+func truncateToDate(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,159 @@
+package pivnet_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/pivotal-cf/go-pivnet/v9"
+	"github.com/pivotal-cf/go-pivnet/v9/go-pivnetfakes"
+	"github.com/pivotal-cf/go-pivnet/v9/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Release lifecycle", func() {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	Describe("Dates", func() {
+		It("parses the dates, leaving empty ones zero", func() {
+			release := pivnet.Release{
+				ReleaseDate:      "2023-01-15",
+				EndOfSupportDate: "2024-03-31",
+				UpdatedAt:        "2023-08-31T20:42:14.913Z",
+			}
+
+			dates, err := release.Dates()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(dates.ReleaseDate).To(Equal(date(2023, time.January, 15)))
+			Expect(dates.EndOfSupportDate).To(Equal(date(2024, time.March, 31)))
+			Expect(dates.UpdatedAt.Equal(time.Date(2023, time.August, 31, 20, 42, 14, 913000000, time.UTC))).To(BeTrue())
+			Expect(dates.EndOfGuidanceDate.IsZero()).To(BeTrue())
+			Expect(dates.SoftwareFilesUpdatedAt.IsZero()).To(BeTrue())
+		})
+
+		It("returns an error naming the invalid date", func() {
+			release := pivnet.Release{ID: 12, EndOfGuidanceDate: "31/03/2024"}
+
+			_, err := release.Dates()
+			Expect(err).To(MatchError(ContainSubstring("release 12 has an invalid end of guidance date")))
+		})
+	})
+
+	Describe("LifecycleStatus", func() {
+		release := pivnet.Release{
+			EndOfSupportDate:      "2024-03-31",
+			EndOfGuidanceDate:     "2024-09-30",
+			EndOfAvailabilityDate: "2025-03-31",
+		}
+
+		It("follows the release through its lifecycle", func() {
+			for _, c := range []struct {
+				now    time.Time
+				status pivnet.LifecycleStatus
+			}{
+				{date(2023, time.June, 1), pivnet.LifecycleSupported},
+				{time.Date(2024, time.March, 31, 23, 59, 0, 0, time.UTC), pivnet.LifecycleSupported},
+				{date(2024, time.April, 1), pivnet.LifecycleGuidanceOnly},
+				{date(2024, time.October, 1), pivnet.LifecycleEndOfSupport},
+				{date(2025, time.April, 1), pivnet.LifecycleUnavailable},
+			} {
+				status, err := release.LifecycleStatus(c.now)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(c.status), "at %s", c.now)
+			}
+		})
+
+		It("goes straight to end of support without an end of guidance date", func() {
+			withoutGuidance := release
+			withoutGuidance.EndOfGuidanceDate = ""
+
+			status, err := withoutGuidance.LifecycleStatus(date(2024, time.April, 1))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(Equal(pivnet.LifecycleEndOfSupport))
+		})
+
+		It("is supported without any dates", func() {
+			status, err := pivnet.Release{}.LifecycleStatus(time.Now())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(Equal(pivnet.LifecycleSupported))
+		})
+
+		It("returns an error for an invalid date", func() {
+			_, err := pivnet.Release{EndOfSupportDate: "soon"}.LifecycleStatus(time.Now())
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("ignores invalid dates that are not lifecycle dates", func() {
+			withBadTimestamps := release
+			withBadTimestamps.UpdatedAt = "garbage"
+			withBadTimestamps.SoftwareFilesUpdatedAt = "garbage"
+			withBadTimestamps.UserGroupsUpdatedAt = "garbage"
+
+			status, err := withBadTimestamps.LifecycleStatus(date(2024, time.April, 1))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(Equal(pivnet.LifecycleGuidanceOnly))
+		})
+	})
+
+	Describe("ListReachingEndOfSupport", func() {
+		var (
+			server *ghttp.Server
+			client pivnet.Client
+
+			releases []pivnet.Release
+		)
+
+		BeforeEach(func() {
+			server = ghttp.NewServer()
+			client = pivnet.NewClient(&gopivnetfakes.FakeAccessTokenService{}, pivnet.ClientConfig{Host: server.URL()}, &loggerfakes.FakeLogger{})
+
+			releases = []pivnet.Release{
+				{ID: 1, Version: "1.0.0", EndOfSupportDate: "2024-06-30"},
+				{ID: 2, Version: "1.1.0", EndOfSupportDate: "2024-03-31"},
+				{ID: 3, Version: "1.2.0", EndOfSupportDate: "2024-12-31"},
+				{ID: 4, Version: "1.3.0"},
+				{ID: 5, Version: "0.9.0", EndOfSupportDate: "2024-02-29"},
+			}
+
+			server.RouteToHandler("GET", apiPrefix+"/products/banana/releases", func(w http.ResponseWriter, req *http.Request) {
+				ghttp.RespondWithJSONEncoded(http.StatusOK, pivnet.ReleasesResponse{Releases: releases})(w, req)
+			})
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("returns the releases whose end of support date is in the window, soonest first", func() {
+			ending, err := client.Releases.ListReachingEndOfSupport("banana", date(2024, time.March, 31), time.Date(2024, time.June, 30, 12, 0, 0, 0, time.UTC))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ending).To(HaveLen(2))
+			Expect(ending[0].ID).To(Equal(2))
+			Expect(ending[1].ID).To(Equal(1))
+		})
+
+		It("ignores invalid dates other than the end of support date", func() {
+			releases[0].UpdatedAt = "yesterday"
+			releases[1].SoftwareFilesUpdatedAt = "last week"
+			releases[2].UserGroupsUpdatedAt = "never"
+
+			ending, err := client.Releases.ListReachingEndOfSupport("banana", date(2024, time.March, 31), date(2024, time.June, 30))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ending).To(HaveLen(2))
+		})
+
+		It("returns an error when a release has an invalid end of support date", func() {
+			releases[0].EndOfSupportDate = "June"
+
+			_, err := client.Releases.ListReachingEndOfSupport("banana", date(2024, time.January, 1), date(2025, time.January, 1))
+			Expect(err).To(MatchError(ContainSubstring("release 1 has an invalid end of support date")))
+		})
+	})
+})
@@ -0,0 +1,160 @@
+package pivnet
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+var availabilityOrder = []Availability{
+	AvailabilityAdminsOnly,
+	AvailabilitySelectedUserGroupsOnly,
+	AvailabilityAllUsers,
+}
+
+// PromoteReleaseOptions are the user groups to give access to a promoted
+// release and whether to check it is ready first.
+type PromoteReleaseOptions struct {
+	// User groups to add to the release (optional). Promoting to
+	// AvailabilitySelectedUserGroupsOnly needs at least one user group,
+	// either here or already on the release.
+	UserGroupIDs []int
+
+	// Promote the release even if it fails the pre-flight checks (optional)
+	SkipPreflightChecks bool
+}
+
+// ErrPreflightFailed is returned by Promote when a release is not ready to
+// be made available. Problems lists every check that failed.
+type ErrPreflightFailed struct {
+	ReleaseID int      `json:"release_id" yaml:"release_id"`
+	Problems  []string `json:"problems" yaml:"problems"`
+}
+
+func (e ErrPreflightFailed) Error() string {
+	return fmt.Sprintf("release %d failed pre-flight checks: %s", e.ReleaseID, strings.Join(e.Problems, "; "))
+}
+
+// Promote widens a release's availability from Admins Only, through
+// Selected User Groups Only, to All Users. It checks that every product
+// file is ready to serve and has a checksum, that a EULA is set and that
+// every artifact reference has replicated, then adds opts.UserGroupIDs to
+// the release and changes its availability. A release cannot be promoted
+// to a narrower availability than it has.
+func (r ReleasesService) Promote(productSlug string, releaseID int, target Availability, opts PromoteReleaseOptions) (Release, error) {
+	return r.PromoteContext(context.Background(), productSlug, releaseID, target, opts)
+}
+
+func (r ReleasesService) PromoteContext(ctx context.Context, productSlug string, releaseID int, target Availability, opts PromoteReleaseOptions) (Release, error) {
+	targetRank := availabilityRank(target)
+	if targetRank < 0 {
+		return Release{}, fmt.Errorf("unknown availability %q", target)
+	}
+
+	release, err := r.GetContext(ctx, productSlug, releaseID)
+	if err != nil {
+		return Release{}, err
+	}
+
+	current := Availability(release.Availability)
+	if availabilityRank(current) > targetRank {
+		return Release{}, fmt.Errorf("cannot promote release %d from %s to %s", releaseID, current, target)
+	}
+
+	userGroups, err := UserGroupsService{client: r.client}.ListForReleaseContext(ctx, productSlug, releaseID)
+	if err != nil {
+		return Release{}, fmt.Errorf("failed to list user groups of release %d: %w", releaseID, err)
+	}
+
+	if target == AvailabilitySelectedUserGroupsOnly && len(userGroups) == 0 && len(opts.UserGroupIDs) == 0 {
+		return Release{}, fmt.Errorf("release %d needs at least one user group to be %s", releaseID, target)
+	}
+
+	if !opts.SkipPreflightChecks {
+		problems, err := r.preflightProblems(ctx, productSlug, release)
+		if err != nil {
+			return Release{}, err
+		}
+		if len(problems) > 0 {
+			return Release{}, ErrPreflightFailed{ReleaseID: releaseID, Problems: problems}
+		}
+	}
+
+	attached := map[int]bool{}
+	for _, ug := range userGroups {
+		attached[ug.ID] = true
+	}
+
+	for _, id := range opts.UserGroupIDs {
+		if attached[id] {
+			continue
+		}
+
+		err := UserGroupsService{client: r.client}.AddToReleaseContext(ctx, productSlug, releaseID, id)
+		if err != nil {
+			return Release{}, fmt.Errorf("failed to add user group %d to release %d: %w", id, releaseID, err)
+		}
+		attached[id] = true
+	}
+
+	if current == target {
+		return release, nil
+	}
+
+	return r.UpdateContext(ctx, productSlug, Release{ID: releaseID, Availability: string(target)})
+}
+
+func (r ReleasesService) preflightProblems(ctx context.Context, productSlug string, release Release) ([]string, error) {
+	var problems []string
+
+	if release.EULA == nil || release.EULA.Slug == "" {
+		problems = append(problems, "no EULA is set")
+	}
+
+	productFiles, err := ProductFilesService{client: r.client}.ListForReleaseContext(ctx, productSlug, release.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list product files of release %d: %w", release.ID, err)
+	}
+
+	for _, pf := range productFiles {
+		// listings may leave out checksums
+		if pf.SHA256 == "" && pf.MD5 == "" {
+			full, err := ProductFilesService{client: r.client}.GetForReleaseContext(ctx, productSlug, release.ID, pf.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get product file %d of release %d: %w", pf.ID, release.ID, err)
+			}
+			pf = full
+		}
+
+		if !pf.ReadyToServe {
+			problems = append(problems, fmt.Sprintf("product file %d (%s) is not ready to serve", pf.ID, pf.Name))
+		}
+		if pf.SHA256 == "" && pf.MD5 == "" {
+			problems = append(problems, fmt.Sprintf("product file %d (%s) has no checksum", pf.ID, pf.Name))
+		}
+	}
+
+	artifactReferences, err := ArtifactReferencesService{client: r.client}.ListForReleaseContext(ctx, productSlug, release.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list artifact references of release %d: %w", release.ID, err)
+	}
+
+	for _, ar := range artifactReferences {
+		if ar.ReplicationStatus != Complete {
+			problems = append(problems, fmt.Sprintf("artifact reference %d (%s) has replication status %s", ar.ID, ar.Name, ar.ReplicationStatus))
+		}
+	}
+
+	return problems, nil
+}
+
+// availabilityRank is the position of the availability in the promotion
+// order, or -1 if it is not one of the known availabilities.
+func availabilityRank(availability Availability) int {
+	for i, a := range availabilityOrder {
+		if a == availability {
+			return i
+		}
+	}
+	return -1
+}
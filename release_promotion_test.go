@@ -0,0 +1,147 @@
+package pivnet_test
+
+import (
+	"errors"
+
+	"github.com/pivotal-cf/go-pivnet/v9"
+	"github.com/pivotal-cf/go-pivnet/v9/logger/loggerfakes"
+	"github.com/pivotal-cf/go-pivnet/v9/pivnettest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PivnetClient - release promotion", func() {
+	var (
+		server    *pivnettest.Server
+		client    pivnet.Client
+		product   pivnet.Product
+		release   pivnet.Release
+		userGroup pivnet.UserGroup
+	)
+
+	BeforeEach(func() {
+		server = pivnettest.NewServer()
+
+		product = server.AddProduct(pivnet.Product{Slug: "some-product"})
+		eula := server.AddEULA(pivnet.EULA{Slug: "some-eula"})
+		release = server.AddRelease(product.Slug, pivnet.Release{
+			Version:      "1.0.0",
+			Availability: string(pivnet.AvailabilityAdminsOnly),
+			EULA:         &eula,
+		})
+		server.AddProductFile(product.Slug, release.ID, pivnet.ProductFile{
+			Name:         "Some Tile",
+			AWSObjectKey: "product-files/some-product/tile.pivotal",
+			ReadyToServe: true,
+		}, []byte("tile"))
+		userGroup = server.AddUserGroup(pivnet.UserGroup{Name: "Early Access"})
+
+		token := pivnet.NewAccessTokenOrLegacyToken("some-refresh-token-longer-than-a-legacy-token", server.URL(), false)
+		client = pivnet.NewClient(token, pivnet.ClientConfig{Host: server.URL()}, &loggerfakes.FakeLogger{})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("makes the release available to the given user groups", func() {
+		promoted, err := client.Releases.Promote(product.Slug, release.ID, pivnet.AvailabilitySelectedUserGroupsOnly, pivnet.PromoteReleaseOptions{
+			UserGroupIDs: []int{userGroup.ID},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(promoted.Availability).To(Equal(string(pivnet.AvailabilitySelectedUserGroupsOnly)))
+		Expect(promoted.Version).To(Equal("1.0.0"))
+
+		userGroups, err := client.UserGroups.ListForRelease(product.Slug, release.ID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(userGroups).To(HaveLen(1))
+		Expect(userGroups[0].ID).To(Equal(userGroup.ID))
+
+		promoted, err = client.Releases.Promote(product.Slug, release.ID, pivnet.AvailabilityAllUsers, pivnet.PromoteReleaseOptions{
+			UserGroupIDs: []int{userGroup.ID},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(promoted.Availability).To(Equal(string(pivnet.AvailabilityAllUsers)))
+	})
+
+	It("gets the checksums of product files that listings leave them out of", func() {
+		server.OmitChecksumsFromListings(true)
+
+		promoted, err := client.Releases.Promote(product.Slug, release.ID, pivnet.AvailabilityAllUsers, pivnet.PromoteReleaseOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(promoted.Availability).To(Equal(string(pivnet.AvailabilityAllUsers)))
+	})
+
+	It("needs a user group to make the release available to selected user groups", func() {
+		_, err := client.Releases.Promote(product.Slug, release.ID, pivnet.AvailabilitySelectedUserGroupsOnly, pivnet.PromoteReleaseOptions{})
+		Expect(err).To(MatchError(ContainSubstring("needs at least one user group")))
+	})
+
+	It("does not demote the release", func() {
+		_, err := client.Releases.Promote(product.Slug, release.ID, pivnet.AvailabilityAllUsers, pivnet.PromoteReleaseOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = client.Releases.Promote(product.Slug, release.ID, pivnet.AvailabilityAdminsOnly, pivnet.PromoteReleaseOptions{})
+		Expect(err).To(MatchError(ContainSubstring("cannot promote release")))
+	})
+
+	It("rejects an unknown availability", func() {
+		_, err := client.Releases.Promote(product.Slug, release.ID, "Everyone", pivnet.PromoteReleaseOptions{})
+		Expect(err).To(MatchError(`unknown availability "Everyone"`))
+	})
+
+	Context("when the release is not ready", func() {
+		BeforeEach(func() {
+			release = server.AddRelease(product.Slug, pivnet.Release{
+				Version:      "1.0.1",
+				Availability: string(pivnet.AvailabilityAdminsOnly),
+			})
+
+			server.AddProductFile(product.Slug, release.ID, pivnet.ProductFile{
+				Name:         "Some Notes",
+				AWSObjectKey: "product-files/some-product/notes.pdf",
+			}, nil)
+
+			ar := server.AddArtifactReference(product.Slug, pivnet.ArtifactReference{
+				Name:              "Some Image",
+				ArtifactPath:      "some/image",
+				Digest:            "sha256:abc",
+				ReplicationStatus: pivnet.InProgress,
+			})
+			Expect(client.ArtifactReferences.AddToRelease(product.Slug, release.ID, ar.ID)).To(Succeed())
+		})
+
+		It("returns every failed pre-flight check without changing the release", func() {
+			_, err := client.Releases.Promote(product.Slug, release.ID, pivnet.AvailabilityAllUsers, pivnet.PromoteReleaseOptions{
+				UserGroupIDs: []int{userGroup.ID},
+			})
+
+			var preflightErr pivnet.ErrPreflightFailed
+			Expect(errors.As(err, &preflightErr)).To(BeTrue())
+			Expect(preflightErr.ReleaseID).To(Equal(release.ID))
+			Expect(preflightErr.Problems).To(ConsistOf(
+				"no EULA is set",
+				ContainSubstring("(Some Notes) is not ready to serve"),
+				ContainSubstring("(Some Notes) has no checksum"),
+				ContainSubstring("(Some Image) has replication status in_progress"),
+			))
+
+			unchanged, err := client.Releases.Get(product.Slug, release.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(unchanged.Availability).To(Equal(string(pivnet.AvailabilityAdminsOnly)))
+
+			userGroups, err := client.UserGroups.ListForRelease(product.Slug, release.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(userGroups).To(BeEmpty())
+		})
+
+		It("promotes the release anyway when told to skip the checks", func() {
+			promoted, err := client.Releases.Promote(product.Slug, release.ID, pivnet.AvailabilityAllUsers, pivnet.PromoteReleaseOptions{
+				SkipPreflightChecks: true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(promoted.Availability).To(Equal(string(pivnet.AvailabilityAllUsers)))
+		})
+	})
+})
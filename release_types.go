@@ -1,15 +1,22 @@
 package pivnet
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
-	"encoding/json"
 )
 
 type ReleaseTypesService struct {
 	client Client
 }
 
+//go:generate counterfeiter . ReleaseTypesAPI
+type ReleaseTypesAPI interface {
+	Get() ([]ReleaseType, error)
+	GetContext(ctx context.Context) ([]ReleaseType, error)
+}
+
 type ReleaseType string
 
 type ReleaseTypesResponse struct {
@@ -17,10 +24,15 @@ type ReleaseTypesResponse struct {
 }
 
 func (r ReleaseTypesService) Get() ([]ReleaseType, error) {
+	return r.GetContext(context.Background())
+}
+
+func (r ReleaseTypesService) GetContext(ctx context.Context) ([]ReleaseType, error) {
 	url := fmt.Sprintf("/releases/release_types")
 
 	var response ReleaseTypesResponse
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -2,6 +2,7 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -11,6 +12,16 @@ type ReleaseUpgradePathsService struct {
 	client Client
 }
 
+//go:generate counterfeiter . ReleaseUpgradePathsAPI
+type ReleaseUpgradePathsAPI interface {
+	Get(productSlug string, releaseID int) ([]ReleaseUpgradePath, error)
+	GetContext(ctx context.Context, productSlug string, releaseID int) ([]ReleaseUpgradePath, error)
+	Add(productSlug string, releaseID int, previousReleaseID int) error
+	AddContext(ctx context.Context, productSlug string, releaseID int, previousReleaseID int) error
+	Remove(productSlug string, releaseID int, previousReleaseID int) error
+	RemoveContext(ctx context.Context, productSlug string, releaseID int, previousReleaseID int) error
+}
+
 type ReleaseUpgradePathsResponse struct {
 	ReleaseUpgradePaths []ReleaseUpgradePath `json:"upgrade_paths,omitempty"`
 }
@@ -25,6 +36,10 @@ type UpgradePathRelease struct {
 }
 
 func (r ReleaseUpgradePathsService) Get(productSlug string, releaseID int) ([]ReleaseUpgradePath, error) {
+	return r.GetContext(context.Background(), productSlug, releaseID)
+}
+
+func (r ReleaseUpgradePathsService) GetContext(ctx context.Context, productSlug string, releaseID int) ([]ReleaseUpgradePath, error) {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/upgrade_paths",
 		productSlug,
@@ -32,7 +47,8 @@ func (r ReleaseUpgradePathsService) Get(productSlug string, releaseID int) ([]Re
 	)
 
 	var response ReleaseUpgradePathsResponse
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -55,6 +71,15 @@ func (r ReleaseUpgradePathsService) Add(
 	productSlug string,
 	releaseID int,
 	previousReleaseID int,
+) error {
+	return r.AddContext(context.Background(), productSlug, releaseID, previousReleaseID)
+}
+
+func (r ReleaseUpgradePathsService) AddContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	previousReleaseID int,
 ) error {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/add_upgrade_path",
@@ -75,7 +100,8 @@ func (r ReleaseUpgradePathsService) Add(
 		return err
 	}
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -93,6 +119,15 @@ func (r ReleaseUpgradePathsService) Remove(
 	productSlug string,
 	releaseID int,
 	previousReleaseID int,
+) error {
+	return r.RemoveContext(context.Background(), productSlug, releaseID, previousReleaseID)
+}
+
+func (r ReleaseUpgradePathsService) RemoveContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	previousReleaseID int,
 ) error {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/remove_upgrade_path",
@@ -113,7 +148,8 @@ func (r ReleaseUpgradePathsService) Remove(
 		return err
 	}
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -0,0 +1,106 @@
+package pivnet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pivotal-cf/go-pivnet/v9/semver"
+)
+
+// GetByVersion returns the product's release with the given version. An
+// exact match of the version string is preferred; otherwise a release whose
+// version is semantically the same, e.g. "v2.13.0" for "2.13", is returned.
+func (r ReleasesService) GetByVersion(productSlug string, version string) (Release, error) {
+	return r.GetByVersionContext(context.Background(), productSlug, version)
+}
+
+func (r ReleasesService) GetByVersionContext(ctx context.Context, productSlug string, version string) (Release, error) {
+	releases, err := r.ListAllContext(ctx, productSlug, ListOptions{})
+	if err != nil {
+		return Release{}, err
+	}
+
+	for _, release := range releases {
+		if release.Version == version {
+			return release, nil
+		}
+	}
+
+	if want, err := semver.Parse(version); err == nil {
+		for _, release := range releases {
+			v, err := semver.Parse(release.Version)
+			if err == nil && v.Equal(want) && v.Build == want.Build {
+				return release, nil
+			}
+		}
+	}
+
+	return Release{}, newErrNotFound(fmt.Sprintf("release with version %s not found for product %s", version, productSlug))
+}
+
+// LatestMatching returns the product's highest release whose version
+// satisfies the constraint, such as "~2.13", ">=1.4 <2" or "2.13.*". See
+// semver.Constraint for the syntax. Releases whose versions are not
+// semantic versions are ignored.
+func (r ReleasesService) LatestMatching(productSlug string, constraint string) (Release, error) {
+	return r.LatestMatchingContext(context.Background(), productSlug, constraint)
+}
+
+func (r ReleasesService) LatestMatchingContext(ctx context.Context, productSlug string, constraint string) (Release, error) {
+	c, err := semver.ParseConstraint(constraint)
+	if err != nil {
+		return Release{}, err
+	}
+
+	releases, err := r.ListAllContext(ctx, productSlug, ListOptions{})
+	if err != nil {
+		return Release{}, err
+	}
+
+	var matching []Release
+	for _, release := range releases {
+		v, err := semver.Parse(release.Version)
+		if err == nil && c.Check(v) {
+			matching = append(matching, release)
+		}
+	}
+
+	if len(matching) == 0 {
+		return Release{}, newErrNotFound(fmt.Sprintf("no release matching %s found for product %s", constraint, productSlug))
+	}
+
+	SortReleasesByVersion(matching)
+	return matching[len(matching)-1], nil
+}
+
+// SortReleasesByVersion sorts releases by semantic version, lowest first.
+// Pre-releases come before the release they precede, and versions that
+// differ only in build metadata are ordered by it. Releases whose versions
+// are not semantic versions come first, in string order.
+func SortReleasesByVersion(releases []Release) {
+	versions := make(map[string]*semver.Version, len(releases))
+	for _, release := range releases {
+		if v, err := semver.Parse(release.Version); err == nil {
+			versions[release.Version] = &v
+		}
+	}
+
+	sort.SliceStable(releases, func(i, j int) bool {
+		a, b := versions[releases[i].Version], versions[releases[j].Version]
+
+		switch {
+		case a == nil && b == nil:
+			return releases[i].Version < releases[j].Version
+		case a == nil:
+			return true
+		case b == nil:
+			return false
+		}
+
+		if c := a.Compare(*b); c != 0 {
+			return c < 0
+		}
+		return a.CompareBuild(*b) < 0
+	})
+}
@@ -0,0 +1,149 @@
+package pivnet_test
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/pivotal-cf/go-pivnet/v9"
+	"github.com/pivotal-cf/go-pivnet/v9/go-pivnetfakes"
+	"github.com/pivotal-cf/go-pivnet/v9/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PivnetClient - release versions", func() {
+	var (
+		server *ghttp.Server
+		client pivnet.Client
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		client = pivnet.NewClient(&gopivnetfakes.FakeAccessTokenService{}, pivnet.ClientConfig{Host: server.URL()}, &loggerfakes.FakeLogger{})
+
+		server.RouteToHandler("GET", apiPrefix+"/products/banana/releases",
+			ghttp.RespondWithJSONEncoded(http.StatusOK, pivnet.ReleasesResponse{Releases: []pivnet.Release{
+				{ID: 1, Version: "2.12.9"},
+				{ID: 2, Version: "2.13.2"},
+				{ID: 3, Version: "2.13.10"},
+				{ID: 4, Version: "2.14.0-rc.1"},
+				{ID: 5, Version: "v3.0"},
+				{ID: 6, Version: "3.0.0 (Beta)"},
+				{ID: 7, Version: "1.4.0+build.9"},
+				{ID: 8, Version: "1.4.0+build.10"},
+			}}),
+		)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("GetByVersion", func() {
+		It("returns the release with exactly that version", func() {
+			release, err := client.Releases.GetByVersion("banana", "3.0.0 (Beta)")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(release.ID).To(Equal(6))
+		})
+
+		It("falls back to a semantically equal version", func() {
+			release, err := client.Releases.GetByVersion("banana", "3.0.0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(release.ID).To(Equal(5))
+		})
+
+		It("does not ignore build metadata", func() {
+			release, err := client.Releases.GetByVersion("banana", "v1.4+build.10")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(release.ID).To(Equal(8))
+		})
+
+		It("returns ErrNotFound when no release has the version", func() {
+			_, err := client.Releases.GetByVersion("banana", "2.13.3")
+			Expect(err).To(MatchError(pivnet.ErrNotFound{
+				ResponseCode: http.StatusNotFound,
+				Message:      "release with version 2.13.3 not found for product banana",
+			}))
+		})
+	})
+
+	Describe("LatestMatching", func() {
+		It("returns the highest release satisfying the constraint", func() {
+			release, err := client.Releases.LatestMatching("banana", "~2.13")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(release.ID).To(Equal(3))
+
+			release, err = client.Releases.LatestMatching("banana", "2.13.*")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(release.ID).To(Equal(3))
+
+			release, err = client.Releases.LatestMatching("banana", ">=1.4 <3")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(release.ID).To(Equal(3))
+
+			release, err = client.Releases.LatestMatching("banana", "*")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(release.ID).To(Equal(5))
+		})
+
+		It("only matches pre-releases when the constraint names one", func() {
+			release, err := client.Releases.LatestMatching("banana", ">=2.14.0-rc.0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(release.ID).To(Equal(5))
+
+			release, err = client.Releases.LatestMatching("banana", ">=2.14.0-rc.0 <3")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(release.ID).To(Equal(4))
+		})
+
+		It("orders releases that differ in build metadata by it", func() {
+			release, err := client.Releases.LatestMatching("banana", "1.4.0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(release.ID).To(Equal(8))
+		})
+
+		It("returns ErrNotFound when no release matches", func() {
+			_, err := client.Releases.LatestMatching("banana", "^4")
+			Expect(err).To(BeAssignableToTypeOf(pivnet.ErrNotFound{}))
+		})
+
+		It("returns an error for an invalid constraint", func() {
+			_, err := client.Releases.LatestMatching("banana", ">=banana")
+			Expect(err).To(MatchError(ContainSubstring("invalid constraint")))
+		})
+	})
+
+	Describe("SortReleasesByVersion", func() {
+		It("sorts releases by semantic version, lowest first", func() {
+			releases := []pivnet.Release{
+				{Version: "2.13.10"},
+				{Version: "not-a-version"},
+				{Version: "2.13.2"},
+				{Version: "1.0.0+build.10"},
+				{Version: "2.13.10-rc.2"},
+				{Version: "1.0.0+build.9"},
+				{Version: "2.13.10-rc.10"},
+				{Version: "1.0.0"},
+			}
+
+			pivnet.SortReleasesByVersion(releases)
+
+			var versions []string
+			for _, r := range releases {
+				versions = append(versions, r.Version)
+			}
+			Expect(versions).To(Equal([]string{
+				"not-a-version",
+				"1.0.0",
+				"1.0.0+build.9",
+				"1.0.0+build.10",
+				"2.13.2",
+				"2.13.10-rc.2",
+				"2.13.10-rc.10",
+				"2.13.10",
+			}))
+		})
+	})
+})
@@ -0,0 +1,416 @@
+package pivnet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet/v9/semver"
+)
+
+const (
+	defaultWatchInterval       = 5 * time.Minute
+	defaultMaxRateLimitBackoff = 30 * time.Minute
+)
+
+type ReleaseEventType string
+
+const (
+	ReleaseCreated             ReleaseEventType = "created"
+	ReleaseVersionChanged      ReleaseEventType = "version_changed"
+	ReleaseAvailabilityChanged ReleaseEventType = "availability_changed"
+	ReleaseFilesUpdated        ReleaseEventType = "files_updated"
+	ReleaseUserGroupsUpdated   ReleaseEventType = "user_groups_updated"
+	ReleaseDeleted             ReleaseEventType = "deleted"
+)
+
+// ReleaseEvent is a change to a release seen by a ReleaseWatcher. Release is
+// the release as listed, or for ReleaseDeleted as last seen. Previous is nil
+// for ReleaseCreated.
+type ReleaseEvent struct {
+	Type        ReleaseEventType `json:"type" yaml:"type"`
+	ProductSlug string           `json:"product_slug" yaml:"product_slug"`
+	Release     Release          `json:"release" yaml:"release"`
+	Previous    *ReleaseSnapshot `json:"previous,omitempty" yaml:"previous,omitempty"`
+}
+
+// ReleaseSnapshot is what a ReleaseWatcher remembers about a release.
+type ReleaseSnapshot struct {
+	ID                     int    `json:"id" yaml:"id"`
+	Version                string `json:"version" yaml:"version"`
+	Availability           string `json:"availability,omitempty" yaml:"availability,omitempty"`
+	UpdatedAt              string `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
+	SoftwareFilesUpdatedAt string `json:"software_files_updated_at,omitempty" yaml:"software_files_updated_at,omitempty"`
+	UserGroupsUpdatedAt    string `json:"user_groups_updated_at,omitempty" yaml:"user_groups_updated_at,omitempty"`
+}
+
+func newReleaseSnapshot(release Release) ReleaseSnapshot {
+	return ReleaseSnapshot{
+		ID:                     release.ID,
+		Version:                release.Version,
+		Availability:           release.Availability,
+		UpdatedAt:              release.UpdatedAt,
+		SoftwareFilesUpdatedAt: release.SoftwareFilesUpdatedAt,
+		UserGroupsUpdatedAt:    release.UserGroupsUpdatedAt,
+	}
+}
+
+func (s ReleaseSnapshot) release() Release {
+	return Release{
+		ID:                     s.ID,
+		Version:                s.Version,
+		Availability:           s.Availability,
+		UpdatedAt:              s.UpdatedAt,
+		SoftwareFilesUpdatedAt: s.SoftwareFilesUpdatedAt,
+		UserGroupsUpdatedAt:    s.UserGroupsUpdatedAt,
+	}
+}
+
+// WatchCursor is the releases a ReleaseWatcher has seen, by product slug
+// and release ID.
+type WatchCursor struct {
+	Products map[string]map[int]ReleaseSnapshot `json:"products" yaml:"products"`
+}
+
+// WatchCursorStore persists a ReleaseWatcher's cursor so a restarted
+// watcher carries on where it stopped.
+type WatchCursorStore interface {
+	Load() (WatchCursor, error)
+	Save(WatchCursor) error
+}
+
+// FileWatchCursorStore keeps the cursor in a JSON file.
+type FileWatchCursorStore struct {
+	Path string
+}
+
+// Load returns an empty cursor if the file does not exist.
+func (s FileWatchCursorStore) Load() (WatchCursor, error) {
+	b, err := ioutil.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return WatchCursor{}, nil
+	}
+	if err != nil {
+		return WatchCursor{}, err
+	}
+
+	var cursor WatchCursor
+	if err := json.Unmarshal(b, &cursor); err != nil {
+		return WatchCursor{}, fmt.Errorf("failed to decode watch cursor %s: %w", s.Path, err)
+	}
+	return cursor, nil
+}
+
+// Save writes the cursor to a temporary file and renames it, so a crash
+// never leaves it half written.
+func (s FileWatchCursorStore) Save(cursor WatchCursor) error {
+	b, err := json.Marshal(cursor)
+	if err != nil {
+		return err
+	}
+
+	tmp := s.Path + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.Path)
+}
+
+type ReleaseWatcherConfig struct {
+	ProductSlugs []string
+
+	// Time between polls (optional, 5 minutes by default)
+	Interval time.Duration
+
+	// Only watch releases whose versions satisfy this semver.Constraint,
+	// e.g. "~2.13" (optional). A release whose version changes to one that
+	// does not satisfy it gets a ReleaseVersionChanged event and is then no
+	// longer watched.
+	VersionConstraint string
+
+	// Where to persist the cursor (optional, kept in memory by default)
+	CursorStore WatchCursorStore
+
+	// Emit ReleaseCreated for the releases that exist the first time a
+	// product is polled (optional). By default the first poll only records
+	// them.
+	EmitExisting bool
+
+	// Delay before polling again after being rate limited, doubling each
+	// time it happens in a row (optional, Interval and 30 minutes at most
+	// by default)
+	RateLimitBackoff    time.Duration
+	MaxRateLimitBackoff time.Duration
+}
+
+// ReleaseWatcher polls products for new, changed and deleted releases. It is
+// not safe for concurrent use.
+type ReleaseWatcher struct {
+	releases   ReleasesAPI
+	config     ReleaseWatcherConfig
+	constraint *semver.Constraint
+
+	cursor WatchCursor
+	loaded bool
+}
+
+func NewReleaseWatcher(releases ReleasesAPI, config ReleaseWatcherConfig) (*ReleaseWatcher, error) {
+	w := &ReleaseWatcher{releases: releases, config: config}
+
+	if config.VersionConstraint != "" {
+		c, err := semver.ParseConstraint(config.VersionConstraint)
+		if err != nil {
+			return nil, err
+		}
+		w.constraint = &c
+	}
+
+	return w, nil
+}
+
+// Poll lists the releases of every product once and returns the changes
+// since the last poll, saving the cursor. If listing a product fails, the
+// events of the products polled before it are returned with the error.
+func (w *ReleaseWatcher) Poll(ctx context.Context) ([]ReleaseEvent, error) {
+	if err := w.load(); err != nil {
+		return nil, err
+	}
+
+	events, cursor, err := w.poll(ctx)
+
+	if saveErr := w.save(cursor); saveErr != nil {
+		return nil, saveErr
+	}
+
+	return events, err
+}
+
+// Run polls on the configured interval and calls handle with every event,
+// until ctx is cancelled or polling fails with anything but a rate limit.
+// The cursor is saved once every event of a poll has been handled, so if
+// handle returns an error Run stops and the events of that poll are seen
+// again by the next watcher using the same cursor.
+func (w *ReleaseWatcher) Run(ctx context.Context, handle func(ReleaseEvent) error) error {
+	rateLimited := 0
+
+	ticker := time.NewTicker(w.interval())
+	defer ticker.Stop()
+
+	for {
+		if err := w.load(); err != nil {
+			return err
+		}
+
+		events, cursor, pollErr := w.poll(ctx)
+
+		for _, e := range events {
+			if err := handle(e); err != nil {
+				return err
+			}
+		}
+
+		if err := w.save(cursor); err != nil {
+			return err
+		}
+
+		delay := w.interval()
+
+		var tooManyRequests ErrTooManyRequests
+		switch {
+		case errors.As(pollErr, &tooManyRequests):
+			rateLimited++
+			delay = w.rateLimitBackoff(rateLimited)
+		case pollErr != nil:
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return pollErr
+		default:
+			rateLimited = 0
+		}
+
+		// the next poll is delay after this one, however long it took
+		select {
+		case <-ticker.C:
+		default:
+		}
+		ticker.Reset(delay)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Events runs the watcher in a goroutine, sending events on the first
+// channel until it stops. The second channel then receives the error Run
+// returned, and both are closed.
+func (w *ReleaseWatcher) Events(ctx context.Context) (<-chan ReleaseEvent, <-chan error) {
+	events := make(chan ReleaseEvent)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(events)
+
+		errs <- w.Run(ctx, func(e ReleaseEvent) error {
+			select {
+			case events <- e:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+
+	return events, errs
+}
+
+// load reads the cursor from the store the first time it is needed. It is
+// tried again on the next poll if it fails, so that the saved cursor is never
+// overwritten by one that does not know what was seen before.
+func (w *ReleaseWatcher) load() error {
+	if w.loaded || w.config.CursorStore == nil {
+		return nil
+	}
+
+	cursor, err := w.config.CursorStore.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load watch cursor: %w", err)
+	}
+
+	w.cursor = cursor
+	w.loaded = true
+	return nil
+}
+
+// poll returns the events and the cursor to save after handling them, which
+// only keeps the products that are still watched.
+func (w *ReleaseWatcher) poll(ctx context.Context) ([]ReleaseEvent, WatchCursor, error) {
+	next := WatchCursor{Products: map[string]map[int]ReleaseSnapshot{}}
+	for _, slug := range w.config.ProductSlugs {
+		if snapshots, ok := w.cursor.Products[slug]; ok {
+			next.Products[slug] = snapshots
+		}
+	}
+
+	var events []ReleaseEvent
+	for _, slug := range w.config.ProductSlugs {
+		releases, err := w.releases.ListAllContext(ctx, slug, ListOptions{})
+		if err != nil {
+			return events, next, fmt.Errorf("failed to list releases of %s: %w", slug, err)
+		}
+
+		previous, seen := next.Products[slug]
+		current := map[int]ReleaseSnapshot{}
+		listed := map[int]bool{}
+
+		for _, release := range releases {
+			listed[release.ID] = true
+
+			prev, tracked := previous[release.ID]
+			matches := w.matches(release)
+			if matches {
+				current[release.ID] = newReleaseSnapshot(release)
+			}
+
+			switch {
+			case tracked:
+				events = append(events, releaseChanges(slug, release, prev)...)
+			case matches && (seen || w.config.EmitExisting):
+				events = append(events, ReleaseEvent{Type: ReleaseCreated, ProductSlug: slug, Release: release})
+			}
+		}
+
+		var deleted []int
+		for id := range previous {
+			if !listed[id] {
+				deleted = append(deleted, id)
+			}
+		}
+		sort.Ints(deleted)
+
+		for _, id := range deleted {
+			prev := previous[id]
+			events = append(events, ReleaseEvent{Type: ReleaseDeleted, ProductSlug: slug, Release: prev.release(), Previous: &prev})
+		}
+
+		next.Products[slug] = current
+	}
+
+	return events, next, nil
+}
+
+func releaseChanges(slug string, release Release, prev ReleaseSnapshot) []ReleaseEvent {
+	var events []ReleaseEvent
+
+	add := func(t ReleaseEventType) {
+		events = append(events, ReleaseEvent{Type: t, ProductSlug: slug, Release: release, Previous: &prev})
+	}
+
+	if release.Version != prev.Version {
+		add(ReleaseVersionChanged)
+	}
+	if release.Availability != prev.Availability {
+		add(ReleaseAvailabilityChanged)
+	}
+	if release.SoftwareFilesUpdatedAt != prev.SoftwareFilesUpdatedAt {
+		add(ReleaseFilesUpdated)
+	}
+	if release.UserGroupsUpdatedAt != prev.UserGroupsUpdatedAt {
+		add(ReleaseUserGroupsUpdated)
+	}
+
+	return events
+}
+
+func (w *ReleaseWatcher) matches(release Release) bool {
+	if w.constraint == nil {
+		return true
+	}
+
+	v, err := semver.Parse(release.Version)
+	return err == nil && w.constraint.Check(v)
+}
+
+func (w *ReleaseWatcher) save(cursor WatchCursor) error {
+	w.cursor = cursor
+
+	if w.config.CursorStore == nil {
+		return nil
+	}
+
+	if err := w.config.CursorStore.Save(cursor); err != nil {
+		return fmt.Errorf("failed to save watch cursor: %w", err)
+	}
+	return nil
+}
+
+func (w *ReleaseWatcher) interval() time.Duration {
+	if w.config.Interval > 0 {
+		return w.config.Interval
+	}
+	return defaultWatchInterval
+}
+
+func (w *ReleaseWatcher) rateLimitBackoff(attempt int) time.Duration {
+	policy := RetryPolicy{
+		BaseBackoff: w.config.RateLimitBackoff,
+		MaxBackoff:  w.config.MaxRateLimitBackoff,
+	}
+	if policy.BaseBackoff <= 0 {
+		policy.BaseBackoff = w.interval()
+	}
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = defaultMaxRateLimitBackoff
+	}
+
+	return policy.backoff(attempt, nil)
+}
@@ -0,0 +1,329 @@
+package pivnet_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet/v9"
+	"github.com/pivotal-cf/go-pivnet/v9/go-pivnetfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReleaseWatcher", func() {
+	var (
+		releasesAPI *gopivnetfakes.FakeReleasesAPI
+		releases    map[string][]pivnet.Release
+		listErrs    []error
+		config      pivnet.ReleaseWatcherConfig
+	)
+
+	BeforeEach(func() {
+		releases = map[string][]pivnet.Release{
+			"banana": {
+				{ID: 1, Version: "1.0.0", Availability: string(pivnet.AvailabilityAllUsers), SoftwareFilesUpdatedAt: "2020-01-01T00:00:00Z"},
+				{ID: 2, Version: "2.0.0", Availability: string(pivnet.AvailabilityAdminsOnly)},
+			},
+		}
+		listErrs = nil
+
+		releasesAPI = &gopivnetfakes.FakeReleasesAPI{}
+		releasesAPI.ListAllContextStub = func(ctx context.Context, slug string, opts pivnet.ListOptions) ([]pivnet.Release, error) {
+			if len(listErrs) > 0 {
+				err := listErrs[0]
+				listErrs = listErrs[1:]
+				return nil, err
+			}
+			return append([]pivnet.Release(nil), releases[slug]...), nil
+		}
+
+		config = pivnet.ReleaseWatcherConfig{ProductSlugs: []string{"banana"}}
+	})
+
+	newWatcher := func() *pivnet.ReleaseWatcher {
+		w, err := pivnet.NewReleaseWatcher(releasesAPI, config)
+		Expect(err).NotTo(HaveOccurred())
+		return w
+	}
+
+	eventTypes := func(events []pivnet.ReleaseEvent) []pivnet.ReleaseEventType {
+		var types []pivnet.ReleaseEventType
+		for _, e := range events {
+			types = append(types, e.Type)
+		}
+		return types
+	}
+
+	Describe("Poll", func() {
+		It("records existing releases without events on the first poll", func() {
+			events, err := newWatcher().Poll(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(BeEmpty())
+		})
+
+		It("emits existing releases as created when asked to", func() {
+			config.EmitExisting = true
+
+			events, err := newWatcher().Poll(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(eventTypes(events)).To(Equal([]pivnet.ReleaseEventType{pivnet.ReleaseCreated, pivnet.ReleaseCreated}))
+			Expect(events[0].ProductSlug).To(Equal("banana"))
+			Expect(events[0].Previous).To(BeNil())
+		})
+
+		It("emits an event for each change since the last poll", func() {
+			w := newWatcher()
+			_, err := w.Poll(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+
+			releases["banana"] = []pivnet.Release{
+				{ID: 2, Version: "2.0.1", Availability: string(pivnet.AvailabilityAllUsers), UserGroupsUpdatedAt: "2020-02-01T00:00:00Z"},
+				{ID: 3, Version: "3.0.0"},
+			}
+
+			events, err := w.Poll(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(eventTypes(events)).To(Equal([]pivnet.ReleaseEventType{
+				pivnet.ReleaseVersionChanged,
+				pivnet.ReleaseAvailabilityChanged,
+				pivnet.ReleaseUserGroupsUpdated,
+				pivnet.ReleaseCreated,
+				pivnet.ReleaseDeleted,
+			}))
+
+			Expect(events[0].Release.Version).To(Equal("2.0.1"))
+			Expect(events[0].Previous.Version).To(Equal("2.0.0"))
+			Expect(events[3].Release.ID).To(Equal(3))
+			Expect(events[4].Release.ID).To(Equal(1))
+			Expect(events[4].Release.Version).To(Equal("1.0.0"))
+
+			events, err = w.Poll(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(BeEmpty())
+		})
+
+		It("emits files updated when the files of a release change", func() {
+			w := newWatcher()
+			_, err := w.Poll(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+
+			releases["banana"][0].SoftwareFilesUpdatedAt = "2020-03-01T00:00:00Z"
+
+			events, err := w.Poll(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(eventTypes(events)).To(Equal([]pivnet.ReleaseEventType{pivnet.ReleaseFilesUpdated}))
+			Expect(events[0].Previous.SoftwareFilesUpdatedAt).To(Equal("2020-01-01T00:00:00Z"))
+		})
+
+		It("only watches releases matching the version constraint", func() {
+			config.VersionConstraint = "^1"
+			config.EmitExisting = true
+			w := newWatcher()
+
+			events, err := w.Poll(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Release.ID).To(Equal(1))
+
+			releases["banana"] = append(releases["banana"], pivnet.Release{ID: 3, Version: "2.1.0"})
+			releases["banana"][0].Version = "2.0.0-fixed"
+
+			events, err = w.Poll(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(eventTypes(events)).To(Equal([]pivnet.ReleaseEventType{pivnet.ReleaseVersionChanged}))
+		})
+
+		It("rejects an invalid version constraint", func() {
+			config.VersionConstraint = ">>1"
+
+			_, err := pivnet.NewReleaseWatcher(releasesAPI, config)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("returns the error when listing releases fails", func() {
+			listErrs = []error{errors.New("boom")}
+
+			_, err := newWatcher().Poll(context.Background())
+			Expect(err).To(MatchError("failed to list releases of banana: boom"))
+		})
+	})
+
+	Describe("FileWatchCursorStore", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "release-watcher")
+			Expect(err).NotTo(HaveOccurred())
+
+			config.CursorStore = pivnet.FileWatchCursorStore{Path: filepath.Join(dir, "cursor.json")}
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("lets a new watcher carry on from the saved cursor", func() {
+			_, err := newWatcher().Poll(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filepath.Join(dir, "cursor.json")).To(BeARegularFile())
+
+			releases["banana"][1].Availability = string(pivnet.AvailabilityAllUsers)
+
+			events, err := newWatcher().Poll(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(eventTypes(events)).To(Equal([]pivnet.ReleaseEventType{pivnet.ReleaseAvailabilityChanged}))
+			Expect(events[0].Previous.Availability).To(Equal(string(pivnet.AvailabilityAdminsOnly)))
+		})
+
+		It("does not overwrite the saved cursor when it cannot be loaded", func() {
+			store := &failingCursorStore{loadErrs: []error{errors.New("disk on fire")}}
+			config.CursorStore = store
+			w := newWatcher()
+
+			_, err := w.Poll(context.Background())
+			Expect(err).To(MatchError("failed to load watch cursor: disk on fire"))
+			Expect(store.saves).To(Equal(0))
+			Expect(releasesAPI.ListAllContextCallCount()).To(Equal(0))
+
+			releases["banana"][1].Version = "2.0.1"
+
+			events, err := w.Poll(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(eventTypes(events)).To(Equal([]pivnet.ReleaseEventType{pivnet.ReleaseVersionChanged}))
+			Expect(store.loads).To(Equal(2))
+		})
+
+		It("forgets products that are no longer watched", func() {
+			config.ProductSlugs = []string{"banana", "apple"}
+			_, err := newWatcher().Poll(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+
+			config.ProductSlugs = []string{"banana"}
+			_, err = newWatcher().Poll(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+
+			cursor, err := config.CursorStore.Load()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cursor.Products).To(HaveKey("banana"))
+			Expect(cursor.Products).NotTo(HaveKey("apple"))
+		})
+
+		It("returns an error for a corrupt cursor", func() {
+			Expect(ioutil.WriteFile(filepath.Join(dir, "cursor.json"), []byte("{"), 0600)).To(Succeed())
+
+			_, err := newWatcher().Poll(context.Background())
+			Expect(err).To(MatchError(ContainSubstring("failed to load watch cursor")))
+		})
+	})
+
+	Describe("Run", func() {
+		BeforeEach(func() {
+			config.Interval = time.Millisecond
+			config.RateLimitBackoff = time.Millisecond
+			config.MaxRateLimitBackoff = 5 * time.Millisecond
+		})
+
+		It("keeps polling through rate limits", func() {
+			listErrs = []error{
+				pivnet.ErrTooManyRequests{ResponseCode: 429, Message: "slow down"},
+				pivnet.ErrTooManyRequests{ResponseCode: 429, Message: "slow down"},
+			}
+			config.EmitExisting = true
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var received []pivnet.ReleaseEvent
+			err := newWatcher().Run(ctx, func(e pivnet.ReleaseEvent) error {
+				received = append(received, e)
+				if len(received) == 2 {
+					cancel()
+				}
+				return nil
+			})
+			Expect(err).To(Equal(context.Canceled))
+			Expect(received).To(HaveLen(2))
+			Expect(releasesAPI.ListAllContextCallCount()).To(Equal(3))
+		})
+
+		It("stops on other errors", func() {
+			listErrs = []error{errors.New("boom")}
+
+			err := newWatcher().Run(context.Background(), func(pivnet.ReleaseEvent) error { return nil })
+			Expect(err).To(MatchError("failed to list releases of banana: boom"))
+		})
+
+		It("stops without saving when the cursor cannot be loaded", func() {
+			store := &failingCursorStore{loadErrs: []error{errors.New("disk on fire")}}
+			config.CursorStore = store
+
+			err := newWatcher().Run(context.Background(), func(pivnet.ReleaseEvent) error { return nil })
+			Expect(err).To(MatchError("failed to load watch cursor: disk on fire"))
+			Expect(store.saves).To(Equal(0))
+		})
+
+		It("does not move the cursor past events the handler failed on", func() {
+			config.EmitExisting = true
+			w := newWatcher()
+
+			err := w.Run(context.Background(), func(pivnet.ReleaseEvent) error { return errors.New("handler failed") })
+			Expect(err).To(MatchError("handler failed"))
+
+			events, err := w.Poll(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(2))
+		})
+	})
+
+	Describe("Events", func() {
+		It("sends events on a channel until the context is cancelled", func() {
+			config.Interval = time.Millisecond
+			config.EmitExisting = true
+
+			ctx, cancel := context.WithCancel(context.Background())
+			events, errs := newWatcher().Events(ctx)
+
+			Eventually(events).Should(Receive(WithTransform(func(e pivnet.ReleaseEvent) int { return e.Release.ID }, Equal(1))))
+			Eventually(events).Should(Receive(WithTransform(func(e pivnet.ReleaseEvent) int { return e.Release.ID }, Equal(2))))
+
+			cancel()
+			Eventually(errs).Should(Receive(Equal(context.Canceled)))
+			Eventually(events).Should(BeClosed())
+		})
+	})
+})
+
+// failingCursorStore fails its first loads with loadErrs, then returns a
+// cursor that has already seen the banana releases.
+type failingCursorStore struct {
+	loadErrs []error
+	loads    int
+	saves    int
+}
+
+func (s *failingCursorStore) Load() (pivnet.WatchCursor, error) {
+	s.loads++
+	if len(s.loadErrs) > 0 {
+		err := s.loadErrs[0]
+		s.loadErrs = s.loadErrs[1:]
+		return pivnet.WatchCursor{}, err
+	}
+
+	return pivnet.WatchCursor{Products: map[string]map[int]pivnet.ReleaseSnapshot{
+		"banana": {
+			1: {ID: 1, Version: "1.0.0", Availability: string(pivnet.AvailabilityAllUsers), SoftwareFilesUpdatedAt: "2020-01-01T00:00:00Z"},
+			2: {ID: 2, Version: "2.0.0", Availability: string(pivnet.AvailabilityAdminsOnly)},
+		},
+	}}, nil
+}
+
+func (s *failingCursorStore) Save(pivnet.WatchCursor) error {
+	s.saves++
+	return nil
+}
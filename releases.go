@@ -2,6 +2,7 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -15,6 +16,34 @@ type ReleasesService struct {
 	l      logger.Logger
 }
 
+//go:generate counterfeiter . ReleasesAPI
+type ReleasesAPI interface {
+	List(productSlug string, params ...QueryParameter) ([]Release, error)
+	ListContext(ctx context.Context, productSlug string, params ...QueryParameter) ([]Release, error)
+	Iterate(productSlug string, opts ListOptions) *ReleaseIterator
+	IterateContext(ctx context.Context, productSlug string, opts ListOptions) *ReleaseIterator
+	ListAll(productSlug string, opts ListOptions) ([]Release, error)
+	ListAllContext(ctx context.Context, productSlug string, opts ListOptions) ([]Release, error)
+	Get(productSlug string, releaseID int) (Release, error)
+	GetContext(ctx context.Context, productSlug string, releaseID int) (Release, error)
+	GetByVersion(productSlug string, version string) (Release, error)
+	GetByVersionContext(ctx context.Context, productSlug string, version string) (Release, error)
+	LatestMatching(productSlug string, constraint string) (Release, error)
+	LatestMatchingContext(ctx context.Context, productSlug string, constraint string) (Release, error)
+	ListReachingEndOfSupport(productSlug string, from time.Time, to time.Time) ([]Release, error)
+	ListReachingEndOfSupportContext(ctx context.Context, productSlug string, from time.Time, to time.Time) ([]Release, error)
+	Clone(productSlug string, sourceReleaseID int, newVersion string, opts CloneReleaseOptions) (CloneReleaseReport, error)
+	CloneContext(ctx context.Context, productSlug string, sourceReleaseID int, newVersion string, opts CloneReleaseOptions) (CloneReleaseReport, error)
+	Promote(productSlug string, releaseID int, target Availability, opts PromoteReleaseOptions) (Release, error)
+	PromoteContext(ctx context.Context, productSlug string, releaseID int, target Availability, opts PromoteReleaseOptions) (Release, error)
+	Create(config CreateReleaseConfig) (Release, error)
+	CreateContext(ctx context.Context, config CreateReleaseConfig) (Release, error)
+	Update(productSlug string, release Release) (Release, error)
+	UpdateContext(ctx context.Context, productSlug string, release Release) (Release, error)
+	Delete(productSlug string, release Release) error
+	DeleteContext(ctx context.Context, productSlug string, release Release) error
+}
+
 type createReleaseBody struct {
 	Release      Release `json:"release"`
 	CopyMetadata bool    `json:"copy_metadata"`
@@ -24,10 +53,30 @@ type ReleasesResponse struct {
 	Releases []Release `json:"releases,omitempty"`
 }
 
+// releasesPage is a single page of a paginated release listing.
+type releasesPage struct {
+	ReleasesResponse
+	Links *Links `json:"_links,omitempty"`
+}
+
+func (r *releasesPage) nextPage() string {
+	return r.Links.NextPage()
+}
+
 type CreateReleaseResponse struct {
 	Release Release `json:"release,omitempty"`
 }
 
+// Availability is who can see and download a release, as in
+// Release.Availability.
+type Availability string
+
+const (
+	AvailabilityAdminsOnly             Availability = "Admins Only"
+	AvailabilitySelectedUserGroupsOnly Availability = "Selected User Groups Only"
+	AvailabilityAllUsers               Availability = "All Users"
+)
+
 type Release struct {
 	ID                     int         `json:"id,omitempty" yaml:"id,omitempty"`
 	Availability           string      `json:"availability,omitempty" yaml:"availability,omitempty"`
@@ -68,10 +117,14 @@ type CreateReleaseConfig struct {
 }
 
 func (r ReleasesService) List(productSlug string, params ...QueryParameter) ([]Release, error) {
+	return r.ListContext(context.Background(), productSlug, params...)
+}
+
+func (r ReleasesService) ListContext(ctx context.Context, productSlug string, params ...QueryParameter) ([]Release, error) {
 	url := fmt.Sprintf("/products/%s/releases", productSlug)
 
 	var response ReleasesResponse
-	resp, err := r.client.MakeRequestWithParams("GET", url, http.StatusOK, params, nil)
+	resp, err := r.client.MakeRequestWithParamsContext(ctx, "GET", url, http.StatusOK, params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -85,11 +138,108 @@ func (r ReleasesService) List(productSlug string, params ...QueryParameter) ([]R
 	return response.Releases, nil
 }
 
+// ReleaseIterator walks the releases of a product one page at a time. Pages
+// are only requested as the iteration reaches them, so a consumer that stops
+// early does not fetch the remaining pages.
+//
+//	it := client.Releases.Iterate(productSlug, pivnet.ListOptions{PerPage: 100})
+//	for it.Next() {
+//		release := it.Release()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type ReleaseIterator struct {
+	pager    *pager
+	err      error
+	releases []Release
+	current  Release
+}
+
+// Next advances the iterator to the next release, fetching the next page if
+// needed. It returns false when there are no more releases or a request
+// fails.
+func (it *ReleaseIterator) Next() bool {
+	for len(it.releases) == 0 {
+		if it.pager == nil {
+			return false
+		}
+
+		var response releasesPage
+		if !it.pager.next(&response) {
+			return false
+		}
+		it.releases = response.Releases
+	}
+
+	it.current = it.releases[0]
+	it.releases = it.releases[1:]
+
+	return true
+}
+
+// Release returns the release the iterator is positioned at.
+func (it *ReleaseIterator) Release() Release {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ReleaseIterator) Err() error {
+	if it.pager == nil {
+		return it.err
+	}
+	return it.pager.err
+}
+
+// NewReleaseIterator returns an iterator over the given releases that then
+// stops with err, e.g. to return from a fake ReleasesAPI. The zero
+// ReleaseIterator is empty.
+func NewReleaseIterator(releases []Release, err error) *ReleaseIterator {
+	return &ReleaseIterator{releases: releases, err: err}
+}
+
+func (r ReleasesService) Iterate(productSlug string, opts ListOptions) *ReleaseIterator {
+	return r.IterateContext(context.Background(), productSlug, opts)
+}
+
+func (r ReleasesService) IterateContext(ctx context.Context, productSlug string, opts ListOptions) *ReleaseIterator {
+	url := fmt.Sprintf("/products/%s/releases", productSlug)
+
+	return &ReleaseIterator{
+		pager: newPager(ctx, r.client, url, opts),
+	}
+}
+
+// ListAll returns the releases from every page, following the pagination
+// links returned by Pivnet.
+func (r ReleasesService) ListAll(productSlug string, opts ListOptions) ([]Release, error) {
+	return r.ListAllContext(context.Background(), productSlug, opts)
+}
+
+func (r ReleasesService) ListAllContext(ctx context.Context, productSlug string, opts ListOptions) ([]Release, error) {
+	var releases []Release
+
+	it := r.IterateContext(ctx, productSlug, opts)
+	for it.Next() {
+		releases = append(releases, it.Release())
+	}
+
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return releases, nil
+}
+
 func (r ReleasesService) Get(productSlug string, releaseID int) (Release, error) {
+	return r.GetContext(context.Background(), productSlug, releaseID)
+}
+
+func (r ReleasesService) GetContext(ctx context.Context, productSlug string, releaseID int) (Release, error) {
 	url := fmt.Sprintf("/products/%s/releases/%d", productSlug, releaseID)
 
 	var response Release
-	resp, err := r.client.MakeRequest("GET", url, http.StatusOK, nil)
+	resp, err := r.client.MakeRequestContext(ctx, "GET", url, http.StatusOK, nil)
 	if err != nil {
 		return Release{}, err
 	}
@@ -104,11 +254,15 @@ func (r ReleasesService) Get(productSlug string, releaseID int) (Release, error)
 }
 
 func (r ReleasesService) Create(config CreateReleaseConfig) (Release, error) {
+	return r.CreateContext(context.Background(), config)
+}
+
+func (r ReleasesService) CreateContext(ctx context.Context, config CreateReleaseConfig) (Release, error) {
 	url := fmt.Sprintf("/products/%s/releases", config.ProductSlug)
 
 	body := createReleaseBody{
 		Release: Release{
-			Availability: "Admins Only",
+			Availability: string(AvailabilityAdminsOnly),
 			EULA: &EULA{
 				Slug: config.EULASlug,
 			},
@@ -143,7 +297,8 @@ func (r ReleasesService) Create(config CreateReleaseConfig) (Release, error) {
 	}
 
 	var response CreateReleaseResponse
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"POST",
 		url,
 		http.StatusCreated,
@@ -163,6 +318,10 @@ func (r ReleasesService) Create(config CreateReleaseConfig) (Release, error) {
 }
 
 func (r ReleasesService) Update(productSlug string, release Release) (Release, error) {
+	return r.UpdateContext(context.Background(), productSlug, release)
+}
+
+func (r ReleasesService) UpdateContext(ctx context.Context, productSlug string, release Release) (Release, error) {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d",
 		productSlug,
@@ -183,7 +342,8 @@ func (r ReleasesService) Update(productSlug string, release Release) (Release, e
 	}
 
 	var response CreateReleaseResponse
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusOK,
@@ -203,13 +363,18 @@ func (r ReleasesService) Update(productSlug string, release Release) (Release, e
 }
 
 func (r ReleasesService) Delete(productSlug string, release Release) error {
+	return r.DeleteContext(context.Background(), productSlug, release)
+}
+
+func (r ReleasesService) DeleteContext(ctx context.Context, productSlug string, release Release) error {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d",
 		productSlug,
 		release.ID,
 	)
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"DELETE",
 		url,
 		http.StatusNoContent,
@@ -1,6 +1,8 @@
 package pivnet_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -121,6 +123,152 @@ var _ = Describe("PivnetClient - product files", func() {
 		})
 	})
 
+	Describe("ListContext", func() {
+		It("returns the releases for the product slug", func() {
+			response := `{"releases": [{"id":2,"version":"1.2.3"}]}`
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", apiPrefix+"/products/banana/releases"),
+					ghttp.RespondWith(http.StatusOK, response),
+				),
+			)
+
+			releases, err := client.Releases.ListContext(context.Background(), "banana")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releases).To(HaveLen(1))
+			Expect(releases[0].ID).To(Equal(2))
+		})
+
+		Context("when the context is cancelled", func() {
+			It("returns the context error", func() {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				_, err := client.Releases.ListContext(ctx, "banana")
+				Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+				Expect(server.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("Iterate", func() {
+		BeforeEach(func() {
+			firstPage := fmt.Sprintf(`{"releases": [{"id":1},{"id":2}], "_links": {"next": {"href":"%s/api/v2/products/banana/releases?page=2&per_page=2"}}}`, apiAddress)
+			secondPage := `{"releases": [{"id":3}], "_links": {}}`
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", apiPrefix+"/products/banana/releases", "per_page=2"),
+					ghttp.RespondWith(http.StatusOK, firstPage),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", apiPrefix+"/products/banana/releases", "page=2&per_page=2"),
+					ghttp.RespondWith(http.StatusOK, secondPage),
+				),
+			)
+		})
+
+		It("follows the next links until the last page", func() {
+			var ids []int
+
+			it := client.Releases.Iterate("banana", pivnet.ListOptions{PerPage: 2})
+			for it.Next() {
+				ids = append(ids, it.Release().ID)
+			}
+
+			Expect(it.Err()).NotTo(HaveOccurred())
+			Expect(ids).To(Equal([]int{1, 2, 3}))
+			Expect(server.ReceivedRequests()).To(HaveLen(2))
+		})
+
+		It("does not fetch further pages when the consumer stops early", func() {
+			it := client.Releases.Iterate("banana", pivnet.ListOptions{PerPage: 2})
+			for it.Next() {
+				if it.Release().ID == 2 {
+					break
+				}
+			}
+
+			Expect(it.Err()).NotTo(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		Context("when a page request fails", func() {
+			BeforeEach(func() {
+				server.SetHandler(1, ghttp.RespondWith(http.StatusTeapot, `{"message":"foo message"}`))
+			})
+
+			It("stops and reports the error", func() {
+				var ids []int
+
+				it := client.Releases.Iterate("banana", pivnet.ListOptions{PerPage: 2})
+				for it.Next() {
+					ids = append(ids, it.Release().ID)
+				}
+
+				Expect(ids).To(Equal([]int{1, 2}))
+				Expect(it.Err()).To(MatchError(ContainSubstring("foo message")))
+			})
+		})
+	})
+
+	Describe("NewReleaseIterator", func() {
+		It("iterates over the given releases and then reports the error", func() {
+			fake := &gopivnetfakes.FakeReleasesAPI{}
+			fake.IterateReturns(pivnet.NewReleaseIterator([]pivnet.Release{{ID: 1}, {ID: 2}}, errors.New("some error")))
+
+			var ids []int
+			it := fake.Iterate("banana", pivnet.ListOptions{})
+			for it.Next() {
+				ids = append(ids, it.Release().ID)
+			}
+
+			Expect(ids).To(Equal([]int{1, 2}))
+			Expect(it.Err()).To(MatchError("some error"))
+		})
+
+		It("makes the zero iterator empty", func() {
+			it := &pivnet.ReleaseIterator{}
+			Expect(it.Next()).To(BeFalse())
+			Expect(it.Err()).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("ListAll", func() {
+		It("returns the releases from every page", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", apiPrefix+"/products/banana/releases", "limit=3"),
+					ghttp.RespondWith(http.StatusOK, fmt.Sprintf(`{"releases": [{"id":1}], "_links": {"next": {"href":"%s/api/v2/products/banana/releases?cursor=abc"}}}`, apiAddress)),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", apiPrefix+"/products/banana/releases", "cursor=abc"),
+					ghttp.RespondWith(http.StatusOK, `{"releases": [{"id":2}]}`),
+				),
+			)
+
+			releases, err := client.Releases.ListAll("banana", pivnet.ListOptions{
+				Params: []pivnet.QueryParameter{{Key: "limit", Value: "3"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releases).To(HaveLen(2))
+			Expect(releases[0].ID).To(Equal(1))
+			Expect(releases[1].ID).To(Equal(2))
+		})
+
+		Context("when a page request fails", func() {
+			It("returns the error", func() {
+				server.AppendHandlers(
+					ghttp.RespondWith(http.StatusTeapot, `{"message":"foo message"}`),
+				)
+
+				_, err := client.Releases.ListAll("banana", pivnet.ListOptions{})
+				Expect(err).To(MatchError(ContainSubstring("foo message")))
+			})
+		})
+	})
+
 	Describe("Get", func() {
 		It("returns the release for the product slug and releaseID", func() {
 			response := `{"id": 3, "version": "3.2.1", "_links": {"product_files": {"href":"https://banana.org/cookies/download"}}}`
@@ -0,0 +1,154 @@
+package pivnet
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RetryPolicy controls how the client retries API requests that fail with a
+// rate limit (429), a transient server error (500, 502, 503, 504) or a
+// transport error. The zero value disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts made for a request,
+	// including the first one. Values below 2 disable retries.
+	MaxAttempts int
+
+	// BaseBackoff is the delay before the first retry. It doubles with every
+	// subsequent attempt.
+	BaseBackoff time.Duration
+
+	// MaxBackoff caps a single delay, including one requested by a
+	// Retry-After header. Zero means no cap.
+	MaxBackoff time.Duration
+
+	// Jitter is the fraction (0 to 1) of each computed delay that is
+	// randomised, so that concurrent clients do not retry in lockstep.
+	Jitter float64
+
+	// RetryNonIdempotent also retries POST and PATCH requests. Only enable
+	// this if creating the same resource twice is acceptable.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy is a reasonable policy for long-running consumers.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	BaseBackoff: 500 * time.Millisecond,
+	MaxBackoff:  30 * time.Second,
+	Jitter:      0.2,
+}
+
+var (
+	jitterRandMutex sync.Mutex
+	jitterRand      = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+func (p RetryPolicy) enabled() bool {
+	return p.MaxAttempts > 1
+}
+
+func (p RetryPolicy) retriesMethod(method string) bool {
+	if !p.enabled() {
+		return false
+	}
+
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	default:
+		return p.RetryNonIdempotent
+	}
+}
+
+func (p RetryPolicy) canRetry(method string, attempt int) bool {
+	return p.retriesMethod(method) && attempt < p.MaxAttempts
+}
+
+// backoff returns how long to wait before the attempt following the given
+// one. A Retry-After header on resp takes precedence over the computed
+// exponential delay.
+func (p RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return p.capped(delay)
+		}
+	}
+
+	delay := p.BaseBackoff
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if p.MaxBackoff > 0 && delay >= p.MaxBackoff {
+			break
+		}
+	}
+	delay = p.capped(delay)
+
+	if p.Jitter > 0 && delay > 0 {
+		jitterRandMutex.Lock()
+		f := jitterRand.Float64()
+		jitterRandMutex.Unlock()
+
+		delay -= time.Duration(f * p.Jitter * float64(delay))
+	}
+
+	return delay
+}
+
+func (p RetryPolicy) capped(delay time.Duration) time.Duration {
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return delay
+}
+
+func isRetryableStatusCode(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		delay := time.Until(t)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,211 @@
+package pivnet_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/pivotal-cf/go-pivnet/v9"
+	"github.com/pivotal-cf/go-pivnet/v9/go-pivnetfakes"
+	"github.com/pivotal-cf/go-pivnet/v9/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PivnetClient - retries", func() {
+	var (
+		server *ghttp.Server
+		client pivnet.Client
+
+		retryPolicy            pivnet.RetryPolicy
+		fakeLogger             *loggerfakes.FakeLogger
+		fakeAccessTokenService *gopivnetfakes.FakeAccessTokenService
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+
+		fakeLogger = &loggerfakes.FakeLogger{}
+		fakeAccessTokenService = &gopivnetfakes.FakeAccessTokenService{}
+		fakeAccessTokenService.AccessTokenReturns("some-token", nil)
+
+		retryPolicy = pivnet.RetryPolicy{
+			MaxAttempts: 3,
+			BaseBackoff: time.Millisecond,
+			MaxBackoff:  5 * time.Millisecond,
+		}
+	})
+
+	JustBeforeEach(func() {
+		client = pivnet.NewClient(fakeAccessTokenService, pivnet.ClientConfig{
+			Host:        server.URL(),
+			RetryPolicy: retryPolicy,
+		}, fakeLogger)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("when Pivnet returns a transient error and then succeeds", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", fmt.Sprintf("%s/foo", apiPrefix)),
+					ghttp.RespondWith(http.StatusServiceUnavailable, `{"message":"busy"}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", fmt.Sprintf("%s/foo", apiPrefix), "limit=1"),
+					ghttp.RespondWith(http.StatusOK, `{}`),
+				),
+			)
+		})
+
+		It("retries the request and reports the attempt", func() {
+			resp, err := client.MakeRequestWithParams(
+				"GET",
+				"/foo",
+				http.StatusOK,
+				[]pivnet.QueryParameter{{Key: "limit", Value: "1"}},
+				nil,
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(server.ReceivedRequests()).To(HaveLen(2))
+
+			Expect(fakeLogger.InfoCallCount()).To(Equal(1))
+			message, data := fakeLogger.InfoArgsForCall(0)
+			Expect(message).To(Equal("Retrying request"))
+			Expect(data[0]).To(HaveKeyWithValue("attempt", 2))
+			Expect(data[0]).To(HaveKeyWithValue("reason", "Service Unavailable"))
+		})
+	})
+
+	Context("when Pivnet keeps returning a server error", func() {
+		BeforeEach(func() {
+			for i := 0; i < 3; i++ {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", fmt.Sprintf("%s/foo", apiPrefix)),
+						ghttp.RespondWith(http.StatusBadGateway, `{"message":"bad gateway"}`),
+					),
+				)
+			}
+		})
+
+		It("gives up after the maximum number of attempts", func() {
+			_, err := client.MakeRequest("GET", "/foo", http.StatusOK, nil)
+			Expect(err).To(MatchError(pivnet.ErrPivnetOther{
+				ResponseCode: http.StatusBadGateway,
+				Message:      "bad gateway",
+			}))
+			Expect(server.ReceivedRequests()).To(HaveLen(3))
+			Expect(fakeLogger.InfoCallCount()).To(Equal(2))
+		})
+	})
+
+	Context("when Pivnet rate limits the request with a Retry-After header", func() {
+		BeforeEach(func() {
+			retryPolicy.MaxBackoff = 0
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", fmt.Sprintf("%s/foo", apiPrefix)),
+					ghttp.RespondWith(http.StatusTooManyRequests, "Retry later", http.Header{
+						"Retry-After": []string{"1"},
+					}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", fmt.Sprintf("%s/foo", apiPrefix)),
+					ghttp.RespondWith(http.StatusOK, `{}`),
+				),
+			)
+		})
+
+		It("waits as long as Pivnet asks before retrying", func() {
+			start := time.Now()
+
+			_, err := client.MakeRequest("GET", "/foo", http.StatusOK, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(time.Since(start)).To(BeNumerically(">=", time.Second))
+			Expect(server.ReceivedRequests()).To(HaveLen(2))
+		})
+	})
+
+	Context("when the request is not idempotent", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", fmt.Sprintf("%s/foo", apiPrefix)),
+					ghttp.VerifyJSON(`{"some":"body"}`),
+					ghttp.RespondWith(http.StatusServiceUnavailable, `{"message":"busy"}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", fmt.Sprintf("%s/foo", apiPrefix)),
+					ghttp.VerifyJSON(`{"some":"body"}`),
+					ghttp.RespondWith(http.StatusCreated, `{}`),
+				),
+			)
+		})
+
+		It("does not retry by default", func() {
+			_, err := client.MakeRequest("POST", "/foo", http.StatusCreated, strings.NewReader(`{"some":"body"}`))
+			Expect(err).To(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		Context("when retrying non-idempotent requests is enabled", func() {
+			BeforeEach(func() {
+				retryPolicy.RetryNonIdempotent = true
+			})
+
+			It("retries with the same body", func() {
+				_, err := client.MakeRequest("POST", "/foo", http.StatusCreated, strings.NewReader(`{"some":"body"}`))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(server.ReceivedRequests()).To(HaveLen(2))
+			})
+		})
+	})
+
+	Context("when the context is cancelled while waiting to retry", func() {
+		BeforeEach(func() {
+			retryPolicy.BaseBackoff = time.Minute
+			retryPolicy.MaxBackoff = time.Minute
+
+			server.AppendHandlers(
+				ghttp.RespondWith(http.StatusServiceUnavailable, `{"message":"busy"}`),
+			)
+		})
+
+		It("returns the context error", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			_, err := client.MakeRequestContext(ctx, "GET", "/foo", http.StatusOK, nil)
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Context("when no retry policy is configured", func() {
+		BeforeEach(func() {
+			retryPolicy = pivnet.RetryPolicy{}
+
+			server.AppendHandlers(
+				ghttp.RespondWith(http.StatusServiceUnavailable, `{"message":"busy"}`),
+			)
+		})
+
+		It("does not retry", func() {
+			_, err := client.MakeRequest("GET", "/foo", http.StatusOK, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,288 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Constraint is a set of version ranges, such as ">=1.4 <2 || ^3.1".
+//
+// Ranges separated by "||" are alternatives, and the comparisons within a
+// range, separated by spaces or commas, must all hold. Comparisons use the
+// operators =, !=, >, >=, < and <=, and also:
+//
+//	~1.2.3, ~>1.2.3     >=1.2.3 <1.3.0 (~1 is >=1.0.0 <2.0.0)
+//	^1.2.3              >=1.2.3 <2.0.0 (^0.2.3 is >=0.2.3 <0.3.0)
+//	1.2.*, 1.2.x, 1.2   >=1.2.0 <1.3.0
+//	1.2 - 1.4           >=1.2.0 <1.5.0
+//
+// A pre-release version only matches a range that has a comparison with a
+// pre-release of the same major, minor and patch version, so ">=1.4" does
+// not match 2.0.0-rc.1 but ">=2.0.0-rc.0" does.
+type Constraint struct {
+	ranges   [][]comparison
+	original string
+}
+
+type comparison struct {
+	operator string
+	version  Version
+}
+
+func ParseConstraint(s string) (Constraint, error) {
+	c := Constraint{original: s}
+
+	for _, alternative := range strings.Split(s, "||") {
+		r, err := parseRange(alternative)
+		if err != nil {
+			return Constraint{}, fmt.Errorf("invalid constraint %q: %w", s, err)
+		}
+		c.ranges = append(c.ranges, r)
+	}
+
+	return c, nil
+}
+
+// MustParseConstraint is like ParseConstraint but panics if the constraint
+// is invalid.
+func MustParseConstraint(s string) Constraint {
+	c, err := ParseConstraint(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func (c Constraint) String() string {
+	return c.original
+}
+
+// Check reports whether v satisfies the constraint.
+func (c Constraint) Check(v Version) bool {
+	for _, r := range c.ranges {
+		if rangeAllows(r, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func rangeAllows(r []comparison, v Version) bool {
+	preReleaseAllowed := v.PreRelease == ""
+
+	for _, cmp := range r {
+		if !cmp.allows(v) {
+			return false
+		}
+		if cmp.version.PreRelease != "" && cmp.version.tuple() == v.tuple() {
+			preReleaseAllowed = true
+		}
+	}
+
+	return preReleaseAllowed
+}
+
+func (cmp comparison) allows(v Version) bool {
+	c := v.Compare(cmp.version)
+
+	switch cmp.operator {
+	case "=":
+		return c == 0
+	case "!=":
+		return c != 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	}
+
+	return false
+}
+
+var operators = []string{"==", "!=", ">=", "<=", "~>", "=", ">", "<", "~", "^"}
+
+func parseRange(s string) ([]comparison, error) {
+	fields := strings.Fields(strings.Replace(s, ",", " ", -1))
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("empty range")
+	}
+
+	var terms []string
+	for i := 0; i < len(fields); i++ {
+		term := fields[i]
+		if isOperator(term) && i+1 < len(fields) {
+			i++
+			term += fields[i]
+		}
+		terms = append(terms, term)
+	}
+
+	var r []comparison
+	for i := 0; i < len(terms); i++ {
+		if i+2 < len(terms) && terms[i+1] == "-" {
+			cmps, err := hyphenRange(terms[i], terms[i+2])
+			if err != nil {
+				return nil, err
+			}
+			r = append(r, cmps...)
+			i += 2
+			continue
+		}
+
+		cmps, err := parseComparison(terms[i])
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, cmps...)
+	}
+
+	return r, nil
+}
+
+func isOperator(s string) bool {
+	for _, op := range operators {
+		if s == op {
+			return true
+		}
+	}
+	return false
+}
+
+// parseComparison expands a term into the plain comparisons it stands for.
+func parseComparison(term string) ([]comparison, error) {
+	operator := ""
+	for _, op := range operators {
+		if strings.HasPrefix(term, op) {
+			operator = op
+			break
+		}
+	}
+
+	p, err := parsePartial(term[len(operator):])
+	if err != nil {
+		return nil, err
+	}
+	if p.version.PreRelease != "" {
+		p.parts = 3
+	}
+
+	v := p.version
+	full := p.parts == 3
+
+	switch operator {
+	case "", "=", "==":
+		if full {
+			return []comparison{{"=", v}}, nil
+		}
+		if p.parts == 0 {
+			return nil, nil
+		}
+		return between(p.floor(), p.ceiling()), nil
+	case "!=":
+		if !full {
+			return nil, fmt.Errorf("%q: != needs a full version", term)
+		}
+		return []comparison{{"!=", v}}, nil
+	case ">":
+		if full {
+			return []comparison{{">", v}}, nil
+		}
+		if p.parts == 0 {
+			return []comparison{nothing}, nil
+		}
+		return []comparison{{">=", p.ceiling()}}, nil
+	case ">=":
+		return []comparison{{">=", p.floor()}}, nil
+	case "<":
+		if p.parts == 0 {
+			return []comparison{nothing}, nil
+		}
+		return []comparison{{"<", p.floor()}}, nil
+	case "<=":
+		if full {
+			return []comparison{{"<=", v}}, nil
+		}
+		if p.parts == 0 {
+			return nil, nil
+		}
+		return []comparison{{"<", p.ceiling()}}, nil
+	case "~", "~>":
+		if p.parts == 0 {
+			return nil, nil
+		}
+		if p.parts < 2 {
+			return between(p.floor(), p.ceiling()), nil
+		}
+		return between(v, Version{Major: v.Major, Minor: v.Minor + 1}), nil
+	case "^":
+		switch {
+		case p.parts == 0:
+			return nil, nil
+		case v.Major > 0 || p.parts == 1:
+			return between(p.floor(), Version{Major: v.Major + 1}), nil
+		case v.Minor > 0 || p.parts == 2:
+			return between(p.floor(), Version{Minor: v.Minor + 1}), nil
+		default:
+			return between(v, Version{Patch: v.Patch + 1}), nil
+		}
+	}
+
+	return nil, fmt.Errorf("%q: unknown operator", term)
+}
+
+func hyphenRange(low string, high string) ([]comparison, error) {
+	lowest, err := parsePartial(low)
+	if err != nil {
+		return nil, err
+	}
+	highest, err := parsePartial(high)
+	if err != nil {
+		return nil, err
+	}
+
+	if highest.parts == 3 || highest.version.PreRelease != "" {
+		return []comparison{{">=", lowest.floor()}, {"<=", highest.version}}, nil
+	}
+	if highest.parts == 0 {
+		return []comparison{{">=", lowest.floor()}}, nil
+	}
+	return between(lowest.floor(), highest.ceiling()), nil
+}
+
+// nothing is a comparison no version satisfies.
+var nothing = comparison{"<", Version{}}
+
+func between(floor Version, ceiling Version) []comparison {
+	return []comparison{{">=", floor}, {"<", ceiling}}
+}
+
+// floor is the lowest version the partial version covers, e.g. 2.13.0 for
+// 2.13.*.
+func (p partial) floor() Version {
+	if p.parts == 3 {
+		return p.version
+	}
+	v := Version{Major: p.version.Major, Minor: p.version.Minor}
+	if p.parts < 2 {
+		v.Minor = 0
+	}
+	return v
+}
+
+// ceiling is the lowest version above those the partial version covers,
+// e.g. 2.14.0 for 2.13.*.
+func (p partial) ceiling() Version {
+	switch p.parts {
+	case 0:
+		return Version{}
+	case 1:
+		return Version{Major: p.version.Major + 1}
+	case 2:
+		return Version{Major: p.version.Major, Minor: p.version.Minor + 1}
+	}
+	return Version{Major: p.version.Major, Minor: p.version.Minor, Patch: p.version.Patch + 1}
+}
@@ -0,0 +1,60 @@
+package semver_test
+
+import (
+	"github.com/pivotal-cf/go-pivnet/v9/semver"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Constraint", func() {
+	DescribeTable("matching",
+		func(constraint string, matching []string, notMatching []string) {
+			c, err := semver.ParseConstraint(constraint)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.String()).To(Equal(constraint))
+
+			for _, v := range matching {
+				Expect(c.Check(semver.MustParse(v))).To(BeTrue(), "%s should match %s", constraint, v)
+			}
+			for _, v := range notMatching {
+				Expect(c.Check(semver.MustParse(v))).To(BeFalse(), "%s should not match %s", constraint, v)
+			}
+		},
+		Entry("exact", "2.13.4", []string{"2.13.4", "2.13.4+build.1"}, []string{"2.13.5", "2.13.4-rc.1"}),
+		Entry("equals", "= 2.13.4", []string{"2.13.4"}, []string{"2.13.3"}),
+		Entry("not equal", "!=2.13.4", []string{"2.13.3", "2.13.5"}, []string{"2.13.4"}),
+		Entry("Pivnet-style wildcard", "2.13.*", []string{"2.13.0", "2.13.9"}, []string{"2.12.9", "2.14.0", "2.13.1-rc.1"}),
+		Entry("x wildcard", "2.x", []string{"2.0.0", "2.99.1"}, []string{"1.9.9", "3.0.0"}),
+		Entry("partial version", "2.13", []string{"2.13.0", "2.13.7"}, []string{"2.14.0"}),
+		Entry("any", "*", []string{"0.0.1", "10.2.3"}, []string{"1.0.0-rc.1"}),
+		Entry("tilde minor", "~2.13", []string{"2.13.0", "2.13.8"}, []string{"2.12.9", "2.14.0"}),
+		Entry("tilde patch", "~2.13.4", []string{"2.13.4", "2.13.9"}, []string{"2.13.3", "2.14.0"}),
+		Entry("tilde major", "~2", []string{"2.0.0", "2.9.0"}, []string{"3.0.0"}),
+		Entry("pessimistic", "~> 2.13.4", []string{"2.13.5"}, []string{"2.14.0"}),
+		Entry("caret", "^1.4.2", []string{"1.4.2", "1.9.0"}, []string{"1.4.1", "2.0.0"}),
+		Entry("caret below 1", "^0.3.1", []string{"0.3.1", "0.3.9"}, []string{"0.4.0"}),
+		Entry("caret below 0.1", "^0.0.3", []string{"0.0.3"}, []string{"0.0.4"}),
+		Entry("range", ">=1.4 <2", []string{"1.4.0", "1.99.0"}, []string{"1.3.9", "2.0.0", "2.0.0-rc.1"}),
+		Entry("comma-separated range", ">= 1.4, < 2", []string{"1.5.0"}, []string{"2.0.0"}),
+		Entry("greater than a partial version", ">1.4", []string{"1.5.0"}, []string{"1.4.9"}),
+		Entry("at most a partial version", "<=1.4", []string{"1.4.9"}, []string{"1.5.0"}),
+		Entry("hyphen range", "1.2 - 1.4", []string{"1.2.0", "1.4.9"}, []string{"1.1.9", "1.5.0"}),
+		Entry("hyphen range to a full version", "1.2 - 1.4.2", []string{"1.4.2"}, []string{"1.4.3"}),
+		Entry("alternatives", "~1.4 || >=3", []string{"1.4.5", "3.1.0"}, []string{"2.0.0"}),
+		Entry("pre-release of the same version", ">=2.0.0-rc.1 <3", []string{"2.0.0-rc.1", "2.0.0-rc.2", "2.0.0", "2.5.0"}, []string{"2.0.0-beta.1", "2.1.0-rc.1"}),
+	)
+
+	DescribeTable("invalid constraints",
+		func(constraint string) {
+			_, err := semver.ParseConstraint(constraint)
+			Expect(err).To(MatchError(ContainSubstring("invalid constraint")))
+		},
+		Entry("empty", ""),
+		Entry("empty alternative", "1.2 ||"),
+		Entry("invalid version", ">=one"),
+		Entry("unknown operator", "=>1.2"),
+		Entry("partial version with !=", "!=1.2"),
+	)
+})
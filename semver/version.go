@@ -0,0 +1,263 @@
+// Package semver parses release versions and matches them against version
+// constraints.
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a semantic version. Parsing is lenient about the forms Pivnet
+// release versions take: a leading "v" is allowed and missing minor and
+// patch numbers are zero, so "v2.13" is 2.13.0.
+type Version struct {
+	Major      int
+	Minor      int
+	Patch      int
+	PreRelease string
+	Build      string
+
+	original string
+}
+
+func Parse(s string) (Version, error) {
+	p, err := parsePartial(s)
+	if err != nil {
+		return Version{}, err
+	}
+	if p.wildcard {
+		return Version{}, fmt.Errorf("invalid version %q: wildcards are only allowed in constraints", s)
+	}
+
+	p.version.original = s
+	return p.version, nil
+}
+
+// MustParse is like Parse but panics if the version is invalid.
+func MustParse(s string) Version {
+	v, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns the version as it was parsed.
+func (v Version) String() string {
+	if v.original != "" {
+		return v.original
+	}
+
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.PreRelease != "" {
+		s += "-" + v.PreRelease
+	}
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+	return s
+}
+
+// Compare returns -1, 0 or 1 as v has lower, equal or higher precedence
+// than o. As in the semver spec, a pre-release is lower than the release it
+// precedes and build metadata is ignored.
+func (v Version) Compare(o Version) int {
+	for _, c := range [][2]int{{v.Major, o.Major}, {v.Minor, o.Minor}, {v.Patch, o.Patch}} {
+		if c[0] != c[1] {
+			return compareInts(c[0], c[1])
+		}
+	}
+
+	switch {
+	case v.PreRelease == o.PreRelease:
+		return 0
+	case v.PreRelease == "":
+		return 1
+	case o.PreRelease == "":
+		return -1
+	}
+
+	return compareIdentifiers(v.PreRelease, o.PreRelease)
+}
+
+// CompareBuild orders versions of equal precedence by their build metadata,
+// compared like pre-release identifiers, with no build metadata first. It
+// gives a deterministic order to versions such as 1.0.0+build.9 and
+// 1.0.0+build.10.
+func (v Version) CompareBuild(o Version) int {
+	switch {
+	case v.Build == o.Build:
+		return 0
+	case v.Build == "":
+		return -1
+	case o.Build == "":
+		return 1
+	}
+
+	return compareIdentifiers(v.Build, o.Build)
+}
+
+func (v Version) LessThan(o Version) bool {
+	return v.Compare(o) < 0
+}
+
+func (v Version) Equal(o Version) bool {
+	return v.Compare(o) == 0
+}
+
+func (v Version) tuple() [3]int {
+	return [3]int{v.Major, v.Minor, v.Patch}
+}
+
+// partial is a version that may be missing trailing numbers, as in the
+// constraints "2.13" and "2.13.*".
+type partial struct {
+	version Version
+
+	// parts is the number of numbers given, from 0 for "*" to 3.
+	parts    int
+	wildcard bool
+}
+
+func parsePartial(s string) (partial, error) {
+	rest := strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(s), "v"), "V")
+
+	var p partial
+
+	if i := strings.Index(rest, "+"); i >= 0 {
+		p.version.Build = rest[i+1:]
+		rest = rest[:i]
+		if err := validateIdentifiers(p.version.Build, false); err != nil {
+			return partial{}, fmt.Errorf("invalid version %q: build metadata %w", s, err)
+		}
+	}
+
+	if i := strings.Index(rest, "-"); i >= 0 {
+		p.version.PreRelease = rest[i+1:]
+		rest = rest[:i]
+		if err := validateIdentifiers(p.version.PreRelease, true); err != nil {
+			return partial{}, fmt.Errorf("invalid version %q: pre-release %w", s, err)
+		}
+	}
+
+	numbers := strings.Split(rest, ".")
+	if len(numbers) > 3 {
+		return partial{}, fmt.Errorf("invalid version %q: more than three numbers", s)
+	}
+
+	for i, n := range numbers {
+		if n == "*" || n == "x" || n == "X" {
+			p.wildcard = true
+			continue
+		}
+		if p.wildcard {
+			return partial{}, fmt.Errorf("invalid version %q: number after a wildcard", s)
+		}
+
+		value, err := parseNumber(n)
+		if err != nil {
+			return partial{}, fmt.Errorf("invalid version %q: %w", s, err)
+		}
+
+		switch i {
+		case 0:
+			p.version.Major = value
+		case 1:
+			p.version.Minor = value
+		case 2:
+			p.version.Patch = value
+		}
+		p.parts++
+	}
+
+	if p.wildcard && (p.version.PreRelease != "" || p.version.Build != "") {
+		return partial{}, fmt.Errorf("invalid version %q: pre-release or build metadata after a wildcard", s)
+	}
+
+	return p, nil
+}
+
+func parseNumber(s string) (int, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty number")
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("%q is not a number", s)
+		}
+	}
+	return strconv.Atoi(s)
+}
+
+func validateIdentifiers(s string, rejectLeadingZeros bool) error {
+	for _, id := range strings.Split(s, ".") {
+		if id == "" {
+			return fmt.Errorf("has an empty identifier")
+		}
+		for _, c := range id {
+			if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '-') {
+				return fmt.Errorf("identifier %q has characters other than [0-9A-Za-z-]", id)
+			}
+		}
+		if rejectLeadingZeros && len(id) > 1 && id[0] == '0' && isNumeric(id) {
+			return fmt.Errorf("identifier %q has a leading zero", id)
+		}
+	}
+	return nil
+}
+
+// compareIdentifiers compares dot-separated identifiers as the semver spec
+// compares pre-releases: numeric identifiers numerically and lower than
+// alphanumeric ones, which compare in ASCII order, and a shorter list lower
+// than a longer one it is a prefix of.
+func compareIdentifiers(a string, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if c := compareIdentifier(as[i], bs[i]); c != 0 {
+			return c
+		}
+	}
+
+	return compareInts(len(as), len(bs))
+}
+
+func compareIdentifier(a string, b string) int {
+	aNumeric, bNumeric := isNumeric(a), isNumeric(b)
+
+	switch {
+	case aNumeric && bNumeric:
+		a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+		if len(a) != len(b) {
+			return compareInts(len(a), len(b))
+		}
+		return strings.Compare(a, b)
+	case aNumeric:
+		return -1
+	case bNumeric:
+		return 1
+	}
+
+	return strings.Compare(a, b)
+}
+
+func isNumeric(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func compareInts(a int, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,90 @@
+package semver_test
+
+import (
+	"sort"
+
+	"github.com/pivotal-cf/go-pivnet/v9/semver"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Version", func() {
+	DescribeTable("parsing",
+		func(s string, expected semver.Version) {
+			v, err := semver.Parse(s)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(v.Major).To(Equal(expected.Major))
+			Expect(v.Minor).To(Equal(expected.Minor))
+			Expect(v.Patch).To(Equal(expected.Patch))
+			Expect(v.PreRelease).To(Equal(expected.PreRelease))
+			Expect(v.Build).To(Equal(expected.Build))
+			Expect(v.String()).To(Equal(s))
+		},
+		Entry("full version", "2.13.4", semver.Version{Major: 2, Minor: 13, Patch: 4}),
+		Entry("leading v", "v2.13.4", semver.Version{Major: 2, Minor: 13, Patch: 4}),
+		Entry("missing patch", "2.13", semver.Version{Major: 2, Minor: 13}),
+		Entry("major only", "3", semver.Version{Major: 3}),
+		Entry("pre-release", "2.0.0-rc.1", semver.Version{Major: 2, PreRelease: "rc.1"}),
+		Entry("build", "1.4.0+build.12", semver.Version{Major: 1, Minor: 4, Build: "build.12"}),
+		Entry("pre-release and build", "1.4.0-alpha-2+sha.5114f85", semver.Version{Major: 1, Minor: 4, PreRelease: "alpha-2", Build: "sha.5114f85"}),
+	)
+
+	DescribeTable("invalid versions",
+		func(s string) {
+			_, err := semver.Parse(s)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("empty", ""),
+		Entry("not a number", "two.13"),
+		Entry("four numbers", "1.2.3.4"),
+		Entry("wildcard", "2.13.*"),
+		Entry("empty pre-release identifier", "1.0.0-rc..1"),
+		Entry("leading zero in numeric pre-release", "1.0.0-01"),
+		Entry("invalid build characters", "1.0.0+build_1"),
+		Entry("suffix", "1.0.0 (Beta)"),
+	)
+
+	It("orders versions by precedence as in the semver spec", func() {
+		ordered := []string{
+			"1.0.0-alpha",
+			"1.0.0-alpha.1",
+			"1.0.0-alpha.beta",
+			"1.0.0-beta",
+			"1.0.0-beta.2",
+			"1.0.0-beta.11",
+			"1.0.0-rc.1",
+			"1.0.0",
+			"1.0.1",
+			"1.2.0",
+			"1.10.0",
+			"2.0.0",
+		}
+
+		for i := 0; i < len(ordered)-1; i++ {
+			a, b := semver.MustParse(ordered[i]), semver.MustParse(ordered[i+1])
+			Expect(a.Compare(b)).To(Equal(-1), "%s < %s", a, b)
+			Expect(b.Compare(a)).To(Equal(1), "%s > %s", b, a)
+			Expect(a.LessThan(b)).To(BeTrue())
+		}
+	})
+
+	It("ignores build metadata for precedence", func() {
+		a, b := semver.MustParse("1.0.0+build.9"), semver.MustParse("v1.0+build.10")
+		Expect(a.Equal(b)).To(BeTrue())
+
+		Expect(a.CompareBuild(b)).To(Equal(-1))
+		Expect(semver.MustParse("1.0.0").CompareBuild(a)).To(Equal(-1))
+	})
+
+	It("sorts with sort.Slice", func() {
+		versions := []semver.Version{semver.MustParse("1.10.0"), semver.MustParse("1.2.0"), semver.MustParse("1.2.0-rc.1")}
+		sort.Slice(versions, func(i, j int) bool { return versions[i].LessThan(versions[j]) })
+
+		Expect(versions[0].String()).To(Equal("1.2.0-rc.1"))
+		Expect(versions[1].String()).To(Equal("1.2.0"))
+		Expect(versions[2].String()).To(Equal("1.10.0"))
+	})
+})
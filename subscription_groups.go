@@ -2,6 +2,7 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -14,6 +15,18 @@ type SubscriptionGroupsService struct {
 	client Client
 }
 
+//go:generate counterfeiter . SubscriptionGroupsAPI
+type SubscriptionGroupsAPI interface {
+	List() ([]SubscriptionGroup, error)
+	ListContext(ctx context.Context) ([]SubscriptionGroup, error)
+	Get(subscriptionGroupID int) (SubscriptionGroup, error)
+	GetContext(ctx context.Context, subscriptionGroupID int) (SubscriptionGroup, error)
+	AddMember(subscriptionGroupID int, memberEmailAddress string, isAdmin string) (SubscriptionGroup, error)
+	AddMemberContext(ctx context.Context, subscriptionGroupID int, memberEmailAddress string, isAdmin string) (SubscriptionGroup, error)
+	RemoveMember(subscriptionGroupID int, memberEmailAddress string) (SubscriptionGroup, error)
+	RemoveMemberContext(ctx context.Context, subscriptionGroupID int, memberEmailAddress string) (SubscriptionGroup, error)
+}
+
 type SubscriptionGroupsResponse struct {
 	SubscriptionGroups []SubscriptionGroup `json:"subscription_groups,omitempty"`
 }
@@ -61,10 +74,15 @@ type SubscriptionGroupSubscription struct {
 }
 
 func (c SubscriptionGroupsService) List() ([]SubscriptionGroup, error) {
+	return c.ListContext(context.Background())
+}
+
+func (c SubscriptionGroupsService) ListContext(ctx context.Context) ([]SubscriptionGroup, error) {
 	url := "/subscription_groups"
 
 	var response SubscriptionGroupsResponse
-	resp, err := c.client.MakeRequest(
+	resp, err := c.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -84,10 +102,15 @@ func (c SubscriptionGroupsService) List() ([]SubscriptionGroup, error) {
 }
 
 func (c SubscriptionGroupsService) Get(subscriptionGroupID int) (SubscriptionGroup, error) {
+	return c.GetContext(context.Background(), subscriptionGroupID)
+}
+
+func (c SubscriptionGroupsService) GetContext(ctx context.Context, subscriptionGroupID int) (SubscriptionGroup, error) {
 	url := fmt.Sprintf("/subscription_groups/%d", subscriptionGroupID)
 
 	var response SubscriptionGroup
-	resp, err := c.client.MakeRequest(
+	resp, err := c.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -110,6 +133,15 @@ func (c SubscriptionGroupsService) AddMember(
 	subscriptionGroupID int,
 	memberEmailAddress string,
 	isAdmin string,
+) (SubscriptionGroup, error) {
+	return c.AddMemberContext(context.Background(), subscriptionGroupID, memberEmailAddress, isAdmin)
+}
+
+func (c SubscriptionGroupsService) AddMemberContext(
+	ctx context.Context,
+	subscriptionGroupID int,
+	memberEmailAddress string,
+	isAdmin string,
 ) (SubscriptionGroup, error) {
 	url := fmt.Sprintf("/subscription_groups/%d/add_member", subscriptionGroupID)
 
@@ -149,7 +181,8 @@ func (c SubscriptionGroupsService) AddMember(
 	body := bytes.NewReader(b)
 
 	var response SubscriptionGroup
-	resp, err := c.client.MakeRequest(
+	resp, err := c.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusOK,
@@ -171,6 +204,14 @@ func (c SubscriptionGroupsService) AddMember(
 func (c SubscriptionGroupsService) RemoveMember(
 	subscriptionGroupID int,
 	memberEmailAddress string,
+) (SubscriptionGroup, error) {
+	return c.RemoveMemberContext(context.Background(), subscriptionGroupID, memberEmailAddress)
+}
+
+func (c SubscriptionGroupsService) RemoveMemberContext(
+	ctx context.Context,
+	subscriptionGroupID int,
+	memberEmailAddress string,
 ) (SubscriptionGroup, error) {
 	url := fmt.Sprintf("/subscription_groups/%d/remove_member", subscriptionGroupID)
 
@@ -188,7 +229,8 @@ func (c SubscriptionGroupsService) RemoveMember(
 	body := bytes.NewReader(b)
 
 	var response SubscriptionGroup
-	resp, err := c.client.MakeRequest(
+	resp, err := c.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusOK,
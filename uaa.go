@@ -2,11 +2,15 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 )
 
@@ -27,6 +31,12 @@ func NewTokenFetcher(endpoint, refreshToken string, skipSSLValidation bool, user
 }
 
 func (t TokenFetcher) GetToken() (string, error) {
+	return t.GetTokenContext(context.Background())
+}
+
+// GetTokenContext exchanges the refresh token for an access token, giving up
+// when ctx is done.
+func (t TokenFetcher) GetTokenContext(ctx context.Context) (string, error) {
 	var transport http.RoundTripper
 	var err error
 
@@ -80,26 +90,32 @@ func (t TokenFetcher) GetToken() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal API token request body: %s", err.Error())
 	}
-	req, err := http.NewRequest("POST", t.Endpoint+"/authentication/access_tokens", bytes.NewReader(b))
+	req, err := http.NewRequestWithContext(ctx, "POST", t.Endpoint+"/authentication/access_tokens", bytes.NewReader(b))
+	if err != nil {
+		return "", fmt.Errorf("failed to construct API token request: %s", err.Error())
+	}
+
 	req.Header.Add("Content-Type", "application/json")
 
 	if t.UserAgent != "" {
 		req.Header.Add("User-Agent", t.UserAgent)
 	}
 
-	if err != nil {
-		return "", fmt.Errorf("failed to construct API token request: %s", err.Error())
-	}
-
 	resp, err := httpClient.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("API token request failed: %s", err.Error())
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		return "", ErrTokenExchange{
+			Unreachable: true,
+			Err:         err,
+		}
 	}
 
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("failed to fetch API token - received status %v", resp.StatusCode)
+		return "", newErrTokenExchange(resp)
 	}
 
 	var response AuthResp
@@ -110,3 +126,29 @@ func (t TokenFetcher) GetToken() (string, error) {
 
 	return response.Token, nil
 }
+
+func newErrTokenExchange(resp *http.Response) ErrTokenExchange {
+	const maxMessageLength = 512
+
+	exchangeErr := ErrTokenExchange{
+		ResponseCode:        resp.StatusCode,
+		InvalidRefreshToken: resp.StatusCode == http.StatusUnauthorized,
+	}
+
+	b, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxMessageLength))
+	if err != nil {
+		return exchangeErr
+	}
+
+	var pErr pivnetErr
+	var internalServerError pivnetInternalServerErr
+	if json.Unmarshal(b, &pErr) == nil && pErr.Message != "" {
+		exchangeErr.Message = pErr.Message
+	} else if json.Unmarshal(b, &internalServerError) == nil && internalServerError.Error != "" {
+		exchangeErr.Message = internalServerError.Error
+	} else if !json.Valid(b) {
+		exchangeErr.Message = strings.TrimSpace(string(b))
+	}
+
+	return exchangeErr
+}
@@ -1,8 +1,10 @@
 package pivnet
 
 import (
+	"context"
 	"errors"
 	"net/http"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -52,6 +54,20 @@ var _ = Describe("UAA", func() {
 			tokenFetcher.GetToken()
 		})
 
+		It("gives up when the context is done", func() {
+			release := make(chan struct{})
+			defer close(release)
+			server.AppendHandlers(func(w http.ResponseWriter, r *http.Request) {
+				<-release
+			})
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			_, err := tokenFetcher.GetTokenContext(ctx)
+			Expect(err).To(MatchError(context.DeadlineExceeded))
+		})
+
 		Context("when UAA server responds with a non-200 status code", func() {
 			It("returns the error 418", func() {
 				server.AppendHandlers(
@@ -64,7 +80,55 @@ var _ = Describe("UAA", func() {
 
 				_, err := tokenFetcher.GetToken()
 				Expect(err).To(HaveOccurred())
-				Expect(err).To(MatchError(errors.New("failed to fetch API token - received status 418")))
+				Expect(err).To(MatchError(ErrTokenExchange{ResponseCode: http.StatusTeapot}))
+				Expect(err).To(MatchError("failed to fetch API token - received status 418"))
+			})
+
+			It("reports an invalid refresh token with the message from Pivnet", func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/authentication/access_tokens"),
+						ghttp.RespondWith(http.StatusUnauthorized, `{"message":"refresh token is expired"}`),
+					),
+				)
+
+				_, err := tokenFetcher.GetToken()
+				Expect(err).To(MatchError(ErrTokenExchange{
+					ResponseCode:        http.StatusUnauthorized,
+					Message:             "refresh token is expired",
+					InvalidRefreshToken: true,
+				}))
+				Expect(err).To(MatchError("failed to fetch API token - received status 401: refresh token is expired"))
+			})
+
+			It("reports the error from a Pivnet internal server error", func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/authentication/access_tokens"),
+						ghttp.RespondWith(http.StatusInternalServerError, `{"status":"500","error":"something broke"}`),
+					),
+				)
+
+				_, err := tokenFetcher.GetToken()
+				Expect(err).To(MatchError(ErrTokenExchange{
+					ResponseCode: http.StatusInternalServerError,
+					Message:      "something broke",
+				}))
+			})
+
+			It("reports a response body that is not JSON", func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/authentication/access_tokens"),
+						ghttp.RespondWith(http.StatusBadGateway, "Bad Gateway\n"),
+					),
+				)
+
+				_, err := tokenFetcher.GetToken()
+				Expect(err).To(MatchError(ErrTokenExchange{
+					ResponseCode: http.StatusBadGateway,
+					Message:      "Bad Gateway",
+				}))
 			})
 
 			It("returns an error without endpoint", func() {
@@ -82,6 +146,23 @@ var _ = Describe("UAA", func() {
 			})
 		})
 
+		Context("when Pivnet cannot be reached", func() {
+			It("returns an error marked as unreachable", func() {
+				url := server.URL()
+				server.Close()
+				tokenFetcher = NewTokenFetcher(url, "some-refresh-token", false, "", ProxyAuthConfig{})
+
+				_, err := tokenFetcher.GetToken()
+				Expect(err).To(HaveOccurred())
+
+				var exchangeErr ErrTokenExchange
+				Expect(errors.As(err, &exchangeErr)).To(BeTrue())
+				Expect(exchangeErr.Unreachable).To(BeTrue())
+				Expect(exchangeErr.InvalidRefreshToken).To(BeFalse())
+				Expect(exchangeErr.Err).To(HaveOccurred())
+			})
+		})
+
 		Context("when proxy authentication is configured", func() {
 			Context("with Basic authentication", func() {
 				It("returns an error when proxy URL is empty but auth type is set", func() {
@@ -2,6 +2,7 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -11,6 +12,18 @@ type UpgradePathSpecifiersService struct {
 	client Client
 }
 
+//go:generate counterfeiter . UpgradePathSpecifiersAPI
+type UpgradePathSpecifiersAPI interface {
+	List(productSlug string, releaseID int) ([]UpgradePathSpecifier, error)
+	ListContext(ctx context.Context, productSlug string, releaseID int) ([]UpgradePathSpecifier, error)
+	Get(productSlug string, releaseID int, upgradePathSpecifierID int) (UpgradePathSpecifier, error)
+	GetContext(ctx context.Context, productSlug string, releaseID int, upgradePathSpecifierID int) (UpgradePathSpecifier, error)
+	Create(productSlug string, releaseID int, specifier string) (UpgradePathSpecifier, error)
+	CreateContext(ctx context.Context, productSlug string, releaseID int, specifier string) (UpgradePathSpecifier, error)
+	Delete(productSlug string, releaseID int, upgradePathSpecifierID int) error
+	DeleteContext(ctx context.Context, productSlug string, releaseID int, upgradePathSpecifierID int) error
+}
+
 type UpgradePathSpecifiersResponse struct {
 	UpgradePathSpecifiers []UpgradePathSpecifier `json:"upgrade_path_specifiers,omitempty"`
 }
@@ -25,6 +38,10 @@ type UpgradePathSpecifier struct {
 }
 
 func (r UpgradePathSpecifiersService) List(productSlug string, releaseID int) ([]UpgradePathSpecifier, error) {
+	return r.ListContext(context.Background(), productSlug, releaseID)
+}
+
+func (r UpgradePathSpecifiersService) ListContext(ctx context.Context, productSlug string, releaseID int) ([]UpgradePathSpecifier, error) {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/upgrade_path_specifiers",
 		productSlug,
@@ -32,7 +49,8 @@ func (r UpgradePathSpecifiersService) List(productSlug string, releaseID int) ([
 	)
 
 	var response UpgradePathSpecifiersResponse
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -52,6 +70,10 @@ func (r UpgradePathSpecifiersService) List(productSlug string, releaseID int) ([
 }
 
 func (r UpgradePathSpecifiersService) Get(productSlug string, releaseID int, upgradePathSpecifierID int) (UpgradePathSpecifier, error) {
+	return r.GetContext(context.Background(), productSlug, releaseID, upgradePathSpecifierID)
+}
+
+func (r UpgradePathSpecifiersService) GetContext(ctx context.Context, productSlug string, releaseID int, upgradePathSpecifierID int) (UpgradePathSpecifier, error) {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/upgrade_path_specifiers/%d",
 		productSlug,
@@ -59,7 +81,8 @@ func (r UpgradePathSpecifiersService) Get(productSlug string, releaseID int, upg
 		upgradePathSpecifierID,
 	)
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -80,6 +103,10 @@ func (r UpgradePathSpecifiersService) Get(productSlug string, releaseID int, upg
 }
 
 func (r UpgradePathSpecifiersService) Create(productSlug string, releaseID int, specifier string) (UpgradePathSpecifier, error) {
+	return r.CreateContext(context.Background(), productSlug, releaseID, specifier)
+}
+
+func (r UpgradePathSpecifiersService) CreateContext(ctx context.Context, productSlug string, releaseID int, specifier string) (UpgradePathSpecifier, error) {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/upgrade_path_specifiers",
 		productSlug,
@@ -99,7 +126,8 @@ func (r UpgradePathSpecifiersService) Create(productSlug string, releaseID int,
 		return UpgradePathSpecifier{}, err
 	}
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"POST",
 		url,
 		http.StatusCreated,
@@ -123,6 +151,15 @@ func (r UpgradePathSpecifiersService) Delete(
 	productSlug string,
 	releaseID int,
 	upgradePathSpecifierID int,
+) error {
+	return r.DeleteContext(context.Background(), productSlug, releaseID, upgradePathSpecifierID)
+}
+
+func (r UpgradePathSpecifiersService) DeleteContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	upgradePathSpecifierID int,
 ) error {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/upgrade_path_specifiers/%d",
@@ -131,7 +168,8 @@ func (r UpgradePathSpecifiersService) Delete(
 		upgradePathSpecifierID,
 	)
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"DELETE",
 		url,
 		http.StatusNoContent,
@@ -0,0 +1,242 @@
+package upload
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Target is the bucket an upload is written to and the credentials to write
+// it with, as returned in a federation token.
+type Target struct {
+	Bucket      string
+	Region      string
+	Credentials Credentials
+}
+
+// ErrS3 is an error response from S3.
+type ErrS3 struct {
+	StatusCode int    `json:"status_code" yaml:"status_code"`
+	Code       string `json:"code" yaml:"code"`
+	Message    string `json:"message" yaml:"message"`
+}
+
+func (e ErrS3) Error() string {
+	return fmt.Sprintf("s3 returned %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+type s3Error struct {
+	XMLName xml.Name `xml:"Error"`
+	Code    string   `xml:"Code"`
+	Message string   `xml:"Message"`
+}
+
+type multipartUpload struct {
+	Key       string `xml:"Key"`
+	UploadID  string `xml:"UploadId"`
+	Initiated string `xml:"Initiated"`
+}
+
+type listMultipartUploadsResult struct {
+	Uploads            []multipartUpload `xml:"Upload"`
+	IsTruncated        bool              `xml:"IsTruncated"`
+	NextKeyMarker      string            `xml:"NextKeyMarker"`
+	NextUploadIDMarker string            `xml:"NextUploadIdMarker"`
+}
+
+type uploadedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+	Size       int64  `xml:"Size"`
+}
+
+type listPartsResult struct {
+	Parts                []uploadedPart `xml:"Part"`
+	IsTruncated          bool           `xml:"IsTruncated"`
+	NextPartNumberMarker int            `xml:"NextPartNumberMarker"`
+}
+
+type initiateMultipartUploadResult struct {
+	UploadID string `xml:"UploadId"`
+}
+
+type completedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+type completeMultipartUpload struct {
+	XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+	Parts   []completedPart `xml:"Part"`
+}
+
+// s3Client makes the multipart upload requests to one bucket.
+type s3Client struct {
+	httpClient httpClient
+	endpoint   string
+	target     Target
+}
+
+// objectURL addresses the bucket virtual-hosted style on AWS, and path style
+// on a custom endpoint.
+func (s s3Client) objectURL(key string, query url.Values) *url.URL {
+	var u *url.URL
+	if s.endpoint == "" {
+		host := fmt.Sprintf("%s.s3.%s.amazonaws.com", s.target.Bucket, s.target.Region)
+		u = &url.URL{Scheme: "https", Host: host, Path: "/" + key}
+	} else {
+		base, err := url.Parse(strings.TrimSuffix(s.endpoint, "/"))
+		if err != nil {
+			base = &url.URL{}
+		}
+		u = base
+		u.Path = base.Path + "/" + s.target.Bucket + "/" + key
+	}
+
+	u.RawPath = escape(u.Path, false)
+	u.RawQuery = strings.Replace(query.Encode(), "+", "%20", -1)
+	return u
+}
+
+func (s s3Client) do(ctx context.Context, method string, key string, query url.Values, body []byte, out interface{}) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.objectURL(key, query).String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(body))
+
+	if len(body) > 0 {
+		sum := md5.Sum(body)
+		req.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(sum[:]))
+	}
+
+	signRequest(req, s.target.Credentials, s.target.Region, hashHex(body), time.Now())
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 300 {
+		var e s3Error
+		_ = xml.Unmarshal(b, &e)
+		return nil, ErrS3{StatusCode: resp.StatusCode, Code: e.Code, Message: e.Message}
+	}
+
+	// CompleteMultipartUpload can fail after sending a 200
+	if bytes.Contains(b, []byte("<Error>")) {
+		var e s3Error
+		if xml.Unmarshal(b, &e) == nil {
+			return nil, ErrS3{StatusCode: resp.StatusCode, Code: e.Code, Message: e.Message}
+		}
+	}
+
+	if out != nil {
+		if err := xml.Unmarshal(b, out); err != nil {
+			return nil, fmt.Errorf("failed to decode s3 response: %w", err)
+		}
+	}
+
+	return resp, nil
+}
+
+// findUpload returns the ID of the most recently started multipart upload of
+// key that has not been completed or aborted, or an empty string.
+func (s s3Client) findUpload(ctx context.Context, key string) (string, error) {
+	query := url.Values{"uploads": {""}, "prefix": {key}}
+
+	var latest multipartUpload
+	for {
+		var result listMultipartUploadsResult
+		if _, err := s.do(ctx, "GET", "", query, nil, &result); err != nil {
+			return "", err
+		}
+
+		for _, u := range result.Uploads {
+			if u.Key == key && u.Initiated >= latest.Initiated {
+				latest = u
+			}
+		}
+
+		if !result.IsTruncated {
+			return latest.UploadID, nil
+		}
+		query.Set("key-marker", result.NextKeyMarker)
+		query.Set("upload-id-marker", result.NextUploadIDMarker)
+	}
+}
+
+func (s s3Client) listParts(ctx context.Context, key string, uploadID string) (map[int]uploadedPart, error) {
+	query := url.Values{"uploadId": {uploadID}}
+
+	parts := map[int]uploadedPart{}
+	for {
+		var result listPartsResult
+		if _, err := s.do(ctx, "GET", key, query, nil, &result); err != nil {
+			return nil, err
+		}
+
+		for _, p := range result.Parts {
+			parts[p.PartNumber] = p
+		}
+
+		if !result.IsTruncated {
+			return parts, nil
+		}
+		query.Set("part-number-marker", strconv.Itoa(result.NextPartNumberMarker))
+	}
+}
+
+func (s s3Client) createUpload(ctx context.Context, key string) (string, error) {
+	var result initiateMultipartUploadResult
+	if _, err := s.do(ctx, "POST", key, url.Values{"uploads": {""}}, nil, &result); err != nil {
+		return "", err
+	}
+	return result.UploadID, nil
+}
+
+func (s s3Client) uploadPart(ctx context.Context, key string, uploadID string, partNumber int, data []byte) (string, error) {
+	query := url.Values{"partNumber": {strconv.Itoa(partNumber)}, "uploadId": {uploadID}}
+
+	resp, err := s.do(ctx, "PUT", key, query, data, nil)
+	if err != nil {
+		return "", err
+	}
+	return resp.Header.Get("ETag"), nil
+}
+
+func (s s3Client) completeUpload(ctx context.Context, key string, uploadID string, parts []completedPart) error {
+	body, err := xml.Marshal(completeMultipartUpload{Parts: parts})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.do(ctx, "POST", key, url.Values{"uploadId": {uploadID}}, body, nil)
+	return err
+}
+
+func (s s3Client) abortUpload(ctx context.Context, key string, uploadID string) error {
+	_, err := s.do(ctx, "DELETE", key, url.Values{"uploadId": {uploadID}}, nil, nil)
+	return err
+}
+
+// partETag is the ETag S3 gives a part with this content.
+func partETag(data []byte) string {
+	sum := md5.Sum(data)
+	return fmt.Sprintf("%q", fmt.Sprintf("%x", sum))
+}
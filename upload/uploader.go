@@ -0,0 +1,194 @@
+// Package upload uploads product files to the S3 bucket Pivnet serves them
+// from, using the temporary credentials of a federation token.
+package upload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pivotal-cf/go-pivnet/v9/logger"
+)
+
+const (
+	// MinPartSize is the smallest part S3 accepts, other than the last.
+	MinPartSize = 5 * 1024 * 1024
+
+	defaultPartSize    = 16 * 1024 * 1024
+	defaultConcurrency = 4
+	maxParts           = 10000
+)
+
+type httpClient interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// Client uploads files with S3 multipart uploads. An upload that fails part
+// way through is left in S3, and uploading the same file to the same key
+// again only sends the parts that are missing.
+type Client struct {
+	HTTPClient httpClient
+	Logger     logger.Logger
+
+	// Endpoint of an S3-compatible store to use instead of AWS, addressed
+	// path style, e.g. "http://localhost:9000".
+	Endpoint string
+
+	// PartSize is the size of each part (16MiB by default). AWS rejects parts
+	// smaller than MinPartSize. It is raised for files that would otherwise
+	// need more than 10000 parts.
+	PartSize int64
+
+	// Concurrency is the number of parts uploaded at once (4 by default).
+	Concurrency int
+}
+
+// Upload uploads the file at filePath to key in target's bucket.
+func (c Client) Upload(ctx context.Context, target Target, filePath string, key string) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", filePath, err)
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %w", filePath, err)
+	}
+
+	s3 := s3Client{httpClient: c.HTTPClient, endpoint: c.Endpoint, target: target}
+
+	uploadID, err := s3.findUpload(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to list multipart uploads: %w", err)
+	}
+
+	uploaded := map[int]uploadedPart{}
+	if uploadID != "" {
+		c.Logger.Debug(fmt.Sprintf("resuming multipart upload %s", uploadID))
+
+		uploaded, err = s3.listParts(ctx, key, uploadID)
+		if err != nil {
+			return fmt.Errorf("failed to list uploaded parts: %w", err)
+		}
+	} else {
+		uploadID, err = s3.createUpload(ctx, key)
+		if err != nil {
+			return fmt.Errorf("failed to create multipart upload: %w", err)
+		}
+	}
+
+	partSize := c.partSize(stat.Size())
+	partCount := int((stat.Size() + partSize - 1) / partSize)
+	if partCount == 0 {
+		partCount = 1
+	}
+
+	concurrency := c.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+
+	parts := make([]completedPart, partCount)
+	partNumbers := make(chan int)
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		defer close(partNumbers)
+		for n := 1; n <= partCount; n++ {
+			select {
+			case partNumbers <- n:
+			case <-gctx.Done():
+				return gctx.Err()
+			}
+		}
+		return nil
+	})
+
+	for w := 0; w < concurrency; w++ {
+		g.Go(func() error {
+			for n := range partNumbers {
+				etag, err := c.uploadPart(gctx, s3, file, key, uploadID, n, partSize, uploaded[n])
+				if err != nil {
+					return fmt.Errorf("failed to upload part %d of %d: %w", n, partCount, err)
+				}
+				parts[n-1] = completedPart{PartNumber: n, ETag: etag}
+			}
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	err = s3.completeUpload(ctx, key, uploadID, parts)
+	if err != nil {
+		return fmt.Errorf("failed to complete multipart upload: %w", err)
+	}
+
+	return nil
+}
+
+// Abort discards any unfinished upload to key, so the next Upload starts
+// from scratch.
+func (c Client) Abort(ctx context.Context, target Target, key string) error {
+	s3 := s3Client{httpClient: c.HTTPClient, endpoint: c.Endpoint, target: target}
+
+	uploadID, err := s3.findUpload(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to list multipart uploads: %w", err)
+	}
+	if uploadID == "" {
+		return nil
+	}
+
+	err = s3.abortUpload(ctx, key, uploadID)
+	if err != nil {
+		return fmt.Errorf("failed to abort multipart upload: %w", err)
+	}
+
+	return nil
+}
+
+func (c Client) partSize(fileSize int64) int64 {
+	partSize := c.PartSize
+	if partSize <= 0 {
+		partSize = defaultPartSize
+	}
+	if smallest := (fileSize + maxParts - 1) / maxParts; partSize < smallest {
+		partSize = smallest
+	}
+	return partSize
+}
+
+// uploadPart sends part n of the file unless previous already holds the same
+// content, and returns the part's ETag.
+func (c Client) uploadPart(ctx context.Context, s3 s3Client, file io.ReaderAt, key string, uploadID string, n int, partSize int64, previous uploadedPart) (string, error) {
+	data := make([]byte, partSize)
+	read, err := file.ReadAt(data, int64(n-1)*partSize)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("failed to read part: %w", err)
+	}
+	data = data[:read]
+
+	etag := partETag(data)
+	if previous.ETag == etag && previous.Size == int64(read) {
+		return etag, nil
+	}
+
+	uploadedETag, err := s3.uploadPart(ctx, key, uploadID, n, data)
+	if err != nil {
+		return "", err
+	}
+	if uploadedETag == "" {
+		uploadedETag = etag
+	}
+
+	return uploadedETag, nil
+}
@@ -0,0 +1,190 @@
+package upload_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"os"
+	"sync/atomic"
+
+	"github.com/pivotal-cf/go-pivnet/v9/logger/loggerfakes"
+	"github.com/pivotal-cf/go-pivnet/v9/pivnettest"
+	"github.com/pivotal-cf/go-pivnet/v9/upload"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// failingClient fails the failAt'th part upload, counting from 1.
+type failingClient struct {
+	failAt int32
+	puts   int32
+}
+
+func (f *failingClient) Do(req *http.Request) (*http.Response, error) {
+	if req.Method == "PUT" && atomic.AddInt32(&f.puts, 1) == f.failAt {
+		return nil, errors.New("connection reset")
+	}
+	return http.DefaultClient.Do(req)
+}
+
+var _ = Describe("Client", func() {
+	const key = "product-files/some-product/some-file.tgz"
+
+	var (
+		server  *pivnettest.Server
+		client  upload.Client
+		target  upload.Target
+		content []byte
+		file    string
+	)
+
+	BeforeEach(func() {
+		server = pivnettest.NewServer()
+
+		client = upload.Client{
+			HTTPClient:  http.DefaultClient,
+			Logger:      &loggerfakes.FakeLogger{},
+			Endpoint:    server.S3Endpoint(),
+			PartSize:    1024,
+			Concurrency: 2,
+		}
+
+		target = upload.Target{
+			Bucket: pivnettest.S3Bucket,
+			Region: pivnettest.S3Region,
+			Credentials: upload.Credentials{
+				AccessKeyID:     pivnettest.S3AccessKeyID,
+				SecretAccessKey: pivnettest.S3SecretAccessKey,
+				SessionToken:    pivnettest.S3SessionToken,
+			},
+		}
+
+		content = make([]byte, 5*1024+100)
+		rand.Read(content)
+
+		f, err := ioutil.TempFile("", "")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = f.Write(content)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Close()).To(Succeed())
+		file = f.Name()
+	})
+
+	AfterEach(func() {
+		server.Close()
+		os.Remove(file)
+	})
+
+	partUploads := func() int {
+		count := 0
+		for _, r := range server.Requests() {
+			if r.Method == "PUT" {
+				count++
+			}
+		}
+		return count
+	}
+
+	It("uploads the file in parts", func() {
+		Expect(client.Upload(context.Background(), target, file, key)).To(Succeed())
+
+		uploaded, ok := server.S3Object(key)
+		Expect(ok).To(BeTrue())
+		Expect(uploaded).To(Equal(content))
+		Expect(server.S3Uploads()).To(BeEmpty())
+
+		Expect(partUploads()).To(Equal(6))
+	})
+
+	It("signs requests with the credentials", func() {
+		Expect(client.Upload(context.Background(), target, file, key)).To(Succeed())
+
+		for _, r := range server.Requests() {
+			Expect(r.Header.Get("Authorization")).To(HavePrefix("AWS4-HMAC-SHA256 Credential=" + pivnettest.S3AccessKeyID + "/"))
+			Expect(r.Header.Get("Authorization")).To(ContainSubstring("/us-east-1/s3/aws4_request"))
+			Expect(r.Header.Get("X-Amz-Security-Token")).To(Equal(pivnettest.S3SessionToken))
+		}
+	})
+
+	It("uploads an empty file", func() {
+		Expect(ioutil.WriteFile(file, nil, 0600)).To(Succeed())
+
+		Expect(client.Upload(context.Background(), target, file, key)).To(Succeed())
+
+		uploaded, ok := server.S3Object(key)
+		Expect(ok).To(BeTrue())
+		Expect(uploaded).To(BeEmpty())
+	})
+
+	It("returns the S3 error when the request is rejected", func() {
+		target.Credentials.SessionToken = "some-expired-token"
+
+		err := client.Upload(context.Background(), target, file, key)
+
+		var s3Err upload.ErrS3
+		Expect(errors.As(err, &s3Err)).To(BeTrue())
+		Expect(s3Err.StatusCode).To(Equal(http.StatusForbidden))
+		Expect(s3Err.Code).To(Equal("AccessDenied"))
+	})
+
+	It("returns an error when the file does not exist", func() {
+		err := client.Upload(context.Background(), target, file+"-missing", key)
+		Expect(err).To(MatchError(ContainSubstring("failed to open")))
+	})
+
+	Context("when an upload fails part way through", func() {
+		BeforeEach(func() {
+			client.Concurrency = 1
+			client.HTTPClient = &failingClient{failAt: 4}
+
+			err := client.Upload(context.Background(), target, file, key)
+			Expect(err).To(MatchError(ContainSubstring("failed to upload part 4 of 6")))
+
+			Expect(server.S3Uploads()).To(Equal([]string{key}))
+			Expect(partUploads()).To(Equal(3))
+
+			client.HTTPClient = http.DefaultClient
+		})
+
+		It("resumes it, only uploading the missing parts", func() {
+			Expect(client.Upload(context.Background(), target, file, key)).To(Succeed())
+
+			uploaded, ok := server.S3Object(key)
+			Expect(ok).To(BeTrue())
+			Expect(uploaded).To(Equal(content))
+
+			Expect(partUploads()).To(Equal(6))
+
+			creates := 0
+			for _, r := range server.Requests() {
+				if _, ok := r.Query["uploads"]; ok && r.Method == "POST" {
+					creates++
+				}
+			}
+			Expect(creates).To(Equal(1))
+		})
+
+		It("re-uploads parts whose content changed", func() {
+			content[0]++
+			Expect(ioutil.WriteFile(file, content, 0600)).To(Succeed())
+
+			Expect(client.Upload(context.Background(), target, file, key)).To(Succeed())
+
+			uploaded, _ := server.S3Object(key)
+			Expect(uploaded).To(Equal(content))
+
+			Expect(partUploads()).To(Equal(7))
+		})
+
+		It("discards it when aborted", func() {
+			Expect(client.Abort(context.Background(), target, key)).To(Succeed())
+			Expect(server.S3Uploads()).To(BeEmpty())
+
+			Expect(client.Upload(context.Background(), target, file, key)).To(Succeed())
+			Expect(partUploads()).To(Equal(9))
+		})
+	})
+})
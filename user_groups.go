@@ -2,6 +2,7 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -11,6 +12,30 @@ type UserGroupsService struct {
 	client Client
 }
 
+//go:generate counterfeiter . UserGroupsAPI
+type UserGroupsAPI interface {
+	List() ([]UserGroup, error)
+	ListContext(ctx context.Context) ([]UserGroup, error)
+	ListForRelease(productSlug string, releaseID int) ([]UserGroup, error)
+	ListForReleaseContext(ctx context.Context, productSlug string, releaseID int) ([]UserGroup, error)
+	AddToRelease(productSlug string, releaseID int, userGroupID int) error
+	AddToReleaseContext(ctx context.Context, productSlug string, releaseID int, userGroupID int) error
+	RemoveFromRelease(productSlug string, releaseID int, userGroupID int) error
+	RemoveFromReleaseContext(ctx context.Context, productSlug string, releaseID int, userGroupID int) error
+	Get(userGroupID int) (UserGroup, error)
+	GetContext(ctx context.Context, userGroupID int) (UserGroup, error)
+	Create(name string, description string, members []string) (UserGroup, error)
+	CreateContext(ctx context.Context, name string, description string, members []string) (UserGroup, error)
+	Update(userGroup UserGroup) (UserGroup, error)
+	UpdateContext(ctx context.Context, userGroup UserGroup) (UserGroup, error)
+	Delete(userGroupID int) error
+	DeleteContext(ctx context.Context, userGroupID int) error
+	AddMemberToGroup(userGroupID int, memberEmailAddress string, admin bool) (UserGroup, error)
+	AddMemberToGroupContext(ctx context.Context, userGroupID int, memberEmailAddress string, admin bool) (UserGroup, error)
+	RemoveMemberFromGroup(userGroupID int, memberEmailAddress string) (UserGroup, error)
+	RemoveMemberFromGroupContext(ctx context.Context, userGroupID int, memberEmailAddress string) (UserGroup, error)
+}
+
 type addRemoveUserGroupBody struct {
 	UserGroup UserGroup `json:"user_group"`
 }
@@ -63,10 +88,15 @@ type member struct {
 }
 
 func (u UserGroupsService) List() ([]UserGroup, error) {
+	return u.ListContext(context.Background())
+}
+
+func (u UserGroupsService) ListContext(ctx context.Context) ([]UserGroup, error) {
 	url := "/user_groups"
 
 	var response UserGroupsResponse
-	resp, err := u.client.MakeRequest(
+	resp, err := u.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -86,6 +116,10 @@ func (u UserGroupsService) List() ([]UserGroup, error) {
 }
 
 func (u UserGroupsService) ListForRelease(productSlug string, releaseID int) ([]UserGroup, error) {
+	return u.ListForReleaseContext(context.Background(), productSlug, releaseID)
+}
+
+func (u UserGroupsService) ListForReleaseContext(ctx context.Context, productSlug string, releaseID int) ([]UserGroup, error) {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/user_groups",
 		productSlug,
@@ -93,7 +127,8 @@ func (u UserGroupsService) ListForRelease(productSlug string, releaseID int) ([]
 	)
 
 	var response UserGroupsResponse
-	resp, err := u.client.MakeRequest(
+	resp, err := u.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -113,6 +148,10 @@ func (u UserGroupsService) ListForRelease(productSlug string, releaseID int) ([]
 }
 
 func (u UserGroupsService) AddToRelease(productSlug string, releaseID int, userGroupID int) error {
+	return u.AddToReleaseContext(context.Background(), productSlug, releaseID, userGroupID)
+}
+
+func (u UserGroupsService) AddToReleaseContext(ctx context.Context, productSlug string, releaseID int, userGroupID int) error {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/add_user_group",
 		productSlug,
@@ -132,7 +171,8 @@ func (u UserGroupsService) AddToRelease(productSlug string, releaseID int, userG
 		return err
 	}
 
-	resp, err := u.client.MakeRequest(
+	resp, err := u.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -147,6 +187,10 @@ func (u UserGroupsService) AddToRelease(productSlug string, releaseID int, userG
 }
 
 func (u UserGroupsService) RemoveFromRelease(productSlug string, releaseID int, userGroupID int) error {
+	return u.RemoveFromReleaseContext(context.Background(), productSlug, releaseID, userGroupID)
+}
+
+func (u UserGroupsService) RemoveFromReleaseContext(ctx context.Context, productSlug string, releaseID int, userGroupID int) error {
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/remove_user_group",
 		productSlug,
@@ -166,7 +210,8 @@ func (u UserGroupsService) RemoveFromRelease(productSlug string, releaseID int,
 		return err
 	}
 
-	resp, err := u.client.MakeRequest(
+	resp, err := u.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -181,10 +226,15 @@ func (u UserGroupsService) RemoveFromRelease(productSlug string, releaseID int,
 }
 
 func (u UserGroupsService) Get(userGroupID int) (UserGroup, error) {
+	return u.GetContext(context.Background(), userGroupID)
+}
+
+func (u UserGroupsService) GetContext(ctx context.Context, userGroupID int) (UserGroup, error) {
 	url := fmt.Sprintf("/user_groups/%d", userGroupID)
 
 	var response UserGroup
-	resp, err := u.client.MakeRequest(
+	resp, err := u.client.MakeRequestContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -204,6 +254,10 @@ func (u UserGroupsService) Get(userGroupID int) (UserGroup, error) {
 }
 
 func (u UserGroupsService) Create(name string, description string, members []string) (UserGroup, error) {
+	return u.CreateContext(context.Background(), name, description, members)
+}
+
+func (u UserGroupsService) CreateContext(ctx context.Context, name string, description string, members []string) (UserGroup, error) {
 	url := "/user_groups"
 
 	if members == nil {
@@ -228,7 +282,8 @@ func (u UserGroupsService) Create(name string, description string, members []str
 	body := bytes.NewReader(b)
 
 	var response UserGroup
-	resp, err := u.client.MakeRequest(
+	resp, err := u.client.MakeRequestContext(
+		ctx,
 		"POST",
 		url,
 		http.StatusCreated,
@@ -248,6 +303,10 @@ func (u UserGroupsService) Create(name string, description string, members []str
 }
 
 func (u UserGroupsService) Update(userGroup UserGroup) (UserGroup, error) {
+	return u.UpdateContext(context.Background(), userGroup)
+}
+
+func (u UserGroupsService) UpdateContext(ctx context.Context, userGroup UserGroup) (UserGroup, error) {
 	url := fmt.Sprintf("/user_groups/%d", userGroup.ID)
 
 	createBody := updateUserGroupBody{
@@ -267,7 +326,8 @@ func (u UserGroupsService) Update(userGroup UserGroup) (UserGroup, error) {
 	body := bytes.NewReader(b)
 
 	var response UpdateUserGroupResponse
-	resp, err := u.client.MakeRequest(
+	resp, err := u.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusOK,
@@ -287,9 +347,14 @@ func (u UserGroupsService) Update(userGroup UserGroup) (UserGroup, error) {
 }
 
 func (r UserGroupsService) Delete(userGroupID int) error {
+	return r.DeleteContext(context.Background(), userGroupID)
+}
+
+func (r UserGroupsService) DeleteContext(ctx context.Context, userGroupID int) error {
 	url := fmt.Sprintf("/user_groups/%d", userGroupID)
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"DELETE",
 		url,
 		http.StatusNoContent,
@@ -307,6 +372,15 @@ func (r UserGroupsService) AddMemberToGroup(
 	userGroupID int,
 	memberEmailAddress string,
 	admin bool,
+) (UserGroup, error) {
+	return r.AddMemberToGroupContext(context.Background(), userGroupID, memberEmailAddress, admin)
+}
+
+func (r UserGroupsService) AddMemberToGroupContext(
+	ctx context.Context,
+	userGroupID int,
+	memberEmailAddress string,
+	admin bool,
 ) (UserGroup, error) {
 	url := fmt.Sprintf("/user_groups/%d/add_member", userGroupID)
 
@@ -327,7 +401,8 @@ func (r UserGroupsService) AddMemberToGroup(
 	body := bytes.NewReader(b)
 
 	var response UpdateUserGroupResponse
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusOK,
@@ -347,6 +422,10 @@ func (r UserGroupsService) AddMemberToGroup(
 }
 
 func (r UserGroupsService) RemoveMemberFromGroup(userGroupID int, memberEmailAddress string) (UserGroup, error) {
+	return r.RemoveMemberFromGroupContext(context.Background(), userGroupID, memberEmailAddress)
+}
+
+func (r UserGroupsService) RemoveMemberFromGroupContext(ctx context.Context, userGroupID int, memberEmailAddress string) (UserGroup, error) {
 	url := fmt.Sprintf("/user_groups/%d/remove_member", userGroupID)
 
 	addRemoveMemberBody := addRemoveMemberBody{
@@ -365,7 +444,8 @@ func (r UserGroupsService) RemoveMemberFromGroup(userGroupID int, memberEmailAdd
 	body := bytes.NewReader(b)
 
 	var response UpdateUserGroupResponse
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusOK,